@@ -7,7 +7,6 @@ import (
 	"github.com/DeepLangAI/wcd/biz/model/wcd"
 	consts2 "github.com/DeepLangAI/wcd/consts"
 	wcd2 "github.com/DeepLangAI/wcd/service/wcd"
-	"github.com/DeepLangAI/wcd/utils"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 )
@@ -43,7 +42,11 @@ func Distill(ctx context.Context, c *app.RequestContext) {
 		c.String(consts.StatusBadRequest, err.Error())
 		return
 	}
-	s := wcd2.DistillService{}
+	s, bizErr := wcd2.NewDistillServiceFromReq(ctx, req)
+	if bizErr != nil {
+		c.String(consts.StatusBadRequest, bizErr.Msg)
+		return
+	}
 	distillResp, bizErr := s.Distill(ctx, req)
 	if bizErr != nil {
 		c.String(consts.StatusInternalServerError, bizErr.Msg)
@@ -93,26 +96,79 @@ func BaseParse(ctx context.Context, c *app.RequestContext) {
 	s := wcd2.BaseParseService{}
 	resp, bizErr := s.BaseParse(ctx, req)
 	if bizErr != nil {
-		if resp == nil {
-			resp = &wcd.WcdParseResp{}
-		}
-		c.JSON(consts.StatusOK, wcd.WcdParseResp{
-			Code:           bizErr.Code,
-			Msg:            bizErr.Msg,
-			URL:            req.URL,
-			Text:           resp.Text,
-			Images:         resp.Images,
-			ReadableHTML:   resp.ReadableHTML,
-			Title:          resp.Title,
-			Author:         resp.Author,
-			ContentSource:  resp.ContentSource,
-			PubTime:        resp.PubTime,
-			ModelInputStr:  resp.ModelInputStr,
-			ModelResultStr: resp.ModelResultStr,
-			Worthless:      true,
-			WorthType:      consts2.WorthType_NoContent,
-			WcdRequestID:   utils.GetCtxOperationId(ctx),
-			RawHTML:        resp.RawHTML,
+		c.JSON(consts.StatusOK, s.FailedResp(ctx, req, resp, bizErr))
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// BaseParseBatch .
+// @router /base-parse/batch [POST]
+func BaseParseBatch(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd.BaseParseBatchReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := wcd2.BaseParseService{}
+	resp, bizErr := s.BaseParseBatch(ctx, req)
+	if bizErr != nil {
+		c.JSON(consts.StatusOK, wcd.BaseParseBatchResp{
+			Code: bizErr.Code,
+			Msg:  bizErr.Msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SubmitParseJob .
+// @router /base-parse/job [POST]
+func SubmitParseJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd.ParseJobSubmitReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := wcd2.ParseJobService{}
+	resp, bizErr := s.Submit(ctx, req)
+	if bizErr != nil {
+		c.JSON(consts.StatusOK, wcd.ParseJobSubmitResp{
+			Code: bizErr.Code,
+			Msg:  bizErr.Msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetParseJob .
+// @router /base-parse/job [GET]
+func GetParseJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd.ParseJobReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := wcd2.ParseJobService{}
+	resp, bizErr := s.Get(ctx, req)
+	if bizErr != nil {
+		c.JSON(consts.StatusOK, wcd.ParseJobResp{
+			Code:  bizErr.Code,
+			Msg:   bizErr.Msg,
+			JobID: req.JobID,
 		})
 		return
 	}
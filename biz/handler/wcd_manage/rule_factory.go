@@ -5,9 +5,12 @@ package wcd_manage
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	consts2 "github.com/DeepLangAI/wcd/consts"
 	"github.com/DeepLangAI/wcd/service/manage"
+	wcd2 "github.com/DeepLangAI/wcd/service/wcd"
 	"github.com/bytedance/sonic"
 	"time"
 
@@ -167,6 +170,15 @@ func UpdateSiteRule(ctx context.Context, c *app.RequestContext) {
 
 	s := manage.NewRuleManageService(ctx)
 	err = s.Update(req)
+	var validationErr *manage.RuleValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(consts.StatusBadRequest, &wcd_manage.UpdateSiteRuleResp{
+			Code:   consts2.ReqParamError.Code,
+			Msg:    err.Error(),
+			Errors: validationErr.Errors,
+		})
+		return
+	}
 	if err != nil {
 		c.String(consts.StatusInternalServerError, err.Error())
 		return
@@ -222,10 +234,183 @@ func ImportSiteRules(ctx context.Context, c *app.RequestContext) {
 
 	s := manage.NewRuleManageService(ctx)
 	err = s.ImportFromCtx(c)
+	var validationErr *manage.RuleValidationError
+	if errors.As(err, &validationErr) {
+		c.JSON(consts.StatusBadRequest, &wcd_manage.ImportSiteRulesResp{
+			Code:   consts2.ReqParamError.Code,
+			Msg:    err.Error(),
+			Errors: validationErr.Errors,
+		})
+		return
+	}
 	if err != nil {
 		c.String(consts.StatusInternalServerError, err.Error())
+		return
 	}
 	resp := new(wcd_manage.ImportSiteRulesResp)
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// SiteRuleRevisionList .
+// @router /api/v1/site_rule/revision/list [POST]
+func SiteRuleRevisionList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd_manage.SiteRuleRevisionListReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := manage.NewRuleManageService(ctx)
+	list, err := s.ListRevisions(req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(wcd_manage.SiteRuleRevisionListResp)
+	resp.Data = list
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SiteRuleRevisionDiff .
+// @router /api/v1/site_rule/revision/diff [POST]
+func SiteRuleRevisionDiff(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd_manage.SiteRuleRevisionDiffReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := manage.NewRuleManageService(ctx)
+	diffs, err := s.DiffRevisions(req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(wcd_manage.SiteRuleRevisionDiffResp)
+	resp.Data = diffs
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RollbackSiteRule .
+// @router /api/v1/site_rule/prod/rollback [POST]
+func RollbackSiteRule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd_manage.RollbackSiteRuleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := manage.NewRuleManageService(ctx)
+	rule, err := s.Rollback(req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(wcd_manage.RollbackSiteRuleResp)
+	resp.Data = rule
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SiteRuleDryRun .
+// @router /api/v1/site_rule/testing/dry_run [POST]
+func SiteRuleDryRun(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd_manage.SiteRuleDryRunReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := wcd2.RuleDryRunService{}
+	items, err := s.DryRun(ctx, req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(wcd_manage.SiteRuleDryRunResp)
+	resp.Data = items
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ValidateSiteRule .
+// @router /api/v1/site_rule/validate [POST]
+func ValidateSiteRule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd_manage.ValidateSiteRuleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := manage.NewRuleManageService(ctx)
+	fieldErrors, matches, err := s.Validate(req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(wcd_manage.ValidateSiteRuleResp)
+	resp.Errors = fieldErrors
+	resp.Matches = matches
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ExplainSiteRuleMatch .
+// @router /api/v1/site_rule/match/explain [POST]
+func ExplainSiteRuleMatch(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd_manage.ExplainSiteRuleMatchReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := manage.NewRuleManageService(ctx)
+	resp, err := s.ExplainMatch(req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SuggestSiteRule .
+// @router /api/v1/site_rule/suggest [POST]
+func SuggestSiteRule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wcd_manage.SuggestSiteRuleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	s := wcd2.RuleSuggestService{}
+	resp, err := s.Suggest(ctx, req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -109,6 +109,110 @@ func (p *RuleStageGroupEnum) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type ParseJobStatus int64
+
+const (
+	ParseJobStatus_Pending   ParseJobStatus = 0
+	ParseJobStatus_Running   ParseJobStatus = 1
+	ParseJobStatus_Succeeded ParseJobStatus = 2
+	ParseJobStatus_Failed    ParseJobStatus = 3
+)
+
+func (p ParseJobStatus) String() string {
+	switch p {
+	case ParseJobStatus_Pending:
+		return "Pending"
+	case ParseJobStatus_Running:
+		return "Running"
+	case ParseJobStatus_Succeeded:
+		return "Succeeded"
+	case ParseJobStatus_Failed:
+		return "Failed"
+	}
+	return "<UNSET>"
+}
+
+func ParseJobStatusFromString(s string) (ParseJobStatus, error) {
+	switch s {
+	case "Pending":
+		return ParseJobStatus_Pending, nil
+	case "Running":
+		return ParseJobStatus_Running, nil
+	case "Succeeded":
+		return ParseJobStatus_Succeeded, nil
+	case "Failed":
+		return ParseJobStatus_Failed, nil
+	}
+	return ParseJobStatus(0), fmt.Errorf("not a valid ParseJobStatus string")
+}
+
+func ParseJobStatusPtr(v ParseJobStatus) *ParseJobStatus { return &v }
+func (p *ParseJobStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ParseJobStatus(result.Int64)
+	return
+}
+
+func (p *ParseJobStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ParseJobCallbackStatus int64
+
+const (
+	ParseJobCallbackStatus_None      ParseJobCallbackStatus = 0
+	ParseJobCallbackStatus_Pending   ParseJobCallbackStatus = 1
+	ParseJobCallbackStatus_Succeeded ParseJobCallbackStatus = 2
+	ParseJobCallbackStatus_Failed    ParseJobCallbackStatus = 3
+)
+
+func (p ParseJobCallbackStatus) String() string {
+	switch p {
+	case ParseJobCallbackStatus_None:
+		return "None"
+	case ParseJobCallbackStatus_Pending:
+		return "Pending"
+	case ParseJobCallbackStatus_Succeeded:
+		return "Succeeded"
+	case ParseJobCallbackStatus_Failed:
+		return "Failed"
+	}
+	return "<UNSET>"
+}
+
+func ParseJobCallbackStatusFromString(s string) (ParseJobCallbackStatus, error) {
+	switch s {
+	case "None":
+		return ParseJobCallbackStatus_None, nil
+	case "Pending":
+		return ParseJobCallbackStatus_Pending, nil
+	case "Succeeded":
+		return ParseJobCallbackStatus_Succeeded, nil
+	case "Failed":
+		return ParseJobCallbackStatus_Failed, nil
+	}
+	return ParseJobCallbackStatus(0), fmt.Errorf("not a valid ParseJobCallbackStatus string")
+}
+
+func ParseJobCallbackStatusPtr(v ParseJobCallbackStatus) *ParseJobCallbackStatus { return &v }
+func (p *ParseJobCallbackStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ParseJobCallbackStatus(result.Int64)
+	return
+}
+
+func (p *ParseJobCallbackStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type EmptyReq struct {
 }
 
@@ -327,61 +431,194 @@ func (p *VersionResp) String() string {
 
 }
 
-type WcdParseReq struct {
-	URL  string `thrift:"url,1" form:"url" json:"url" query:"url"`
-	HTML string `thrift:"html,2" form:"html" json:"html" query:"html"`
-	// 是否强制重新解析，不走缓存
-	Reparse *bool `thrift:"reparse,3,optional" form:"reparse" json:"reparse,omitempty" query:"reparse"`
-	// 规则组，默认为ProdOnly
-	RuleStageGroup *RuleStageGroupEnum `thrift:"rule_stage_group,4,optional" form:"rule_stage_group" json:"rule_stage_group,omitempty" query:"rule_stage_group"`
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+
+// 结构化正文中的一个块
+type ContentBlock struct {
+	// heading / paragraph / image / table / list / code / quote / reference / caption
+	Type string `thrift:"type,1" form:"type" json:"type" query:"type"`
+	// 纯文本，列表各项、表格各行以换行连接
+	Text string `thrift:"text,2" form:"text" json:"text" query:"text"`
+	// heading：标题层级，文章标题为1
+	Level *int32 `thrift:"level,3,optional" form:"level" json:"level,omitempty" query:"level"`
+	// image：图片链接
+	URL *string `thrift:"url,4,optional" form:"url" json:"url,omitempty" query:"url"`
+	// image：图片的alt
+	Alt *string `thrift:"alt,5,optional" form:"alt" json:"alt,omitempty" query:"alt"`
+	// image、table：图注或表格标题
+	Caption *string `thrift:"caption,6,optional" form:"caption" json:"caption,omitempty" query:"caption"`
+	// table：按行排列的单元格文本
+	Rows [][]string `thrift:"rows,7,optional" form:"rows" json:"rows,omitempty" query:"rows"`
+	// list：列表项
+	Items []string `thrift:"items,8,optional" form:"items" json:"items,omitempty" query:"items"`
+	// list：是否有序列表
+	Ordered *bool `thrift:"ordered,9,optional" form:"ordered" json:"ordered,omitempty" query:"ordered"`
+	// code：代码语言
+	Language *string `thrift:"language,10,optional" form:"language" json:"language,omitempty" query:"language"`
+	// 块内容对应的切分原子的position_id，按文档顺序
+	PositionIds []int32 `thrift:"position_ids,11" form:"position_ids" json:"position_ids" query:"position_ids"`
+	// 块内容对应的切分原子的xpath
+	Xpaths []string `thrift:"xpaths,12" form:"xpaths" json:"xpaths" query:"xpaths"`
 }
 
-func NewWcdParseReq() *WcdParseReq {
-	return &WcdParseReq{}
+func NewContentBlock() *ContentBlock {
+	return &ContentBlock{}
 }
 
-func (p *WcdParseReq) GetURL() (v string) {
-	return p.URL
+func (p *ContentBlock) GetType() (v string) {
+	return p.Type
 }
 
-func (p *WcdParseReq) GetHTML() (v string) {
-	return p.HTML
+func (p *ContentBlock) GetText() (v string) {
+	return p.Text
 }
 
-var WcdParseReq_Reparse_DEFAULT bool
+var ContentBlock_Level_DEFAULT int32
 
-func (p *WcdParseReq) GetReparse() (v bool) {
-	if !p.IsSetReparse() {
-		return WcdParseReq_Reparse_DEFAULT
+func (p *ContentBlock) GetLevel() (v int32) {
+	if !p.IsSetLevel() {
+		return ContentBlock_Level_DEFAULT
 	}
-	return *p.Reparse
+	return *p.Level
 }
 
-var WcdParseReq_RuleStageGroup_DEFAULT RuleStageGroupEnum
+var ContentBlock_URL_DEFAULT string
 
-func (p *WcdParseReq) GetRuleStageGroup() (v RuleStageGroupEnum) {
-	if !p.IsSetRuleStageGroup() {
-		return WcdParseReq_RuleStageGroup_DEFAULT
+func (p *ContentBlock) GetURL() (v string) {
+	if !p.IsSetURL() {
+		return ContentBlock_URL_DEFAULT
 	}
-	return *p.RuleStageGroup
+	return *p.URL
 }
 
-var fieldIDToName_WcdParseReq = map[int16]string{
-	1: "url",
-	2: "html",
-	3: "reparse",
-	4: "rule_stage_group",
+var ContentBlock_Alt_DEFAULT string
+
+func (p *ContentBlock) GetAlt() (v string) {
+	if !p.IsSetAlt() {
+		return ContentBlock_Alt_DEFAULT
+	}
+	return *p.Alt
 }
 
-func (p *WcdParseReq) IsSetReparse() bool {
-	return p.Reparse != nil
+var ContentBlock_Caption_DEFAULT string
+
+func (p *ContentBlock) GetCaption() (v string) {
+	if !p.IsSetCaption() {
+		return ContentBlock_Caption_DEFAULT
+	}
+	return *p.Caption
 }
 
-func (p *WcdParseReq) IsSetRuleStageGroup() bool {
-	return p.RuleStageGroup != nil
+var ContentBlock_Rows_DEFAULT [][]string
+
+func (p *ContentBlock) GetRows() (v [][]string) {
+	if !p.IsSetRows() {
+		return ContentBlock_Rows_DEFAULT
+	}
+	return p.Rows
 }
 
-func (p *WcdParseReq) Read(iprot thrift.TProtocol) (err error) {
+var ContentBlock_Items_DEFAULT []string
+
+func (p *ContentBlock) GetItems() (v []string) {
+	if !p.IsSetItems() {
+		return ContentBlock_Items_DEFAULT
+	}
+	return p.Items
+}
+
+var ContentBlock_Ordered_DEFAULT bool
+
+func (p *ContentBlock) GetOrdered() (v bool) {
+	if !p.IsSetOrdered() {
+		return ContentBlock_Ordered_DEFAULT
+	}
+	return *p.Ordered
+}
+
+var ContentBlock_Language_DEFAULT string
+
+func (p *ContentBlock) GetLanguage() (v string) {
+	if !p.IsSetLanguage() {
+		return ContentBlock_Language_DEFAULT
+	}
+	return *p.Language
+}
+
+func (p *ContentBlock) GetPositionIds() (v []int32) {
+	return p.PositionIds
+}
+
+func (p *ContentBlock) GetXpaths() (v []string) {
+	return p.Xpaths
+}
+
+var fieldIDToName_ContentBlock = map[int16]string{
+	1:  "type",
+	2:  "text",
+	3:  "level",
+	4:  "url",
+	5:  "alt",
+	6:  "caption",
+	7:  "rows",
+	8:  "items",
+	9:  "ordered",
+	10: "language",
+	11: "position_ids",
+	12: "xpaths",
+}
+
+func (p *ContentBlock) IsSetLevel() bool {
+	return p.Level != nil
+}
+
+func (p *ContentBlock) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *ContentBlock) IsSetAlt() bool {
+	return p.Alt != nil
+}
+
+func (p *ContentBlock) IsSetCaption() bool {
+	return p.Caption != nil
+}
+
+func (p *ContentBlock) IsSetRows() bool {
+	return p.Rows != nil
+}
+
+func (p *ContentBlock) IsSetItems() bool {
+	return p.Items != nil
+}
+
+func (p *ContentBlock) IsSetOrdered() bool {
+	return p.Ordered != nil
+}
+
+func (p *ContentBlock) IsSetLanguage() bool {
+	return p.Language != nil
+}
+
+func (p *ContentBlock) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -417,7 +654,7 @@ func (p *WcdParseReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -425,13 +662,77 @@ func (p *WcdParseReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -451,7 +752,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdParseReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentBlock[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -461,7 +762,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *WcdParseReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ContentBlock) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -469,10 +770,10 @@ func (p *WcdParseReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.URL = _field
+	p.Type = _field
 	return nil
 }
-func (p *WcdParseReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *ContentBlock) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -480,64 +781,244 @@ func (p *WcdParseReq) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.HTML = _field
+	p.Text = _field
 	return nil
 }
-func (p *WcdParseReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *ContentBlock) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Reparse = _field
+	p.Level = _field
 	return nil
 }
-func (p *WcdParseReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *ContentBlock) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *RuleStageGroupEnum
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		tmp := RuleStageGroupEnum(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.RuleStageGroup = _field
+	p.URL = _field
 	return nil
 }
+func (p *ContentBlock) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *WcdParseReq) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("WcdParseReq"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.Alt = _field
 	return nil
-WriteStructBeginError:
+}
+func (p *ContentBlock) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Caption = _field
+	return nil
+}
+func (p *ContentBlock) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([][]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		_, size, err := iprot.ReadListBegin()
+		if err != nil {
+			return err
+		}
+		_elem := make([]string, 0, size)
+		for i := 0; i < size; i++ {
+
+			var _elem1 string
+			if v, err := iprot.ReadString(); err != nil {
+				return err
+			} else {
+				_elem1 = v
+			}
+
+			_elem = append(_elem, _elem1)
+		}
+		if err := iprot.ReadListEnd(); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Rows = _field
+	return nil
+}
+func (p *ContentBlock) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Items = _field
+	return nil
+}
+func (p *ContentBlock) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Ordered = _field
+	return nil
+}
+func (p *ContentBlock) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Language = _field
+	return nil
+}
+func (p *ContentBlock) ReadField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int32, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PositionIds = _field
+	return nil
+}
+func (p *ContentBlock) ReadField12(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Xpaths = _field
+	return nil
+}
+
+func (p *ContentBlock) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContentBlock"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 WriteFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
@@ -547,11 +1028,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *WcdParseReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+func (p *ContentBlock) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.URL); err != nil {
+	if err := oprot.WriteString(p.Type); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -564,11 +1045,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *WcdParseReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("html", thrift.STRING, 2); err != nil {
+func (p *ContentBlock) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.HTML); err != nil {
+	if err := oprot.WriteString(p.Text); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -581,12 +1062,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *WcdParseReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReparse() {
-		if err = oprot.WriteFieldBegin("reparse", thrift.BOOL, 3); err != nil {
+func (p *ContentBlock) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLevel() {
+		if err = oprot.WriteFieldBegin("level", thrift.I32, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.Reparse); err != nil {
+		if err := oprot.WriteI32(*p.Level); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -600,12 +1081,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *WcdParseReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRuleStageGroup() {
-		if err = oprot.WriteFieldBegin("rule_stage_group", thrift.I32, 4); err != nil {
+func (p *ContentBlock) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL() {
+		if err = oprot.WriteFieldBegin("url", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.RuleStageGroup)); err != nil {
+		if err := oprot.WriteString(*p.URL); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -619,198 +1100,298 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *WcdParseReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ContentBlock) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlt() {
+		if err = oprot.WriteFieldBegin("alt", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("WcdParseReq(%+v)", *p)
-
-}
-
-type WcdParseResp struct {
-	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	URL  string `thrift:"url,3" form:"url" json:"url" query:"url"`
-	Text string `thrift:"text,4" form:"text" json:"text" query:"text"`
-	// 正文图片url列表
-	Images []string `thrift:"images,5" form:"images" json:"images" query:"images"`
-	// 正文html，给阅读器
-	ReadableHTML   string `thrift:"readable_html,6" form:"readable_html" json:"readable_html" query:"readable_html"`
-	Title          string `thrift:"title,7" form:"title" json:"title" query:"title"`
-	Author         string `thrift:"author,8" form:"author" json:"author" query:"author"`
-	ContentSource  string `thrift:"content_source,9" form:"content_source" json:"content_source" query:"content_source"`
-	PubTime        string `thrift:"pub_time,10" form:"pub_time" json:"pub_time" query:"pub_time"`
-	ModelInputStr  string `thrift:"model_input_str,11" form:"model_input_str" json:"model_input_str" query:"model_input_str"`
-	ModelResultStr string `thrift:"model_result_str,12" form:"model_result_str" json:"model_result_str" query:"model_result_str"`
-	// 是否无意义
-	Worthless    bool   `thrift:"worthless,13" form:"worthless" json:"worthless" query:"worthless"`
-	WorthType    int32  `thrift:"worth_type,14" form:"worth_type" json:"worth_type" query:"worth_type"`
-	WcdRequestID string `thrift:"wcd_request_id,15" form:"wcd_request_id" json:"wcd_request_id" query:"wcd_request_id"`
-	// 原始html
-	RawHTML *string `thrift:"raw_html,19,optional" form:"raw_html" json:"raw_html,omitempty" query:"raw_html"`
-	// 作者信息
-	AuthorMeta *ArticleAuthorMeta `thrift:"author_meta,21,optional" form:"author_meta" json:"author_meta,omitempty" query:"author_meta"`
-	// 网站图标
-	SiteIcon *string `thrift:"site_icon,22,optional" form:"site_icon" json:"site_icon,omitempty" query:"site_icon"`
-	// 网页描述
-	Description *string `thrift:"description,23,optional" form:"description" json:"description,omitempty" query:"description"`
-	// 封面图
-	SurfaceImage *string `thrift:"surface_image,24,optional" form:"surface_image" json:"surface_image,omitempty" query:"surface_image"`
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func NewWcdParseResp() *WcdParseResp {
-	return &WcdParseResp{}
+func (p *ContentBlock) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCaption() {
+		if err = oprot.WriteFieldBegin("caption", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Caption); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *WcdParseResp) GetCode() (v int32) {
-	return p.Code
-}
-
-func (p *WcdParseResp) GetMsg() (v string) {
-	return p.Msg
-}
-
-func (p *WcdParseResp) GetURL() (v string) {
-	return p.URL
-}
-
-func (p *WcdParseResp) GetText() (v string) {
-	return p.Text
-}
-
-func (p *WcdParseResp) GetImages() (v []string) {
-	return p.Images
+func (p *ContentBlock) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRows() {
+		if err = oprot.WriteFieldBegin("rows", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.LIST, len(p.Rows)); err != nil {
+			return err
+		}
+		for _, v := range p.Rows {
+			if err := oprot.WriteListBegin(thrift.STRING, len(v)); err != nil {
+				return err
+			}
+			for _, v := range v {
+				if err := oprot.WriteString(v); err != nil {
+					return err
+				}
+			}
+			if err := oprot.WriteListEnd(); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *WcdParseResp) GetReadableHTML() (v string) {
-	return p.ReadableHTML
+func (p *ContentBlock) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItems() {
+		if err = oprot.WriteFieldBegin("items", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Items)); err != nil {
+			return err
+		}
+		for _, v := range p.Items {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *WcdParseResp) GetTitle() (v string) {
-	return p.Title
+func (p *ContentBlock) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrdered() {
+		if err = oprot.WriteFieldBegin("ordered", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Ordered); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *WcdParseResp) GetAuthor() (v string) {
-	return p.Author
+func (p *ContentBlock) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLanguage() {
+		if err = oprot.WriteFieldBegin("language", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Language); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *WcdParseResp) GetContentSource() (v string) {
-	return p.ContentSource
+func (p *ContentBlock) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("position_ids", thrift.LIST, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I32, len(p.PositionIds)); err != nil {
+		return err
+	}
+	for _, v := range p.PositionIds {
+		if err := oprot.WriteI32(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *WcdParseResp) GetPubTime() (v string) {
-	return p.PubTime
+func (p *ContentBlock) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("xpaths", thrift.LIST, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Xpaths)); err != nil {
+		return err
+	}
+	for _, v := range p.Xpaths {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *WcdParseResp) GetModelInputStr() (v string) {
-	return p.ModelInputStr
-}
+func (p *ContentBlock) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContentBlock(%+v)", *p)
 
-func (p *WcdParseResp) GetModelResultStr() (v string) {
-	return p.ModelResultStr
 }
 
-func (p *WcdParseResp) GetWorthless() (v bool) {
-	return p.Worthless
+type ImageInfo struct {
+	URL string `thrift:"url,1" form:"url" json:"url" query:"url"`
+	// 来自标签的width、style或图片头部，未知时为空
+	Width  *int32 `thrift:"width,2,optional" form:"width" json:"width,omitempty" query:"width"`
+	Height *int32 `thrift:"height,3,optional" form:"height" json:"height,omitempty" query:"height"`
+	// 来自图片内容，未下载时按扩展名判断
+	MimeType *string `thrift:"mime_type,4,optional" form:"mime_type" json:"mime_type,omitempty" query:"mime_type"`
+	// alt，没有时为title
+	Alt *string `thrift:"alt,5,optional" form:"alt" json:"alt,omitempty" query:"alt"`
+	// 所在figure的图注
+	Caption *string `thrift:"caption,6,optional" form:"caption" json:"caption,omitempty" query:"caption"`
 }
 
-func (p *WcdParseResp) GetWorthType() (v int32) {
-	return p.WorthType
+func NewImageInfo() *ImageInfo {
+	return &ImageInfo{}
 }
 
-func (p *WcdParseResp) GetWcdRequestID() (v string) {
-	return p.WcdRequestID
+func (p *ImageInfo) GetURL() (v string) {
+	return p.URL
 }
 
-var WcdParseResp_RawHTML_DEFAULT string
+var ImageInfo_Width_DEFAULT int32
 
-func (p *WcdParseResp) GetRawHTML() (v string) {
-	if !p.IsSetRawHTML() {
-		return WcdParseResp_RawHTML_DEFAULT
+func (p *ImageInfo) GetWidth() (v int32) {
+	if !p.IsSetWidth() {
+		return ImageInfo_Width_DEFAULT
 	}
-	return *p.RawHTML
+	return *p.Width
 }
 
-var WcdParseResp_AuthorMeta_DEFAULT *ArticleAuthorMeta
+var ImageInfo_Height_DEFAULT int32
 
-func (p *WcdParseResp) GetAuthorMeta() (v *ArticleAuthorMeta) {
-	if !p.IsSetAuthorMeta() {
-		return WcdParseResp_AuthorMeta_DEFAULT
+func (p *ImageInfo) GetHeight() (v int32) {
+	if !p.IsSetHeight() {
+		return ImageInfo_Height_DEFAULT
 	}
-	return p.AuthorMeta
+	return *p.Height
 }
 
-var WcdParseResp_SiteIcon_DEFAULT string
+var ImageInfo_MimeType_DEFAULT string
 
-func (p *WcdParseResp) GetSiteIcon() (v string) {
-	if !p.IsSetSiteIcon() {
-		return WcdParseResp_SiteIcon_DEFAULT
+func (p *ImageInfo) GetMimeType() (v string) {
+	if !p.IsSetMimeType() {
+		return ImageInfo_MimeType_DEFAULT
 	}
-	return *p.SiteIcon
+	return *p.MimeType
 }
 
-var WcdParseResp_Description_DEFAULT string
+var ImageInfo_Alt_DEFAULT string
 
-func (p *WcdParseResp) GetDescription() (v string) {
-	if !p.IsSetDescription() {
-		return WcdParseResp_Description_DEFAULT
+func (p *ImageInfo) GetAlt() (v string) {
+	if !p.IsSetAlt() {
+		return ImageInfo_Alt_DEFAULT
 	}
-	return *p.Description
+	return *p.Alt
 }
 
-var WcdParseResp_SurfaceImage_DEFAULT string
+var ImageInfo_Caption_DEFAULT string
 
-func (p *WcdParseResp) GetSurfaceImage() (v string) {
-	if !p.IsSetSurfaceImage() {
-		return WcdParseResp_SurfaceImage_DEFAULT
+func (p *ImageInfo) GetCaption() (v string) {
+	if !p.IsSetCaption() {
+		return ImageInfo_Caption_DEFAULT
 	}
-	return *p.SurfaceImage
+	return *p.Caption
 }
 
-var fieldIDToName_WcdParseResp = map[int16]string{
-	1:  "code",
-	2:  "msg",
-	3:  "url",
-	4:  "text",
-	5:  "images",
-	6:  "readable_html",
-	7:  "title",
-	8:  "author",
-	9:  "content_source",
-	10: "pub_time",
-	11: "model_input_str",
-	12: "model_result_str",
-	13: "worthless",
-	14: "worth_type",
-	15: "wcd_request_id",
-	19: "raw_html",
-	21: "author_meta",
-	22: "site_icon",
-	23: "description",
-	24: "surface_image",
+var fieldIDToName_ImageInfo = map[int16]string{
+	1: "url",
+	2: "width",
+	3: "height",
+	4: "mime_type",
+	5: "alt",
+	6: "caption",
 }
 
-func (p *WcdParseResp) IsSetRawHTML() bool {
-	return p.RawHTML != nil
+func (p *ImageInfo) IsSetWidth() bool {
+	return p.Width != nil
 }
 
-func (p *WcdParseResp) IsSetAuthorMeta() bool {
-	return p.AuthorMeta != nil
+func (p *ImageInfo) IsSetHeight() bool {
+	return p.Height != nil
 }
 
-func (p *WcdParseResp) IsSetSiteIcon() bool {
-	return p.SiteIcon != nil
+func (p *ImageInfo) IsSetMimeType() bool {
+	return p.MimeType != nil
 }
 
-func (p *WcdParseResp) IsSetDescription() bool {
-	return p.Description != nil
+func (p *ImageInfo) IsSetAlt() bool {
+	return p.Alt != nil
 }
 
-func (p *WcdParseResp) IsSetSurfaceImage() bool {
-	return p.SurfaceImage != nil
+func (p *ImageInfo) IsSetCaption() bool {
+	return p.Caption != nil
 }
 
-func (p *WcdParseResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ImageInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -830,7 +1411,7 @@ func (p *WcdParseResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -838,7 +1419,7 @@ func (p *WcdParseResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -846,7 +1427,7 @@ func (p *WcdParseResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -862,7 +1443,7 @@ func (p *WcdParseResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -877,120 +1458,8 @@ func (p *WcdParseResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 13:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField13(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 14:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField14(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 15:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField15(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 19:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField19(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 21:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField21(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 22:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField22(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 23:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField23(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 24:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField24(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
@@ -1008,7 +1477,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdParseResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1018,29 +1487,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *WcdParseResp) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Code = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Msg = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField3(iprot thrift.TProtocol) error {
+func (p *ImageInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1051,170 +1498,29 @@ func (p *WcdParseResp) ReadField3(iprot thrift.TProtocol) error {
 	p.URL = _field
 	return nil
 }
-func (p *WcdParseResp) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Text = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Images = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ReadableHTML = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Title = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Author = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField9(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ContentSource = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField10(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.PubTime = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField11(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ModelInputStr = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField12(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ModelResultStr = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField13(iprot thrift.TProtocol) error {
-
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Worthless = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField14(iprot thrift.TProtocol) error {
+func (p *ImageInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int32
+	var _field *int32
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
-	}
-	p.WorthType = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField15(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+		_field = &v
 	}
-	p.WcdRequestID = _field
+	p.Width = _field
 	return nil
 }
-func (p *WcdParseResp) ReadField19(iprot thrift.TProtocol) error {
+func (p *ImageInfo) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.RawHTML = _field
-	return nil
-}
-func (p *WcdParseResp) ReadField21(iprot thrift.TProtocol) error {
-	_field := NewArticleAuthorMeta()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.AuthorMeta = _field
+	p.Height = _field
 	return nil
 }
-func (p *WcdParseResp) ReadField22(iprot thrift.TProtocol) error {
+func (p *ImageInfo) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1222,10 +1528,10 @@ func (p *WcdParseResp) ReadField22(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.SiteIcon = _field
+	p.MimeType = _field
 	return nil
 }
-func (p *WcdParseResp) ReadField23(iprot thrift.TProtocol) error {
+func (p *ImageInfo) ReadField5(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1233,10 +1539,10 @@ func (p *WcdParseResp) ReadField23(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Description = _field
+	p.Alt = _field
 	return nil
 }
-func (p *WcdParseResp) ReadField24(iprot thrift.TProtocol) error {
+func (p *ImageInfo) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1244,13 +1550,13 @@ func (p *WcdParseResp) ReadField24(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.SurfaceImage = _field
+	p.Caption = _field
 	return nil
 }
 
-func (p *WcdParseResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ImageInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("WcdParseResp"); err != nil {
+	if err = oprot.WriteStructBegin("ImageInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1278,62 +1584,6 @@ func (p *WcdParseResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
-		if err = p.writeField13(oprot); err != nil {
-			fieldId = 13
-			goto WriteFieldError
-		}
-		if err = p.writeField14(oprot); err != nil {
-			fieldId = 14
-			goto WriteFieldError
-		}
-		if err = p.writeField15(oprot); err != nil {
-			fieldId = 15
-			goto WriteFieldError
-		}
-		if err = p.writeField19(oprot); err != nil {
-			fieldId = 19
-			goto WriteFieldError
-		}
-		if err = p.writeField21(oprot); err != nil {
-			fieldId = 21
-			goto WriteFieldError
-		}
-		if err = p.writeField22(oprot); err != nil {
-			fieldId = 22
-			goto WriteFieldError
-		}
-		if err = p.writeField23(oprot); err != nil {
-			fieldId = 23
-			goto WriteFieldError
-		}
-		if err = p.writeField24(oprot); err != nil {
-			fieldId = 24
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1352,11 +1602,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *WcdParseResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+func (p *ImageInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Code); err != nil {
+	if err := oprot.WriteString(p.URL); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1369,15 +1619,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *WcdParseResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Msg); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ImageInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWidth() {
+		if err = oprot.WriteFieldBegin("width", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Width); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1386,15 +1638,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *WcdParseResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.URL); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ImageInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeight() {
+		if err = oprot.WriteFieldBegin("height", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Height); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1403,15 +1657,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *WcdParseResp) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("text", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Text); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ImageInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMimeType() {
+		if err = oprot.WriteFieldBegin("mime_type", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.MimeType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1420,23 +1676,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *WcdParseResp) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("images", thrift.LIST, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Images)); err != nil {
-		return err
-	}
-	for _, v := range p.Images {
-		if err := oprot.WriteString(v); err != nil {
+func (p *ImageInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlt() {
+		if err = oprot.WriteFieldBegin("alt", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alt); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1445,15 +1695,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *WcdParseResp) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("readable_html", thrift.STRING, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ReadableHTML); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ImageInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCaption() {
+		if err = oprot.WriteFieldBegin("caption", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Caption); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1462,323 +1714,117 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *WcdParseResp) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Title); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ImageInfo) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return fmt.Sprintf("ImageInfo(%+v)", *p)
+
 }
 
-func (p *WcdParseResp) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("author", thrift.STRING, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Author); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+type WcdParseReq struct {
+	URL  string `thrift:"url,1" form:"url" json:"url" query:"url"`
+	HTML string `thrift:"html,2" form:"html" json:"html" query:"html"`
+	// 是否强制重新解析，不读取解析结果缓存
+	Reparse *bool `thrift:"reparse,3,optional" form:"reparse" json:"reparse,omitempty" query:"reparse"`
+	// 规则组，默认为ProdOnly
+	RuleStageGroup *RuleStageGroupEnum `thrift:"rule_stage_group,4,optional" form:"rule_stage_group" json:"rule_stage_group,omitempty" query:"rule_stage_group"`
+	// 指定标注器，为空时依次使用站点规则、全局配置中的标注器
+	Labeler *string `thrift:"labeler,5,optional" form:"labeler" json:"labeler,omitempty" query:"labeler"`
+	// 除text和readable_html之外，额外返回的格式：markdown、blocks
+	OutputFormats []string `thrift:"output_formats,6,optional" form:"output_formats" json:"output_formats,omitempty" query:"output_formats"`
+	// 是否把正文图片保存到对象存储并替换为镜像地址，为空时使用全局配置
+	MirrorImages *bool `thrift:"mirror_images,7,optional" form:"mirror_images" json:"mirror_images,omitempty" query:"mirror_images"`
 }
 
-func (p *WcdParseResp) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content_source", thrift.STRING, 9); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ContentSource); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+func NewWcdParseReq() *WcdParseReq {
+	return &WcdParseReq{}
 }
 
-func (p *WcdParseResp) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("pub_time", thrift.STRING, 10); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.PubTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+func (p *WcdParseReq) GetURL() (v string) {
+	return p.URL
 }
 
-func (p *WcdParseResp) writeField11(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("model_input_str", thrift.STRING, 11); err != nil {
-		goto WriteFieldBeginError
+func (p *WcdParseReq) GetHTML() (v string) {
+	return p.HTML
+}
+
+var WcdParseReq_Reparse_DEFAULT bool
+
+func (p *WcdParseReq) GetReparse() (v bool) {
+	if !p.IsSetReparse() {
+		return WcdParseReq_Reparse_DEFAULT
 	}
-	if err := oprot.WriteString(p.ModelInputStr); err != nil {
-		return err
+	return *p.Reparse
+}
+
+var WcdParseReq_RuleStageGroup_DEFAULT RuleStageGroupEnum
+
+func (p *WcdParseReq) GetRuleStageGroup() (v RuleStageGroupEnum) {
+	if !p.IsSetRuleStageGroup() {
+		return WcdParseReq_RuleStageGroup_DEFAULT
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	return *p.RuleStageGroup
+}
+
+var WcdParseReq_Labeler_DEFAULT string
+
+func (p *WcdParseReq) GetLabeler() (v string) {
+	if !p.IsSetLabeler() {
+		return WcdParseReq_Labeler_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return *p.Labeler
 }
 
-func (p *WcdParseResp) writeField12(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("model_result_str", thrift.STRING, 12); err != nil {
-		goto WriteFieldBeginError
+var WcdParseReq_OutputFormats_DEFAULT []string
+
+func (p *WcdParseReq) GetOutputFormats() (v []string) {
+	if !p.IsSetOutputFormats() {
+		return WcdParseReq_OutputFormats_DEFAULT
 	}
-	if err := oprot.WriteString(p.ModelResultStr); err != nil {
-		return err
+	return p.OutputFormats
+}
+
+var WcdParseReq_MirrorImages_DEFAULT bool
+
+func (p *WcdParseReq) GetMirrorImages() (v bool) {
+	if !p.IsSetMirrorImages() {
+		return WcdParseReq_MirrorImages_DEFAULT
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
-}
-
-func (p *WcdParseResp) writeField13(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("worthless", thrift.BOOL, 13); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteBool(p.Worthless); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
-}
-
-func (p *WcdParseResp) writeField14(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("worth_type", thrift.I32, 14); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.WorthType); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
-}
-
-func (p *WcdParseResp) writeField15(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("wcd_request_id", thrift.STRING, 15); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.WcdRequestID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
-}
-
-func (p *WcdParseResp) writeField19(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRawHTML() {
-		if err = oprot.WriteFieldBegin("raw_html", thrift.STRING, 19); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.RawHTML); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
-}
-
-func (p *WcdParseResp) writeField21(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAuthorMeta() {
-		if err = oprot.WriteFieldBegin("author_meta", thrift.STRUCT, 21); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.AuthorMeta.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
-}
-
-func (p *WcdParseResp) writeField22(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSiteIcon() {
-		if err = oprot.WriteFieldBegin("site_icon", thrift.STRING, 22); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.SiteIcon); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 22 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
-}
-
-func (p *WcdParseResp) writeField23(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 23); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Description); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
-}
-
-func (p *WcdParseResp) writeField24(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSurfaceImage() {
-		if err = oprot.WriteFieldBegin("surface_image", thrift.STRING, 24); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.SurfaceImage); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
-}
-
-func (p *WcdParseResp) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("WcdParseResp(%+v)", *p)
-
+	return *p.MirrorImages
 }
 
-type AtomicText struct {
-	Text       string `thrift:"text,1" form:"text" json:"text" query:"text"`
-	PositionID int32  `thrift:"position_id,2" form:"position_id" json:"position_id" query:"position_id"`
-	// xpath
-	Xpath     string `thrift:"xpath,3" form:"xpath" json:"xpath" query:"xpath"`
-	Immutable bool   `thrift:"immutable,7" form:"immutable" json:"immutable" query:"immutable"`
-	// 原始标签
-	Tags      []string          `thrift:"tags,8" form:"tags" json:"tags" query:"tags"`
-	Tail      bool              `thrift:"tail,9" form:"tail" json:"tail" query:"tail"`
-	SegmentID int32             `thrift:"segment_id,10" form:"segment_id" json:"segment_id" query:"segment_id"`
-	Attrs     map[string]string `thrift:"attrs,11" form:"attrs" json:"attrs" query:"attrs"`
-}
-
-func NewAtomicText() *AtomicText {
-	return &AtomicText{}
-}
-
-func (p *AtomicText) GetText() (v string) {
-	return p.Text
-}
-
-func (p *AtomicText) GetPositionID() (v int32) {
-	return p.PositionID
-}
-
-func (p *AtomicText) GetXpath() (v string) {
-	return p.Xpath
-}
-
-func (p *AtomicText) GetImmutable() (v bool) {
-	return p.Immutable
+var fieldIDToName_WcdParseReq = map[int16]string{
+	1: "url",
+	2: "html",
+	3: "reparse",
+	4: "rule_stage_group",
+	5: "labeler",
+	6: "output_formats",
+	7: "mirror_images",
 }
 
-func (p *AtomicText) GetTags() (v []string) {
-	return p.Tags
+func (p *WcdParseReq) IsSetReparse() bool {
+	return p.Reparse != nil
 }
 
-func (p *AtomicText) GetTail() (v bool) {
-	return p.Tail
+func (p *WcdParseReq) IsSetRuleStageGroup() bool {
+	return p.RuleStageGroup != nil
 }
 
-func (p *AtomicText) GetSegmentID() (v int32) {
-	return p.SegmentID
+func (p *WcdParseReq) IsSetLabeler() bool {
+	return p.Labeler != nil
 }
 
-func (p *AtomicText) GetAttrs() (v map[string]string) {
-	return p.Attrs
+func (p *WcdParseReq) IsSetOutputFormats() bool {
+	return p.OutputFormats != nil
 }
 
-var fieldIDToName_AtomicText = map[int16]string{
-	1:  "text",
-	2:  "position_id",
-	3:  "xpath",
-	7:  "immutable",
-	8:  "tags",
-	9:  "tail",
-	10: "segment_id",
-	11: "attrs",
+func (p *WcdParseReq) IsSetMirrorImages() bool {
+	return p.MirrorImages != nil
 }
 
-func (p *AtomicText) Read(iprot thrift.TProtocol) (err error) {
+func (p *WcdParseReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1806,7 +1852,7 @@ func (p *AtomicText) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1814,48 +1860,40 @@ func (p *AtomicText) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
 			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField7(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 8:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField8(iprot); err != nil {
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 9:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField9(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField10(iprot); err != nil {
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 11:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField11(iprot); err != nil {
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -1880,7 +1918,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AtomicText[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdParseReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1890,7 +1928,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AtomicText) ReadField1(iprot thrift.TProtocol) error {
+func (p *WcdParseReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1898,43 +1936,55 @@ func (p *AtomicText) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Text = _field
+	p.URL = _field
 	return nil
 }
-func (p *AtomicText) ReadField2(iprot thrift.TProtocol) error {
+func (p *WcdParseReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PositionID = _field
+	p.HTML = _field
 	return nil
 }
-func (p *AtomicText) ReadField3(iprot thrift.TProtocol) error {
+func (p *WcdParseReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Xpath = _field
+	p.Reparse = _field
 	return nil
 }
-func (p *AtomicText) ReadField7(iprot thrift.TProtocol) error {
+func (p *WcdParseReq) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field *RuleStageGroupEnum
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
+		tmp := RuleStageGroupEnum(v)
+		_field = &tmp
 	}
-	p.Immutable = _field
+	p.RuleStageGroup = _field
 	return nil
 }
-func (p *AtomicText) ReadField8(iprot thrift.TProtocol) error {
+func (p *WcdParseReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Labeler = _field
+	return nil
+}
+func (p *WcdParseReq) ReadField6(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -1954,64 +2004,24 @@ func (p *AtomicText) ReadField8(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Tags = _field
+	p.OutputFormats = _field
 	return nil
 }
-func (p *AtomicText) ReadField9(iprot thrift.TProtocol) error {
+func (p *WcdParseReq) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field bool
+	var _field *bool
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
-		_field = v
-	}
-	p.Tail = _field
-	return nil
-}
-func (p *AtomicText) ReadField10(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.SegmentID = _field
-	return nil
-}
-func (p *AtomicText) ReadField11(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
+		_field = &v
 	}
-	p.Attrs = _field
+	p.MirrorImages = _field
 	return nil
 }
 
-func (p *AtomicText) Write(oprot thrift.TProtocol) (err error) {
+func (p *WcdParseReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AtomicText"); err != nil {
+	if err = oprot.WriteStructBegin("WcdParseReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2027,24 +2037,20 @@ func (p *AtomicText) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
 			goto WriteFieldError
 		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
 			goto WriteFieldError
 		}
 	}
@@ -2065,11 +2071,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AtomicText) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("text", thrift.STRING, 1); err != nil {
+func (p *WcdParseReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Text); err != nil {
+	if err := oprot.WriteString(p.URL); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2082,11 +2088,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AtomicText) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("position_id", thrift.I32, 2); err != nil {
+func (p *WcdParseReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("html", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.PositionID); err != nil {
+	if err := oprot.WriteString(p.HTML); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2099,15 +2105,17 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AtomicText) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("xpath", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Xpath); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *WcdParseReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReparse() {
+		if err = oprot.WriteFieldBegin("reparse", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Reparse); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -2116,177 +2124,410 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *AtomicText) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("immutable", thrift.BOOL, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteBool(p.Immutable); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *WcdParseReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleStageGroup() {
+		if err = oprot.WriteFieldBegin("rule_stage_group", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.RuleStageGroup)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *AtomicText) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-		return err
-	}
-	for _, v := range p.Tags {
-		if err := oprot.WriteString(v); err != nil {
+func (p *WcdParseReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabeler() {
+		if err = oprot.WriteFieldBegin("labeler", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Labeler); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *AtomicText) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tail", thrift.BOOL, 9); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteBool(p.Tail); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *WcdParseReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputFormats() {
+		if err = oprot.WriteFieldBegin("output_formats", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.OutputFormats)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputFormats {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *AtomicText) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("segment_id", thrift.I32, 10); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.SegmentID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *WcdParseReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMirrorImages() {
+		if err = oprot.WriteFieldBegin("mirror_images", thrift.BOOL, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.MirrorImages); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *AtomicText) writeField11(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("attrs", thrift.MAP, 11); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attrs)); err != nil {
-		return err
-	}
-	for k, v := range p.Attrs {
-		if err := oprot.WriteString(k); err != nil {
-			return err
-		}
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
+func (p *WcdParseReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteMapEnd(); err != nil {
-		return err
+	return fmt.Sprintf("WcdParseReq(%+v)", *p)
+
+}
+
+type WcdParseResp struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	URL  string `thrift:"url,3" form:"url" json:"url" query:"url"`
+	Text string `thrift:"text,4" form:"text" json:"text" query:"text"`
+	// 正文图片url列表
+	Images []string `thrift:"images,5" form:"images" json:"images" query:"images"`
+	// 正文html，给阅读器
+	ReadableHTML   string `thrift:"readable_html,6" form:"readable_html" json:"readable_html" query:"readable_html"`
+	Title          string `thrift:"title,7" form:"title" json:"title" query:"title"`
+	Author         string `thrift:"author,8" form:"author" json:"author" query:"author"`
+	ContentSource  string `thrift:"content_source,9" form:"content_source" json:"content_source" query:"content_source"`
+	PubTime        string `thrift:"pub_time,10" form:"pub_time" json:"pub_time" query:"pub_time"`
+	ModelInputStr  string `thrift:"model_input_str,11" form:"model_input_str" json:"model_input_str" query:"model_input_str"`
+	ModelResultStr string `thrift:"model_result_str,12" form:"model_result_str" json:"model_result_str" query:"model_result_str"`
+	// 是否无意义
+	Worthless    bool   `thrift:"worthless,13" form:"worthless" json:"worthless" query:"worthless"`
+	WorthType    int32  `thrift:"worth_type,14" form:"worth_type" json:"worth_type" query:"worth_type"`
+	WcdRequestID string `thrift:"wcd_request_id,15" form:"wcd_request_id" json:"wcd_request_id" query:"wcd_request_id"`
+	// 原始html
+	RawHTML *string `thrift:"raw_html,19,optional" form:"raw_html" json:"raw_html,omitempty" query:"raw_html"`
+	// 作者信息
+	AuthorMeta *ArticleAuthorMeta `thrift:"author_meta,21,optional" form:"author_meta" json:"author_meta,omitempty" query:"author_meta"`
+	// 网站图标
+	SiteIcon *string `thrift:"site_icon,22,optional" form:"site_icon" json:"site_icon,omitempty" query:"site_icon"`
+	// 网页描述
+	Description *string `thrift:"description,23,optional" form:"description" json:"description,omitempty" query:"description"`
+	// 封面图
+	SurfaceImage *string `thrift:"surface_image,24,optional" form:"surface_image" json:"surface_image,omitempty" query:"surface_image"`
+	// 实际使用的标注器
+	Labeler *string `thrift:"labeler,25,optional" form:"labeler" json:"labeler,omitempty" query:"labeler"`
+	// 正文markdown，output_formats包含markdown时返回
+	Markdown *string `thrift:"markdown,26,optional" form:"markdown" json:"markdown,omitempty" query:"markdown"`
+	// 结构化正文，output_formats包含blocks时返回
+	Blocks []*ContentBlock `thrift:"blocks,27,optional" form:"blocks" json:"blocks,omitempty" query:"blocks"`
+	// 抓取过程，传入html时为空
+	CrawlAttempts []*CrawlAttempt `thrift:"crawl_attempts,28,optional" form:"crawl_attempts" json:"crawl_attempts,omitempty" query:"crawl_attempts"`
+	// 使用的网页的抓取信息，传入html时为空
+	CrawlMeta *CrawlMeta `thrift:"crawl_meta,29,optional" form:"crawl_meta" json:"crawl_meta,omitempty" query:"crawl_meta"`
+	// 解析结果是否来自缓存
+	FromCache *bool `thrift:"from_cache,30,optional" form:"from_cache" json:"from_cache,omitempty" query:"from_cache"`
+	// 图片原地址到镜像地址，开启图片镜像时返回，下载失败的图片不包含在内
+	MirroredImages map[string]string `thrift:"mirrored_images,31,optional" form:"mirrored_images" json:"mirrored_images,omitempty" query:"mirrored_images"`
+	// 正文图片的尺寸、格式和图注，与readable_html中的图片顺序一致
+	ImageInfos []*ImageInfo `thrift:"image_infos,32,optional" form:"image_infos" json:"image_infos,omitempty" query:"image_infos"`
+}
+
+func NewWcdParseResp() *WcdParseResp {
+	return &WcdParseResp{}
+}
+
+func (p *WcdParseResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *WcdParseResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *WcdParseResp) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *WcdParseResp) GetText() (v string) {
+	return p.Text
+}
+
+func (p *WcdParseResp) GetImages() (v []string) {
+	return p.Images
+}
+
+func (p *WcdParseResp) GetReadableHTML() (v string) {
+	return p.ReadableHTML
+}
+
+func (p *WcdParseResp) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *WcdParseResp) GetAuthor() (v string) {
+	return p.Author
+}
+
+func (p *WcdParseResp) GetContentSource() (v string) {
+	return p.ContentSource
+}
+
+func (p *WcdParseResp) GetPubTime() (v string) {
+	return p.PubTime
+}
+
+func (p *WcdParseResp) GetModelInputStr() (v string) {
+	return p.ModelInputStr
+}
+
+func (p *WcdParseResp) GetModelResultStr() (v string) {
+	return p.ModelResultStr
+}
+
+func (p *WcdParseResp) GetWorthless() (v bool) {
+	return p.Worthless
+}
+
+func (p *WcdParseResp) GetWorthType() (v int32) {
+	return p.WorthType
+}
+
+func (p *WcdParseResp) GetWcdRequestID() (v string) {
+	return p.WcdRequestID
+}
+
+var WcdParseResp_RawHTML_DEFAULT string
+
+func (p *WcdParseResp) GetRawHTML() (v string) {
+	if !p.IsSetRawHTML() {
+		return WcdParseResp_RawHTML_DEFAULT
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	return *p.RawHTML
+}
+
+var WcdParseResp_AuthorMeta_DEFAULT *ArticleAuthorMeta
+
+func (p *WcdParseResp) GetAuthorMeta() (v *ArticleAuthorMeta) {
+	if !p.IsSetAuthorMeta() {
+		return WcdParseResp_AuthorMeta_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return p.AuthorMeta
 }
 
-func (p *AtomicText) String() string {
-	if p == nil {
-		return "<nil>"
+var WcdParseResp_SiteIcon_DEFAULT string
+
+func (p *WcdParseResp) GetSiteIcon() (v string) {
+	if !p.IsSetSiteIcon() {
+		return WcdParseResp_SiteIcon_DEFAULT
 	}
-	return fmt.Sprintf("AtomicText(%+v)", *p)
+	return *p.SiteIcon
+}
+
+var WcdParseResp_Description_DEFAULT string
 
+func (p *WcdParseResp) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return WcdParseResp_Description_DEFAULT
+	}
+	return *p.Description
 }
 
-type SentenceMeta struct {
-	URL         string   `thrift:"url,1" form:"url" json:"url" query:"url"`
-	TableHTML   string   `thrift:"table_html,2" form:"table_html" json:"table_html" query:"table_html"`
-	Captions    []int32  `thrift:"captions,3" form:"captions" json:"captions" query:"captions"`
-	CaptionTxts []string `thrift:"caption_txts,4" form:"caption_txts" json:"caption_txts" query:"caption_txts"`
-	Type        int32    `thrift:"type,5" form:"type" json:"type" query:"type"`
-	TitleType   string   `thrift:"title_type,6" form:"title_type" json:"title_type" query:"title_type"`
-	TitleIndex  string   `thrift:"title_index,7" form:"title_index" json:"title_index" query:"title_index"`
-	PureTitle   string   `thrift:"pure_title,8" form:"pure_title" json:"pure_title" query:"pure_title"`
+var WcdParseResp_SurfaceImage_DEFAULT string
+
+func (p *WcdParseResp) GetSurfaceImage() (v string) {
+	if !p.IsSetSurfaceImage() {
+		return WcdParseResp_SurfaceImage_DEFAULT
+	}
+	return *p.SurfaceImage
 }
 
-func NewSentenceMeta() *SentenceMeta {
-	return &SentenceMeta{}
+var WcdParseResp_Labeler_DEFAULT string
+
+func (p *WcdParseResp) GetLabeler() (v string) {
+	if !p.IsSetLabeler() {
+		return WcdParseResp_Labeler_DEFAULT
+	}
+	return *p.Labeler
 }
 
-func (p *SentenceMeta) GetURL() (v string) {
-	return p.URL
+var WcdParseResp_Markdown_DEFAULT string
+
+func (p *WcdParseResp) GetMarkdown() (v string) {
+	if !p.IsSetMarkdown() {
+		return WcdParseResp_Markdown_DEFAULT
+	}
+	return *p.Markdown
 }
 
-func (p *SentenceMeta) GetTableHTML() (v string) {
-	return p.TableHTML
+var WcdParseResp_Blocks_DEFAULT []*ContentBlock
+
+func (p *WcdParseResp) GetBlocks() (v []*ContentBlock) {
+	if !p.IsSetBlocks() {
+		return WcdParseResp_Blocks_DEFAULT
+	}
+	return p.Blocks
 }
 
-func (p *SentenceMeta) GetCaptions() (v []int32) {
-	return p.Captions
+var WcdParseResp_CrawlAttempts_DEFAULT []*CrawlAttempt
+
+func (p *WcdParseResp) GetCrawlAttempts() (v []*CrawlAttempt) {
+	if !p.IsSetCrawlAttempts() {
+		return WcdParseResp_CrawlAttempts_DEFAULT
+	}
+	return p.CrawlAttempts
 }
 
-func (p *SentenceMeta) GetCaptionTxts() (v []string) {
-	return p.CaptionTxts
+var WcdParseResp_CrawlMeta_DEFAULT *CrawlMeta
+
+func (p *WcdParseResp) GetCrawlMeta() (v *CrawlMeta) {
+	if !p.IsSetCrawlMeta() {
+		return WcdParseResp_CrawlMeta_DEFAULT
+	}
+	return p.CrawlMeta
 }
 
-func (p *SentenceMeta) GetType() (v int32) {
-	return p.Type
+var WcdParseResp_FromCache_DEFAULT bool
+
+func (p *WcdParseResp) GetFromCache() (v bool) {
+	if !p.IsSetFromCache() {
+		return WcdParseResp_FromCache_DEFAULT
+	}
+	return *p.FromCache
 }
 
-func (p *SentenceMeta) GetTitleType() (v string) {
-	return p.TitleType
+var WcdParseResp_MirroredImages_DEFAULT map[string]string
+
+func (p *WcdParseResp) GetMirroredImages() (v map[string]string) {
+	if !p.IsSetMirroredImages() {
+		return WcdParseResp_MirroredImages_DEFAULT
+	}
+	return p.MirroredImages
 }
 
-func (p *SentenceMeta) GetTitleIndex() (v string) {
-	return p.TitleIndex
+var WcdParseResp_ImageInfos_DEFAULT []*ImageInfo
+
+func (p *WcdParseResp) GetImageInfos() (v []*ImageInfo) {
+	if !p.IsSetImageInfos() {
+		return WcdParseResp_ImageInfos_DEFAULT
+	}
+	return p.ImageInfos
 }
 
-func (p *SentenceMeta) GetPureTitle() (v string) {
-	return p.PureTitle
+var fieldIDToName_WcdParseResp = map[int16]string{
+	1:  "code",
+	2:  "msg",
+	3:  "url",
+	4:  "text",
+	5:  "images",
+	6:  "readable_html",
+	7:  "title",
+	8:  "author",
+	9:  "content_source",
+	10: "pub_time",
+	11: "model_input_str",
+	12: "model_result_str",
+	13: "worthless",
+	14: "worth_type",
+	15: "wcd_request_id",
+	19: "raw_html",
+	21: "author_meta",
+	22: "site_icon",
+	23: "description",
+	24: "surface_image",
+	25: "labeler",
+	26: "markdown",
+	27: "blocks",
+	28: "crawl_attempts",
+	29: "crawl_meta",
+	30: "from_cache",
+	31: "mirrored_images",
+	32: "image_infos",
 }
 
-var fieldIDToName_SentenceMeta = map[int16]string{
-	1: "url",
-	2: "table_html",
-	3: "captions",
-	4: "caption_txts",
-	5: "type",
-	6: "title_type",
-	7: "title_index",
-	8: "pure_title",
+func (p *WcdParseResp) IsSetRawHTML() bool {
+	return p.RawHTML != nil
 }
 
-func (p *SentenceMeta) Read(iprot thrift.TProtocol) (err error) {
+func (p *WcdParseResp) IsSetAuthorMeta() bool {
+	return p.AuthorMeta != nil
+}
+
+func (p *WcdParseResp) IsSetSiteIcon() bool {
+	return p.SiteIcon != nil
+}
+
+func (p *WcdParseResp) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *WcdParseResp) IsSetSurfaceImage() bool {
+	return p.SurfaceImage != nil
+}
+
+func (p *WcdParseResp) IsSetLabeler() bool {
+	return p.Labeler != nil
+}
+
+func (p *WcdParseResp) IsSetMarkdown() bool {
+	return p.Markdown != nil
+}
+
+func (p *WcdParseResp) IsSetBlocks() bool {
+	return p.Blocks != nil
+}
+
+func (p *WcdParseResp) IsSetCrawlAttempts() bool {
+	return p.CrawlAttempts != nil
+}
+
+func (p *WcdParseResp) IsSetCrawlMeta() bool {
+	return p.CrawlMeta != nil
+}
+
+func (p *WcdParseResp) IsSetFromCache() bool {
+	return p.FromCache != nil
+}
+
+func (p *WcdParseResp) IsSetMirroredImages() bool {
+	return p.MirroredImages != nil
+}
+
+func (p *WcdParseResp) IsSetImageInfos() bool {
+	return p.ImageInfos != nil
+}
+
+func (p *WcdParseResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2306,7 +2547,7 @@ func (p *SentenceMeta) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2322,7 +2563,7 @@ func (p *SentenceMeta) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2330,7 +2571,7 @@ func (p *SentenceMeta) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2338,7 +2579,7 @@ func (p *SentenceMeta) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2369,6 +2610,166 @@ func (p *SentenceMeta) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 19:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField19(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 21:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField21(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 22:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField22(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 23:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField23(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 24:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField24(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 25:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField25(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 26:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField26(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 27:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField27(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 28:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField28(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 29:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField29(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 30:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField30(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 31:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField31(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 32:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField32(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2388,7 +2789,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SentenceMeta[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdParseResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2398,18 +2799,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SentenceMeta) ReadField1(iprot thrift.TProtocol) error {
+func (p *WcdParseResp) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.URL = _field
+	p.Code = _field
 	return nil
 }
-func (p *SentenceMeta) ReadField2(iprot thrift.TProtocol) error {
+func (p *WcdParseResp) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2417,33 +2818,32 @@ func (p *SentenceMeta) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.TableHTML = _field
+	p.Msg = _field
 	return nil
 }
-func (p *SentenceMeta) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *WcdParseResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	_field := make([]int32, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem int32
-		if v, err := iprot.ReadI32(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+	p.URL = _field
+	return nil
+}
+func (p *WcdParseResp) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Captions = _field
+	p.Text = _field
 	return nil
 }
-func (p *SentenceMeta) ReadField4(iprot thrift.TProtocol) error {
+func (p *WcdParseResp) ReadField5(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -2463,21 +2863,21 @@ func (p *SentenceMeta) ReadField4(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.CaptionTxts = _field
+	p.Images = _field
 	return nil
 }
-func (p *SentenceMeta) ReadField5(iprot thrift.TProtocol) error {
+func (p *WcdParseResp) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Type = _field
+	p.ReadableHTML = _field
 	return nil
 }
-func (p *SentenceMeta) ReadField6(iprot thrift.TProtocol) error {
+func (p *WcdParseResp) ReadField7(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2485,10 +2885,10 @@ func (p *SentenceMeta) ReadField6(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.TitleType = _field
+	p.Title = _field
 	return nil
 }
-func (p *SentenceMeta) ReadField7(iprot thrift.TProtocol) error {
+func (p *WcdParseResp) ReadField8(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2496,10 +2896,10 @@ func (p *SentenceMeta) ReadField7(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.TitleIndex = _field
+	p.Author = _field
 	return nil
 }
-func (p *SentenceMeta) ReadField8(iprot thrift.TProtocol) error {
+func (p *WcdParseResp) ReadField9(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2507,421 +2907,253 @@ func (p *SentenceMeta) ReadField8(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.PureTitle = _field
+	p.ContentSource = _field
 	return nil
 }
+func (p *WcdParseResp) ReadField10(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("SentenceMeta"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.PubTime = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField11(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.URL); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.ModelInputStr = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField12(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("table_html", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.TableHTML); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.ModelResultStr = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField13(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("captions", thrift.LIST, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.I32, len(p.Captions)); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	for _, v := range p.Captions {
-		if err := oprot.WriteI32(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	p.Worthless = _field
+	return nil
+}
+func (p *WcdParseResp) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.WorthType = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField15(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("caption_txts", thrift.LIST, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.CaptionTxts)); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	for _, v := range p.CaptionTxts {
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	p.WcdRequestID = _field
+	return nil
+}
+func (p *WcdParseResp) ReadField19(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	p.RawHTML = _field
+	return nil
+}
+func (p *WcdParseResp) ReadField21(iprot thrift.TProtocol) error {
+	_field := NewArticleAuthorMeta()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.AuthorMeta = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField22(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("type", thrift.I32, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.Type); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.SiteIcon = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField23(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title_type", thrift.STRING, 6); err != nil {
-		goto WriteFieldBeginError
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := oprot.WriteString(p.TitleType); err != nil {
+	p.Description = _field
+	return nil
+}
+func (p *WcdParseResp) ReadField24(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	p.SurfaceImage = _field
+	return nil
+}
+func (p *WcdParseResp) ReadField25(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.Labeler = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField26(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title_index", thrift.STRING, 7); err != nil {
-		goto WriteFieldBeginError
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := oprot.WriteString(p.TitleIndex); err != nil {
+	p.Markdown = _field
+	return nil
+}
+func (p *WcdParseResp) ReadField27(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	_field := make([]*ContentBlock, 0, size)
+	values := make([]ContentBlock, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
+	p.Blocks = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField28(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*CrawlAttempt, 0, size)
+	values := make([]CrawlAttempt, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
 
-func (p *SentenceMeta) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("pure_title", thrift.STRING, 8); err != nil {
-		goto WriteFieldBeginError
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
 	}
-	if err := oprot.WriteString(p.PureTitle); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	p.CrawlAttempts = _field
+	return nil
+}
+func (p *WcdParseResp) ReadField29(iprot thrift.TProtocol) error {
+	_field := NewCrawlMeta()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.CrawlMeta = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
+func (p *WcdParseResp) ReadField30(iprot thrift.TProtocol) error {
 
-func (p *SentenceMeta) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	return fmt.Sprintf("SentenceMeta(%+v)", *p)
-
+	p.FromCache = _field
+	return nil
 }
-
-type AtomicSentence struct {
-	Text      string        `thrift:"text,1" form:"text" json:"text" query:"text"`
-	Atoms     []*AtomicText `thrift:"atoms,2" form:"atoms" json:"atoms" query:"atoms"`
-	SegmentID int32         `thrift:"segment_id,3" form:"segment_id" json:"segment_id" query:"segment_id"`
-	// 原始标签
-	Tags []string      `thrift:"tags,4" form:"tags" json:"tags" query:"tags"`
-	Meta *SentenceMeta `thrift:"meta,5" form:"meta" json:"meta" query:"meta"`
-}
-
-func NewAtomicSentence() *AtomicSentence {
-	return &AtomicSentence{}
-}
-
-func (p *AtomicSentence) GetText() (v string) {
-	return p.Text
-}
-
-func (p *AtomicSentence) GetAtoms() (v []*AtomicText) {
-	return p.Atoms
-}
-
-func (p *AtomicSentence) GetSegmentID() (v int32) {
-	return p.SegmentID
-}
-
-func (p *AtomicSentence) GetTags() (v []string) {
-	return p.Tags
-}
-
-var AtomicSentence_Meta_DEFAULT *SentenceMeta
-
-func (p *AtomicSentence) GetMeta() (v *SentenceMeta) {
-	if !p.IsSetMeta() {
-		return AtomicSentence_Meta_DEFAULT
-	}
-	return p.Meta
-}
-
-var fieldIDToName_AtomicSentence = map[int16]string{
-	1: "text",
-	2: "atoms",
-	3: "segment_id",
-	4: "tags",
-	5: "meta",
-}
-
-func (p *AtomicSentence) IsSetMeta() bool {
-	return p.Meta != nil
-}
-
-func (p *AtomicSentence) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AtomicSentence[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *AtomicSentence) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Text = _field
-	return nil
-}
-func (p *AtomicSentence) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
+func (p *WcdParseResp) ReadField31(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*AtomicText, 0, size)
-	values := make([]AtomicText, size)
+	_field := make(map[string]string, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-		if err := _elem.Read(iprot); err != nil {
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
+		} else {
+			_val = v
 		}
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
+		_field[_key] = _val
 	}
-	p.Atoms = _field
-	return nil
-}
-func (p *AtomicSentence) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.SegmentID = _field
+	p.MirroredImages = _field
 	return nil
 }
-func (p *AtomicSentence) ReadField4(iprot thrift.TProtocol) error {
+func (p *WcdParseResp) ReadField32(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]string, 0, size)
+	_field := make([]*ImageInfo, 0, size)
+	values := make([]ImageInfo, size)
 	for i := 0; i < size; i++ {
+		_elem := &values[i]
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -2929,21 +3161,13 @@ func (p *AtomicSentence) ReadField4(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Tags = _field
-	return nil
-}
-func (p *AtomicSentence) ReadField5(iprot thrift.TProtocol) error {
-	_field := NewSentenceMeta()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Meta = _field
+	p.ImageInfos = _field
 	return nil
 }
 
-func (p *AtomicSentence) Write(oprot thrift.TProtocol) (err error) {
+func (p *WcdParseResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AtomicSentence"); err != nil {
+	if err = oprot.WriteStructBegin("WcdParseResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2967,71 +3191,155 @@ func (p *AtomicSentence) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *AtomicSentence) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("text", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Text); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *AtomicSentence) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("atoms", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Atoms)); err != nil {
-		return err
-	}
-	for _, v := range p.Atoms {
-		if err := v.Write(oprot); err != nil {
-			return err
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField19(oprot); err != nil {
+			fieldId = 19
+			goto WriteFieldError
+		}
+		if err = p.writeField21(oprot); err != nil {
+			fieldId = 21
+			goto WriteFieldError
+		}
+		if err = p.writeField22(oprot); err != nil {
+			fieldId = 22
+			goto WriteFieldError
+		}
+		if err = p.writeField23(oprot); err != nil {
+			fieldId = 23
+			goto WriteFieldError
+		}
+		if err = p.writeField24(oprot); err != nil {
+			fieldId = 24
+			goto WriteFieldError
+		}
+		if err = p.writeField25(oprot); err != nil {
+			fieldId = 25
+			goto WriteFieldError
+		}
+		if err = p.writeField26(oprot); err != nil {
+			fieldId = 26
+			goto WriteFieldError
+		}
+		if err = p.writeField27(oprot); err != nil {
+			fieldId = 27
+			goto WriteFieldError
+		}
+		if err = p.writeField28(oprot); err != nil {
+			fieldId = 28
+			goto WriteFieldError
+		}
+		if err = p.writeField29(oprot); err != nil {
+			fieldId = 29
+			goto WriteFieldError
+		}
+		if err = p.writeField30(oprot); err != nil {
+			fieldId = 30
+			goto WriteFieldError
+		}
+		if err = p.writeField31(oprot); err != nil {
+			fieldId = 31
+			goto WriteFieldError
+		}
+		if err = p.writeField32(oprot); err != nil {
+			fieldId = 32
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *WcdParseResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *WcdParseResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
 WriteFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AtomicSentence) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("segment_id", thrift.I32, 3); err != nil {
+func (p *WcdParseResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.SegmentID); err != nil {
+	if err := oprot.WriteString(p.URL); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3044,14 +3352,31 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *AtomicSentence) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 4); err != nil {
+func (p *WcdParseResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+	if err := oprot.WriteString(p.Text); err != nil {
 		return err
 	}
-	for _, v := range p.Tags {
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *WcdParseResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("images", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Images)); err != nil {
+		return err
+	}
+	for _, v := range p.Images {
 		if err := oprot.WriteString(v); err != nil {
 			return err
 		}
@@ -3064,16 +3389,16 @@ func (p *AtomicSentence) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *AtomicSentence) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("meta", thrift.STRUCT, 5); err != nil {
+func (p *WcdParseResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("readable_html", thrift.STRING, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Meta.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.ReadableHTML); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3081,234 +3406,101 @@ func (p *AtomicSentence) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *AtomicSentence) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *WcdParseResp) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("AtomicSentence(%+v)", *p)
-
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-type TextParseLabelSentence struct {
-	Text      string        `thrift:"text,1" form:"text" json:"text" query:"text"`
-	Atoms     []*AtomicText `thrift:"atoms,2" form:"atoms" json:"atoms" query:"atoms"`
-	SegmentID int32         `thrift:"segment_id,3" form:"segment_id" json:"segment_id" query:"segment_id"`
-	Label     string        `thrift:"label,4" form:"label" json:"label" query:"label"`
+func (p *WcdParseResp) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Author); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func NewTextParseLabelSentence() *TextParseLabelSentence {
-	return &TextParseLabelSentence{}
+func (p *WcdParseResp) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content_source", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ContentSource); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *TextParseLabelSentence) GetText() (v string) {
-	return p.Text
+func (p *WcdParseResp) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pub_time", thrift.STRING, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PubTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *TextParseLabelSentence) GetAtoms() (v []*AtomicText) {
-	return p.Atoms
+func (p *WcdParseResp) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("model_input_str", thrift.STRING, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ModelInputStr); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *TextParseLabelSentence) GetSegmentID() (v int32) {
-	return p.SegmentID
-}
-
-func (p *TextParseLabelSentence) GetLabel() (v string) {
-	return p.Label
-}
-
-var fieldIDToName_TextParseLabelSentence = map[int16]string{
-	1: "text",
-	2: "atoms",
-	3: "segment_id",
-	4: "label",
-}
-
-func (p *TextParseLabelSentence) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TextParseLabelSentence[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *TextParseLabelSentence) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Text = _field
-	return nil
-}
-func (p *TextParseLabelSentence) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AtomicText, 0, size)
-	values := make([]AtomicText, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Atoms = _field
-	return nil
-}
-func (p *TextParseLabelSentence) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.SegmentID = _field
-	return nil
-}
-func (p *TextParseLabelSentence) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Label = _field
-	return nil
-}
-
-func (p *TextParseLabelSentence) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("TextParseLabelSentence"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *TextParseLabelSentence) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("text", thrift.STRING, 1); err != nil {
+func (p *WcdParseResp) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("model_result_str", thrift.STRING, 12); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Text); err != nil {
+	if err := oprot.WriteString(p.ModelResultStr); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3316,24 +3508,16 @@ func (p *TextParseLabelSentence) writeField1(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-func (p *TextParseLabelSentence) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("atoms", thrift.LIST, 2); err != nil {
+func (p *WcdParseResp) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("worthless", thrift.BOOL, 13); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Atoms)); err != nil {
-		return err
-	}
-	for _, v := range p.Atoms {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteBool(p.Worthless); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3341,16 +3525,16 @@ func (p *TextParseLabelSentence) writeField2(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
 
-func (p *TextParseLabelSentence) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("segment_id", thrift.I32, 3); err != nil {
+func (p *WcdParseResp) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("worth_type", thrift.I32, 14); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.SegmentID); err != nil {
+	if err := oprot.WriteI32(p.WorthType); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3358,16 +3542,16 @@ func (p *TextParseLabelSentence) writeField3(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
 }
 
-func (p *TextParseLabelSentence) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("label", thrift.STRING, 4); err != nil {
+func (p *WcdParseResp) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("wcd_request_id", thrift.STRING, 15); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Label); err != nil {
+	if err := oprot.WriteString(p.WcdRequestID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3375,57 +3559,358 @@ func (p *TextParseLabelSentence) writeField4(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
 }
 
-func (p *TextParseLabelSentence) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *WcdParseResp) writeField19(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRawHTML() {
+		if err = oprot.WriteFieldBegin("raw_html", thrift.STRING, 19); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RawHTML); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("TextParseLabelSentence(%+v)", *p)
-
-}
-
-type SegmentReq struct {
-	HTML           string              `thrift:"html,1" form:"html" json:"html" query:"html"`
-	URL            string              `thrift:"url,2" form:"url" json:"url" query:"url"`
-	RuleStageGroup *RuleStageGroupEnum `thrift:"rule_stage_group,3,optional" form:"rule_stage_group" json:"rule_stage_group,omitempty" query:"rule_stage_group"`
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
 }
 
-func NewSegmentReq() *SegmentReq {
-	return &SegmentReq{}
+func (p *WcdParseResp) writeField21(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthorMeta() {
+		if err = oprot.WriteFieldBegin("author_meta", thrift.STRUCT, 21); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.AuthorMeta.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
 }
 
-func (p *SegmentReq) GetHTML() (v string) {
-	return p.HTML
+func (p *WcdParseResp) writeField22(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSiteIcon() {
+		if err = oprot.WriteFieldBegin("site_icon", thrift.STRING, 22); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SiteIcon); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
 }
 
-func (p *SegmentReq) GetURL() (v string) {
-	return p.URL
+func (p *WcdParseResp) writeField23(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 23); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
 }
 
-var SegmentReq_RuleStageGroup_DEFAULT RuleStageGroupEnum
+func (p *WcdParseResp) writeField24(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSurfaceImage() {
+		if err = oprot.WriteFieldBegin("surface_image", thrift.STRING, 24); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SurfaceImage); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
+}
 
-func (p *SegmentReq) GetRuleStageGroup() (v RuleStageGroupEnum) {
-	if !p.IsSetRuleStageGroup() {
-		return SegmentReq_RuleStageGroup_DEFAULT
+func (p *WcdParseResp) writeField25(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabeler() {
+		if err = oprot.WriteFieldBegin("labeler", thrift.STRING, 25); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Labeler); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return *p.RuleStageGroup
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 end error: ", p), err)
 }
 
-var fieldIDToName_SegmentReq = map[int16]string{
-	1: "html",
-	2: "url",
-	3: "rule_stage_group",
+func (p *WcdParseResp) writeField26(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMarkdown() {
+		if err = oprot.WriteFieldBegin("markdown", thrift.STRING, 26); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Markdown); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 26 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 26 end error: ", p), err)
 }
 
-func (p *SegmentReq) IsSetRuleStageGroup() bool {
-	return p.RuleStageGroup != nil
+func (p *WcdParseResp) writeField27(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBlocks() {
+		if err = oprot.WriteFieldBegin("blocks", thrift.LIST, 27); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Blocks)); err != nil {
+			return err
+		}
+		for _, v := range p.Blocks {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 27 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 27 end error: ", p), err)
 }
 
-func (p *SegmentReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *WcdParseResp) writeField28(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCrawlAttempts() {
+		if err = oprot.WriteFieldBegin("crawl_attempts", thrift.LIST, 28); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.CrawlAttempts)); err != nil {
+			return err
+		}
+		for _, v := range p.CrawlAttempts {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 28 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 28 end error: ", p), err)
+}
+
+func (p *WcdParseResp) writeField29(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCrawlMeta() {
+		if err = oprot.WriteFieldBegin("crawl_meta", thrift.STRUCT, 29); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.CrawlMeta.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 29 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 29 end error: ", p), err)
+}
+
+func (p *WcdParseResp) writeField30(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromCache() {
+		if err = oprot.WriteFieldBegin("from_cache", thrift.BOOL, 30); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.FromCache); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 30 end error: ", p), err)
+}
+
+func (p *WcdParseResp) writeField31(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMirroredImages() {
+		if err = oprot.WriteFieldBegin("mirrored_images", thrift.MAP, 31); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.MirroredImages)); err != nil {
+			return err
+		}
+		for k, v := range p.MirroredImages {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 31 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 31 end error: ", p), err)
+}
+
+func (p *WcdParseResp) writeField32(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImageInfos() {
+		if err = oprot.WriteFieldBegin("image_infos", thrift.LIST, 32); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ImageInfos)); err != nil {
+			return err
+		}
+		for _, v := range p.ImageInfos {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 32 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 32 end error: ", p), err)
+}
+
+func (p *WcdParseResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WcdParseResp(%+v)", *p)
+
+}
+
+type CrawlAttempt struct {
+	// 抓取后端，命中缓存时为cache
+	Backend string `thrift:"backend,1" form:"backend" json:"backend" query:"backend"`
+	// 同一后端的第几次尝试，从1开始
+	Attempt int32 `thrift:"attempt,2" form:"attempt" json:"attempt" query:"attempt"`
+	// 是否使用了本次抓取的网页
+	Success  bool   `thrift:"success,3" form:"success" json:"success" query:"success"`
+	Error    string `thrift:"error,4" form:"error" json:"error" query:"error"`
+	CostMs   int64  `thrift:"cost_ms,5" form:"cost_ms" json:"cost_ms" query:"cost_ms"`
+	FinalURL string `thrift:"final_url,6" form:"final_url" json:"final_url" query:"final_url"`
+	// 网页的http状态码，抓取失败时为0
+	StatusCode int32 `thrift:"status_code,7" form:"status_code" json:"status_code" query:"status_code"`
+}
+
+func NewCrawlAttempt() *CrawlAttempt {
+	return &CrawlAttempt{}
+}
+
+func (p *CrawlAttempt) GetBackend() (v string) {
+	return p.Backend
+}
+
+func (p *CrawlAttempt) GetAttempt() (v int32) {
+	return p.Attempt
+}
+
+func (p *CrawlAttempt) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CrawlAttempt) GetError() (v string) {
+	return p.Error
+}
+
+func (p *CrawlAttempt) GetCostMs() (v int64) {
+	return p.CostMs
+}
+
+func (p *CrawlAttempt) GetFinalURL() (v string) {
+	return p.FinalURL
+}
+
+func (p *CrawlAttempt) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+var fieldIDToName_CrawlAttempt = map[int16]string{
+	1: "backend",
+	2: "attempt",
+	3: "success",
+	4: "error",
+	5: "cost_ms",
+	6: "final_url",
+	7: "status_code",
+}
+
+func (p *CrawlAttempt) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3453,7 +3938,7 @@ func (p *SegmentReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3461,24 +3946,56 @@ func (p *SegmentReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
 
 	return nil
@@ -3487,7 +4004,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SegmentReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CrawlAttempt[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3497,7 +4014,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SegmentReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *CrawlAttempt) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3505,10 +4022,32 @@ func (p *SegmentReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.HTML = _field
+	p.Backend = _field
 	return nil
 }
-func (p *SegmentReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *CrawlAttempt) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Attempt = _field
+	return nil
+}
+func (p *CrawlAttempt) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *CrawlAttempt) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3516,25 +4055,46 @@ func (p *SegmentReq) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.URL = _field
+	p.Error = _field
 	return nil
 }
-func (p *SegmentReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *CrawlAttempt) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *RuleStageGroupEnum
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CostMs = _field
+	return nil
+}
+func (p *CrawlAttempt) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FinalURL = _field
+	return nil
+}
+func (p *CrawlAttempt) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		tmp := RuleStageGroupEnum(v)
-		_field = &tmp
+		_field = v
 	}
-	p.RuleStageGroup = _field
+	p.StatusCode = _field
 	return nil
 }
 
-func (p *SegmentReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *CrawlAttempt) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SegmentReq"); err != nil {
+	if err = oprot.WriteStructBegin("CrawlAttempt"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3550,6 +4110,22 @@ func (p *SegmentReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3568,11 +4144,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SegmentReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("html", thrift.STRING, 1); err != nil {
+func (p *CrawlAttempt) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("backend", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.HTML); err != nil {
+	if err := oprot.WriteString(p.Backend); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3585,11 +4161,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SegmentReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("url", thrift.STRING, 2); err != nil {
+func (p *CrawlAttempt) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attempt", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.URL); err != nil {
+	if err := oprot.WriteI32(p.Attempt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3602,17 +4178,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SegmentReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRuleStageGroup() {
-		if err = oprot.WriteFieldBegin("rule_stage_group", thrift.I32, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(int32(*p.RuleStageGroup)); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *CrawlAttempt) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3621,49 +4195,157 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SegmentReq) String() string {
+func (p *CrawlAttempt) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("error", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Error); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CrawlAttempt) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cost_ms", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CostMs); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *CrawlAttempt) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("final_url", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FinalURL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *CrawlAttempt) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *CrawlAttempt) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SegmentReq(%+v)", *p)
+	return fmt.Sprintf("CrawlAttempt(%+v)", *p)
 
 }
 
-type ArticleAuthorMeta struct {
-	Name        string `thrift:"name,1" form:"name" json:"name" query:"name"`
-	ProfileURL  string `thrift:"profile_url,2" form:"profile_url" json:"profile_url" query:"profile_url"`
-	Description string `thrift:"description,3" form:"description" json:"description" query:"description"`
-	UID         string `thrift:"uid,4" form:"uid" json:"uid" query:"uid"`
+type CrawlMeta struct {
+	// 网页的http状态码
+	StatusCode int32 `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	// 重定向后的最终url
+	FinalURL    string `thrift:"final_url,2" form:"final_url" json:"final_url" query:"final_url"`
+	ContentType string `thrift:"content_type,3" form:"content_type" json:"content_type" query:"content_type"`
+	// 网页的响应头，不含Set-Cookie
+	Headers map[string]string `thrift:"headers,4" form:"headers" json:"headers" query:"headers"`
+	// 抓取时间，命中缓存时为缓存的抓取时间
+	FetchTime string `thrift:"fetch_time,5" form:"fetch_time" json:"fetch_time" query:"fetch_time"`
+	// 网页html的md5，用于判断内容是否变化
+	ContentHash string `thrift:"content_hash,6" form:"content_hash" json:"content_hash" query:"content_hash"`
+	// 与上次缓存的网页相比内容是否变化，没有缓存时为空
+	Changed *bool `thrift:"changed,7,optional" form:"changed" json:"changed,omitempty" query:"changed"`
+	// 缓存过期后通过条件请求确认网页未变化
+	Revalidated bool `thrift:"revalidated,8" form:"revalidated" json:"revalidated" query:"revalidated"`
 }
 
-func NewArticleAuthorMeta() *ArticleAuthorMeta {
-	return &ArticleAuthorMeta{}
+func NewCrawlMeta() *CrawlMeta {
+	return &CrawlMeta{}
 }
 
-func (p *ArticleAuthorMeta) GetName() (v string) {
-	return p.Name
+func (p *CrawlMeta) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
 
-func (p *ArticleAuthorMeta) GetProfileURL() (v string) {
-	return p.ProfileURL
+func (p *CrawlMeta) GetFinalURL() (v string) {
+	return p.FinalURL
 }
 
-func (p *ArticleAuthorMeta) GetDescription() (v string) {
-	return p.Description
+func (p *CrawlMeta) GetContentType() (v string) {
+	return p.ContentType
 }
 
-func (p *ArticleAuthorMeta) GetUID() (v string) {
-	return p.UID
+func (p *CrawlMeta) GetHeaders() (v map[string]string) {
+	return p.Headers
 }
 
-var fieldIDToName_ArticleAuthorMeta = map[int16]string{
-	1: "name",
-	2: "profile_url",
-	3: "description",
-	4: "uid",
+func (p *CrawlMeta) GetFetchTime() (v string) {
+	return p.FetchTime
 }
 
-func (p *ArticleAuthorMeta) Read(iprot thrift.TProtocol) (err error) {
+func (p *CrawlMeta) GetContentHash() (v string) {
+	return p.ContentHash
+}
+
+var CrawlMeta_Changed_DEFAULT bool
+
+func (p *CrawlMeta) GetChanged() (v bool) {
+	if !p.IsSetChanged() {
+		return CrawlMeta_Changed_DEFAULT
+	}
+	return *p.Changed
+}
+
+func (p *CrawlMeta) GetRevalidated() (v bool) {
+	return p.Revalidated
+}
+
+var fieldIDToName_CrawlMeta = map[int16]string{
+	1: "status_code",
+	2: "final_url",
+	3: "content_type",
+	4: "headers",
+	5: "fetch_time",
+	6: "content_hash",
+	7: "changed",
+	8: "revalidated",
+}
+
+func (p *CrawlMeta) IsSetChanged() bool {
+	return p.Changed != nil
+}
+
+func (p *CrawlMeta) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3683,7 +4365,7 @@ func (p *ArticleAuthorMeta) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3707,13 +4389,45 @@ func (p *ArticleAuthorMeta) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3733,7 +4447,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleAuthorMeta[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CrawlMeta[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3743,18 +4457,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ArticleAuthorMeta) ReadField1(iprot thrift.TProtocol) error {
+func (p *CrawlMeta) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Name = _field
+	p.StatusCode = _field
 	return nil
 }
-func (p *ArticleAuthorMeta) ReadField2(iprot thrift.TProtocol) error {
+func (p *CrawlMeta) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3762,10 +4476,10 @@ func (p *ArticleAuthorMeta) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ProfileURL = _field
+	p.FinalURL = _field
 	return nil
 }
-func (p *ArticleAuthorMeta) ReadField3(iprot thrift.TProtocol) error {
+func (p *CrawlMeta) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3773,10 +4487,39 @@ func (p *ArticleAuthorMeta) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Description = _field
+	p.ContentType = _field
 	return nil
 }
-func (p *ArticleAuthorMeta) ReadField4(iprot thrift.TProtocol) error {
+func (p *CrawlMeta) ReadField4(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Headers = _field
+	return nil
+}
+func (p *CrawlMeta) ReadField5(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3784,13 +4527,46 @@ func (p *ArticleAuthorMeta) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UID = _field
+	p.FetchTime = _field
 	return nil
 }
+func (p *CrawlMeta) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *ArticleAuthorMeta) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ContentHash = _field
+	return nil
+}
+func (p *CrawlMeta) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Changed = _field
+	return nil
+}
+func (p *CrawlMeta) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Revalidated = _field
+	return nil
+}
+
+func (p *CrawlMeta) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ArticleAuthorMeta"); err != nil {
+	if err = oprot.WriteStructBegin("CrawlMeta"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3810,6 +4586,22 @@ func (p *ArticleAuthorMeta) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3828,11 +4620,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ArticleAuthorMeta) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+func (p *CrawlMeta) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3845,11 +4637,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ArticleAuthorMeta) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("profile_url", thrift.STRING, 2); err != nil {
+func (p *CrawlMeta) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("final_url", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ProfileURL); err != nil {
+	if err := oprot.WriteString(p.FinalURL); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3862,11 +4654,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ArticleAuthorMeta) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
+func (p *CrawlMeta) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content_type", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Description); err != nil {
+	if err := oprot.WriteString(p.ContentType); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3879,11 +4671,22 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ArticleAuthorMeta) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("uid", thrift.STRING, 4); err != nil {
+func (p *CrawlMeta) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("headers", thrift.MAP, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.UID); err != nil {
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Headers)); err != nil {
+		return err
+	}
+	for k, v := range p.Headers {
+		if err := oprot.WriteString(k); err != nil {
+			return err
+		}
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteMapEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3896,115 +4699,145 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ArticleAuthorMeta) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *CrawlMeta) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("fetch_time", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("ArticleAuthorMeta(%+v)", *p)
-
-}
-
-type ArticleMeta struct {
-	URL           string             `thrift:"url,1" form:"url" json:"url" query:"url"`
-	Title         string             `thrift:"title,2" form:"title" json:"title" query:"title"`
-	PublishTime   string             `thrift:"publish_time,3" form:"publish_time" json:"publish_time" query:"publish_time"`
-	Author        string             `thrift:"author,4" form:"author" json:"author" query:"author"`
-	ContentSource string             `thrift:"content_source,5" form:"content_source" json:"content_source" query:"content_source"`
-	AuthorMeta    *ArticleAuthorMeta `thrift:"author_meta,6,optional" form:"author_meta" json:"author_meta,omitempty" query:"author_meta"`
-	SiteIcon      *string            `thrift:"site_icon,7,optional" form:"site_icon" json:"site_icon,omitempty" query:"site_icon"`
-	Description   *string            `thrift:"description,8,optional" form:"description" json:"description,omitempty" query:"description"`
-	SurfaceImage  *string            `thrift:"surface_image,9,optional" form:"surface_image" json:"surface_image,omitempty" query:"surface_image"`
+	if err := oprot.WriteString(p.FetchTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func NewArticleMeta() *ArticleMeta {
-	return &ArticleMeta{}
+func (p *CrawlMeta) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content_hash", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ContentHash); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *ArticleMeta) GetURL() (v string) {
-	return p.URL
+func (p *CrawlMeta) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChanged() {
+		if err = oprot.WriteFieldBegin("changed", thrift.BOOL, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Changed); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *ArticleMeta) GetTitle() (v string) {
-	return p.Title
+func (p *CrawlMeta) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("revalidated", thrift.BOOL, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Revalidated); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *ArticleMeta) GetPublishTime() (v string) {
-	return p.PublishTime
-}
+func (p *CrawlMeta) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CrawlMeta(%+v)", *p)
 
-func (p *ArticleMeta) GetAuthor() (v string) {
-	return p.Author
 }
 
-func (p *ArticleMeta) GetContentSource() (v string) {
-	return p.ContentSource
+type AtomicText struct {
+	Text       string `thrift:"text,1" form:"text" json:"text" query:"text"`
+	PositionID int32  `thrift:"position_id,2" form:"position_id" json:"position_id" query:"position_id"`
+	// xpath
+	Xpath     string `thrift:"xpath,3" form:"xpath" json:"xpath" query:"xpath"`
+	Immutable bool   `thrift:"immutable,7" form:"immutable" json:"immutable" query:"immutable"`
+	// 原始标签
+	Tags      []string          `thrift:"tags,8" form:"tags" json:"tags" query:"tags"`
+	Tail      bool              `thrift:"tail,9" form:"tail" json:"tail" query:"tail"`
+	SegmentID int32             `thrift:"segment_id,10" form:"segment_id" json:"segment_id" query:"segment_id"`
+	Attrs     map[string]string `thrift:"attrs,11" form:"attrs" json:"attrs" query:"attrs"`
 }
 
-var ArticleMeta_AuthorMeta_DEFAULT *ArticleAuthorMeta
-
-func (p *ArticleMeta) GetAuthorMeta() (v *ArticleAuthorMeta) {
-	if !p.IsSetAuthorMeta() {
-		return ArticleMeta_AuthorMeta_DEFAULT
-	}
-	return p.AuthorMeta
+func NewAtomicText() *AtomicText {
+	return &AtomicText{}
 }
 
-var ArticleMeta_SiteIcon_DEFAULT string
-
-func (p *ArticleMeta) GetSiteIcon() (v string) {
-	if !p.IsSetSiteIcon() {
-		return ArticleMeta_SiteIcon_DEFAULT
-	}
-	return *p.SiteIcon
+func (p *AtomicText) GetText() (v string) {
+	return p.Text
 }
 
-var ArticleMeta_Description_DEFAULT string
-
-func (p *ArticleMeta) GetDescription() (v string) {
-	if !p.IsSetDescription() {
-		return ArticleMeta_Description_DEFAULT
-	}
-	return *p.Description
+func (p *AtomicText) GetPositionID() (v int32) {
+	return p.PositionID
 }
 
-var ArticleMeta_SurfaceImage_DEFAULT string
-
-func (p *ArticleMeta) GetSurfaceImage() (v string) {
-	if !p.IsSetSurfaceImage() {
-		return ArticleMeta_SurfaceImage_DEFAULT
-	}
-	return *p.SurfaceImage
+func (p *AtomicText) GetXpath() (v string) {
+	return p.Xpath
 }
 
-var fieldIDToName_ArticleMeta = map[int16]string{
-	1: "url",
-	2: "title",
-	3: "publish_time",
-	4: "author",
-	5: "content_source",
-	6: "author_meta",
-	7: "site_icon",
-	8: "description",
-	9: "surface_image",
+func (p *AtomicText) GetImmutable() (v bool) {
+	return p.Immutable
 }
 
-func (p *ArticleMeta) IsSetAuthorMeta() bool {
-	return p.AuthorMeta != nil
+func (p *AtomicText) GetTags() (v []string) {
+	return p.Tags
 }
 
-func (p *ArticleMeta) IsSetSiteIcon() bool {
-	return p.SiteIcon != nil
+func (p *AtomicText) GetTail() (v bool) {
+	return p.Tail
 }
 
-func (p *ArticleMeta) IsSetDescription() bool {
-	return p.Description != nil
+func (p *AtomicText) GetSegmentID() (v int32) {
+	return p.SegmentID
 }
 
-func (p *ArticleMeta) IsSetSurfaceImage() bool {
-	return p.SurfaceImage != nil
+func (p *AtomicText) GetAttrs() (v map[string]string) {
+	return p.Attrs
 }
 
-func (p *ArticleMeta) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_AtomicText = map[int16]string{
+	1:  "text",
+	2:  "position_id",
+	3:  "xpath",
+	7:  "immutable",
+	8:  "tags",
+	9:  "tail",
+	10: "segment_id",
+	11: "attrs",
+}
+
+func (p *AtomicText) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4032,7 +4865,7 @@ func (p *ArticleMeta) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4047,49 +4880,41 @@ func (p *ArticleMeta) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField6(iprot); err != nil {
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
+		case 10:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField10(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 9:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField9(iprot); err != nil {
+		case 11:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField11(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -4114,7 +4939,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleMeta[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AtomicText[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4124,7 +4949,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ArticleMeta) ReadField1(iprot thrift.TProtocol) error {
+func (p *AtomicText) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4132,21 +4957,21 @@ func (p *ArticleMeta) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.URL = _field
+	p.Text = _field
 	return nil
 }
-func (p *ArticleMeta) ReadField2(iprot thrift.TProtocol) error {
+func (p *AtomicText) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Title = _field
+	p.PositionID = _field
 	return nil
 }
-func (p *ArticleMeta) ReadField3(iprot thrift.TProtocol) error {
+func (p *AtomicText) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4154,76 +4979,98 @@ func (p *ArticleMeta) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.PublishTime = _field
+	p.Xpath = _field
 	return nil
 }
-func (p *ArticleMeta) ReadField4(iprot thrift.TProtocol) error {
+func (p *AtomicText) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Author = _field
+	p.Immutable = _field
 	return nil
 }
-func (p *ArticleMeta) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *AtomicText) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ContentSource = _field
-	return nil
-}
-func (p *ArticleMeta) ReadField6(iprot thrift.TProtocol) error {
-	_field := NewArticleAuthorMeta()
-	if err := _field.Read(iprot); err != nil {
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.AuthorMeta = _field
+	p.Tags = _field
 	return nil
 }
-func (p *ArticleMeta) ReadField7(iprot thrift.TProtocol) error {
+func (p *AtomicText) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.SiteIcon = _field
+	p.Tail = _field
 	return nil
 }
-func (p *ArticleMeta) ReadField8(iprot thrift.TProtocol) error {
+func (p *AtomicText) ReadField10(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Description = _field
+	p.SegmentID = _field
 	return nil
 }
-func (p *ArticleMeta) ReadField9(iprot thrift.TProtocol) error {
+func (p *AtomicText) ReadField11(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.SurfaceImage = _field
+	p.Attrs = _field
 	return nil
 }
 
-func (p *ArticleMeta) Write(oprot thrift.TProtocol) (err error) {
+func (p *AtomicText) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ArticleMeta"); err != nil {
+	if err = oprot.WriteStructBegin("AtomicText"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4239,18 +5086,6 @@ func (p *ArticleMeta) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 		if err = p.writeField7(oprot); err != nil {
 			fieldId = 7
 			goto WriteFieldError
@@ -4263,6 +5098,14 @@ func (p *ArticleMeta) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 9
 			goto WriteFieldError
 		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4281,11 +5124,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ArticleMeta) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+func (p *AtomicText) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.URL); err != nil {
+	if err := oprot.WriteString(p.Text); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4298,11 +5141,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ArticleMeta) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+func (p *AtomicText) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("position_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteI32(p.PositionID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4315,11 +5158,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ArticleMeta) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("publish_time", thrift.STRING, 3); err != nil {
+func (p *AtomicText) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("xpath", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.PublishTime); err != nil {
+	if err := oprot.WriteString(p.Xpath); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4332,11 +5175,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ArticleMeta) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("author", thrift.STRING, 4); err != nil {
+func (p *AtomicText) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("immutable", thrift.BOOL, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Author); err != nil {
+	if err := oprot.WriteBool(p.Immutable); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4344,16 +5187,24 @@ func (p *ArticleMeta) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *ArticleMeta) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content_source", thrift.STRING, 5); err != nil {
+func (p *AtomicText) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 8); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ContentSource); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+		return err
+	}
+	for _, v := range p.Tags {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4361,157 +5212,140 @@ func (p *ArticleMeta) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *ArticleMeta) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAuthorMeta() {
-		if err = oprot.WriteFieldBegin("author_meta", thrift.STRUCT, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.AuthorMeta.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *AtomicText) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tail", thrift.BOOL, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Tail); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *ArticleMeta) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSiteIcon() {
-		if err = oprot.WriteFieldBegin("site_icon", thrift.STRING, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.SiteIcon); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *AtomicText) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("segment_id", thrift.I32, 10); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-
-func (p *ArticleMeta) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Description); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := oprot.WriteI32(p.SegmentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *ArticleMeta) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSurfaceImage() {
-		if err = oprot.WriteFieldBegin("surface_image", thrift.STRING, 9); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.SurfaceImage); err != nil {
+func (p *AtomicText) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attrs", thrift.MAP, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attrs)); err != nil {
+		return err
+	}
+	for k, v := range p.Attrs {
+		if err := oprot.WriteString(k); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err := oprot.WriteString(v); err != nil {
+			return err
 		}
 	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *ArticleMeta) String() string {
+func (p *AtomicText) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ArticleMeta(%+v)", *p)
+	return fmt.Sprintf("AtomicText(%+v)", *p)
 
 }
 
-type SegmentResp struct {
-	Code                 int32             `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg                  string            `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	Sentences            []*AtomicSentence `thrift:"sentences,3" form:"sentences" json:"sentences" query:"sentences"`
-	HTML                 string            `thrift:"html,4" form:"html" json:"html" query:"html"`
-	OperationID          string            `thrift:"operation_id,5" form:"operation_id" json:"operation_id" query:"operation_id"`
-	ArticleMeta          *ArticleMeta      `thrift:"article_meta,6" form:"article_meta" json:"article_meta" query:"article_meta"`
-	ImagesWithPositionID map[string]string `thrift:"images_with_position_id,7" form:"images_with_position_id" json:"images_with_position_id" query:"images_with_position_id"`
+type SentenceMeta struct {
+	URL         string   `thrift:"url,1" form:"url" json:"url" query:"url"`
+	TableHTML   string   `thrift:"table_html,2" form:"table_html" json:"table_html" query:"table_html"`
+	Captions    []int32  `thrift:"captions,3" form:"captions" json:"captions" query:"captions"`
+	CaptionTxts []string `thrift:"caption_txts,4" form:"caption_txts" json:"caption_txts" query:"caption_txts"`
+	Type        int32    `thrift:"type,5" form:"type" json:"type" query:"type"`
+	TitleType   string   `thrift:"title_type,6" form:"title_type" json:"title_type" query:"title_type"`
+	TitleIndex  string   `thrift:"title_index,7" form:"title_index" json:"title_index" query:"title_index"`
+	PureTitle   string   `thrift:"pure_title,8" form:"pure_title" json:"pure_title" query:"pure_title"`
 }
 
-func NewSegmentResp() *SegmentResp {
-	return &SegmentResp{}
+func NewSentenceMeta() *SentenceMeta {
+	return &SentenceMeta{}
 }
 
-func (p *SegmentResp) GetCode() (v int32) {
-	return p.Code
+func (p *SentenceMeta) GetURL() (v string) {
+	return p.URL
 }
 
-func (p *SegmentResp) GetMsg() (v string) {
-	return p.Msg
+func (p *SentenceMeta) GetTableHTML() (v string) {
+	return p.TableHTML
 }
 
-func (p *SegmentResp) GetSentences() (v []*AtomicSentence) {
-	return p.Sentences
+func (p *SentenceMeta) GetCaptions() (v []int32) {
+	return p.Captions
 }
 
-func (p *SegmentResp) GetHTML() (v string) {
-	return p.HTML
+func (p *SentenceMeta) GetCaptionTxts() (v []string) {
+	return p.CaptionTxts
 }
 
-func (p *SegmentResp) GetOperationID() (v string) {
-	return p.OperationID
+func (p *SentenceMeta) GetType() (v int32) {
+	return p.Type
 }
 
-var SegmentResp_ArticleMeta_DEFAULT *ArticleMeta
-
-func (p *SegmentResp) GetArticleMeta() (v *ArticleMeta) {
-	if !p.IsSetArticleMeta() {
-		return SegmentResp_ArticleMeta_DEFAULT
-	}
-	return p.ArticleMeta
+func (p *SentenceMeta) GetTitleType() (v string) {
+	return p.TitleType
 }
 
-func (p *SegmentResp) GetImagesWithPositionID() (v map[string]string) {
-	return p.ImagesWithPositionID
+func (p *SentenceMeta) GetTitleIndex() (v string) {
+	return p.TitleIndex
 }
 
-var fieldIDToName_SegmentResp = map[int16]string{
-	1: "code",
-	2: "msg",
-	3: "sentences",
-	4: "html",
-	5: "operation_id",
-	6: "article_meta",
-	7: "images_with_position_id",
+func (p *SentenceMeta) GetPureTitle() (v string) {
+	return p.PureTitle
 }
 
-func (p *SegmentResp) IsSetArticleMeta() bool {
-	return p.ArticleMeta != nil
+var fieldIDToName_SentenceMeta = map[int16]string{
+	1: "url",
+	2: "table_html",
+	3: "captions",
+	4: "caption_txts",
+	5: "type",
+	6: "title_type",
+	7: "title_index",
+	8: "pure_title",
 }
 
-func (p *SegmentResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *SentenceMeta) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4531,7 +5365,7 @@ func (p *SegmentResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4555,7 +5389,7 @@ func (p *SegmentResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4563,7 +5397,7 @@ func (p *SegmentResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4571,7 +5405,7 @@ func (p *SegmentResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4579,13 +5413,21 @@ func (p *SegmentResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 7:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4605,7 +5447,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SegmentResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SentenceMeta[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4615,18 +5457,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SegmentResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *SentenceMeta) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Code = _field
+	p.URL = _field
 	return nil
 }
-func (p *SegmentResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *SentenceMeta) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4634,21 +5476,22 @@ func (p *SegmentResp) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Msg = _field
+	p.TableHTML = _field
 	return nil
 }
-func (p *SegmentResp) ReadField3(iprot thrift.TProtocol) error {
+func (p *SentenceMeta) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*AtomicSentence, 0, size)
-	values := make([]AtomicSentence, size)
+	_field := make([]int32, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
 
-		if err := _elem.Read(iprot); err != nil {
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
 			return err
+		} else {
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -4656,10 +5499,44 @@ func (p *SegmentResp) ReadField3(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Sentences = _field
+	p.Captions = _field
 	return nil
 }
-func (p *SegmentResp) ReadField4(iprot thrift.TProtocol) error {
+func (p *SentenceMeta) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.CaptionTxts = _field
+	return nil
+}
+func (p *SentenceMeta) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *SentenceMeta) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4667,10 +5544,10 @@ func (p *SegmentResp) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.HTML = _field
+	p.TitleType = _field
 	return nil
 }
-func (p *SegmentResp) ReadField5(iprot thrift.TProtocol) error {
+func (p *SentenceMeta) ReadField7(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4678,51 +5555,25 @@ func (p *SegmentResp) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.OperationID = _field
+	p.TitleIndex = _field
 	return nil
 }
-func (p *SegmentResp) ReadField6(iprot thrift.TProtocol) error {
-	_field := NewArticleMeta()
-	if err := _field.Read(iprot); err != nil {
+func (p *SentenceMeta) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.ArticleMeta = _field
+	p.PureTitle = _field
 	return nil
 }
-func (p *SegmentResp) ReadField7(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.ImagesWithPositionID = _field
-	return nil
-}
-
-func (p *SegmentResp) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("SegmentResp"); err != nil {
-		goto WriteStructBeginError
+
+func (p *SentenceMeta) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SentenceMeta"); err != nil {
+		goto WriteStructBeginError
 	}
 	if p != nil {
 		if err = p.writeField1(oprot); err != nil {
@@ -4753,6 +5604,10 @@ func (p *SegmentResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4771,11 +5626,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SegmentResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+func (p *SentenceMeta) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Code); err != nil {
+	if err := oprot.WriteString(p.URL); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4788,11 +5643,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SegmentResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+func (p *SentenceMeta) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("table_html", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Msg); err != nil {
+	if err := oprot.WriteString(p.TableHTML); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4805,15 +5660,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SegmentResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("sentences", thrift.LIST, 3); err != nil {
+func (p *SentenceMeta) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("captions", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sentences)); err != nil {
+	if err := oprot.WriteListBegin(thrift.I32, len(p.Captions)); err != nil {
 		return err
 	}
-	for _, v := range p.Sentences {
-		if err := v.Write(oprot); err != nil {
+	for _, v := range p.Captions {
+		if err := oprot.WriteI32(v); err != nil {
 			return err
 		}
 	}
@@ -4830,11 +5685,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SegmentResp) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("html", thrift.STRING, 4); err != nil {
+func (p *SentenceMeta) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("caption_txts", thrift.LIST, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.HTML); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.CaptionTxts)); err != nil {
+		return err
+	}
+	for _, v := range p.CaptionTxts {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4847,11 +5710,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *SegmentResp) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("operation_id", thrift.STRING, 5); err != nil {
+func (p *SentenceMeta) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.I32, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.OperationID); err != nil {
+	if err := oprot.WriteI32(p.Type); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4864,11 +5727,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *SegmentResp) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("article_meta", thrift.STRUCT, 6); err != nil {
+func (p *SentenceMeta) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title_type", thrift.STRING, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.ArticleMeta.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.TitleType); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4881,22 +5744,28 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *SegmentResp) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("images_with_position_id", thrift.MAP, 7); err != nil {
+func (p *SentenceMeta) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title_index", thrift.STRING, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.ImagesWithPositionID)); err != nil {
+	if err := oprot.WriteString(p.TitleIndex); err != nil {
 		return err
 	}
-	for k, v := range p.ImagesWithPositionID {
-		if err := oprot.WriteString(k); err != nil {
-			return err
-		}
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err := oprot.WriteMapEnd(); err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SentenceMeta) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pure_title", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PureTitle); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4904,63 +5773,70 @@ func (p *SegmentResp) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *SegmentResp) String() string {
+func (p *SentenceMeta) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SegmentResp(%+v)", *p)
+	return fmt.Sprintf("SentenceMeta(%+v)", *p)
 
 }
 
-type DistillReq struct {
-	Sentences   []*TextParseLabelSentence `thrift:"sentences,3" form:"sentences" json:"sentences" query:"sentences"`
-	HTML        string                    `thrift:"html,4" form:"html" json:"html" query:"html"`
-	URL         string                    `thrift:"url,5" form:"url" json:"url" query:"url"`
-	ArticleMeta *ArticleMeta              `thrift:"article_meta,6" form:"article_meta" json:"article_meta" query:"article_meta"`
+type AtomicSentence struct {
+	Text      string        `thrift:"text,1" form:"text" json:"text" query:"text"`
+	Atoms     []*AtomicText `thrift:"atoms,2" form:"atoms" json:"atoms" query:"atoms"`
+	SegmentID int32         `thrift:"segment_id,3" form:"segment_id" json:"segment_id" query:"segment_id"`
+	// 原始标签
+	Tags []string      `thrift:"tags,4" form:"tags" json:"tags" query:"tags"`
+	Meta *SentenceMeta `thrift:"meta,5" form:"meta" json:"meta" query:"meta"`
 }
 
-func NewDistillReq() *DistillReq {
-	return &DistillReq{}
+func NewAtomicSentence() *AtomicSentence {
+	return &AtomicSentence{}
 }
 
-func (p *DistillReq) GetSentences() (v []*TextParseLabelSentence) {
-	return p.Sentences
+func (p *AtomicSentence) GetText() (v string) {
+	return p.Text
 }
 
-func (p *DistillReq) GetHTML() (v string) {
-	return p.HTML
+func (p *AtomicSentence) GetAtoms() (v []*AtomicText) {
+	return p.Atoms
 }
 
-func (p *DistillReq) GetURL() (v string) {
-	return p.URL
+func (p *AtomicSentence) GetSegmentID() (v int32) {
+	return p.SegmentID
 }
 
-var DistillReq_ArticleMeta_DEFAULT *ArticleMeta
+func (p *AtomicSentence) GetTags() (v []string) {
+	return p.Tags
+}
 
-func (p *DistillReq) GetArticleMeta() (v *ArticleMeta) {
-	if !p.IsSetArticleMeta() {
-		return DistillReq_ArticleMeta_DEFAULT
+var AtomicSentence_Meta_DEFAULT *SentenceMeta
+
+func (p *AtomicSentence) GetMeta() (v *SentenceMeta) {
+	if !p.IsSetMeta() {
+		return AtomicSentence_Meta_DEFAULT
 	}
-	return p.ArticleMeta
+	return p.Meta
 }
 
-var fieldIDToName_DistillReq = map[int16]string{
-	3: "sentences",
-	4: "html",
-	5: "url",
-	6: "article_meta",
+var fieldIDToName_AtomicSentence = map[int16]string{
+	1: "text",
+	2: "atoms",
+	3: "segment_id",
+	4: "tags",
+	5: "meta",
 }
 
-func (p *DistillReq) IsSetArticleMeta() bool {
-	return p.ArticleMeta != nil
+func (p *AtomicSentence) IsSetMeta() bool {
+	return p.Meta != nil
 }
 
-func (p *DistillReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *AtomicSentence) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4979,33 +5855,41 @@ func (p *DistillReq) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
-		case 3:
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 6:
+		case 5:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField6(iprot); err != nil {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -5030,7 +5914,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DistillReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AtomicSentence[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5040,13 +5924,24 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DistillReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *AtomicSentence) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Text = _field
+	return nil
+}
+func (p *AtomicSentence) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*TextParseLabelSentence, 0, size)
-	values := make([]TextParseLabelSentence, size)
+	_field := make([]*AtomicText, 0, size)
+	values := make([]AtomicText, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 
@@ -5059,46 +5954,66 @@ func (p *DistillReq) ReadField3(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Sentences = _field
-	return nil
-}
-func (p *DistillReq) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.HTML = _field
+	p.Atoms = _field
 	return nil
 }
-func (p *DistillReq) ReadField5(iprot thrift.TProtocol) error {
+func (p *AtomicSentence) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.URL = _field
+	p.SegmentID = _field
 	return nil
 }
-func (p *DistillReq) ReadField6(iprot thrift.TProtocol) error {
-	_field := NewArticleMeta()
-	if err := _field.Read(iprot); err != nil {
+func (p *AtomicSentence) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.ArticleMeta = _field
-	return nil
-}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-func (p *DistillReq) Write(oprot thrift.TProtocol) (err error) {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Tags = _field
+	return nil
+}
+func (p *AtomicSentence) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewSentenceMeta()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Meta = _field
+	return nil
+}
+
+func (p *AtomicSentence) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DistillReq"); err != nil {
+	if err = oprot.WriteStructBegin("AtomicSentence"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 		if err = p.writeField3(oprot); err != nil {
 			fieldId = 3
 			goto WriteFieldError
@@ -5111,10 +6026,6 @@ func (p *DistillReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5133,14 +6044,31 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DistillReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("sentences", thrift.LIST, 3); err != nil {
+func (p *AtomicSentence) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sentences)); err != nil {
+	if err := oprot.WriteString(p.Text); err != nil {
 		return err
 	}
-	for _, v := range p.Sentences {
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AtomicSentence) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("atoms", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Atoms)); err != nil {
+		return err
+	}
+	for _, v := range p.Atoms {
 		if err := v.Write(oprot); err != nil {
 			return err
 		}
@@ -5153,16 +6081,16 @@ func (p *DistillReq) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *DistillReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("html", thrift.STRING, 4); err != nil {
+func (p *AtomicSentence) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("segment_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.HTML); err != nil {
+	if err := oprot.WriteI32(p.SegmentID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5170,16 +6098,24 @@ func (p *DistillReq) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *DistillReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("url", thrift.STRING, 5); err != nil {
+func (p *AtomicSentence) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.URL); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+		return err
+	}
+	for _, v := range p.Tags {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5187,16 +6123,16 @@ func (p *DistillReq) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *DistillReq) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("article_meta", thrift.STRUCT, 6); err != nil {
+func (p *AtomicSentence) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("meta", thrift.STRUCT, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.ArticleMeta.Write(oprot); err != nil {
+	if err := p.Meta.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5204,82 +6140,54 @@ func (p *DistillReq) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *DistillReq) String() string {
+func (p *AtomicSentence) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DistillReq(%+v)", *p)
-
-}
-
-type DistillResp struct {
-	Code        int32   `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg         string  `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	SentenceIds []int32 `thrift:"sentence_ids,3" form:"sentence_ids" json:"sentence_ids" query:"sentence_ids"`
-	// 去噪后html
-	HTML string `thrift:"html,4" form:"html" json:"html" query:"html"`
-	// 解析纯文本
-	Text string `thrift:"text,5" form:"text" json:"text" query:"text"`
-	// 正文图片url
-	Images []string `thrift:"images,6" form:"images" json:"images" query:"images"`
-	// 是否无意义
-	Worthless bool  `thrift:"worthless,7" form:"worthless" json:"worthless" query:"worthless"`
-	WorthType int32 `thrift:"worth_type,8" form:"worth_type" json:"worth_type" query:"worth_type"`
-}
-
-func NewDistillResp() *DistillResp {
-	return &DistillResp{}
-}
-
-func (p *DistillResp) GetCode() (v int32) {
-	return p.Code
-}
+	return fmt.Sprintf("AtomicSentence(%+v)", *p)
 
-func (p *DistillResp) GetMsg() (v string) {
-	return p.Msg
 }
 
-func (p *DistillResp) GetSentenceIds() (v []int32) {
-	return p.SentenceIds
+type TextParseLabelSentence struct {
+	Text      string        `thrift:"text,1" form:"text" json:"text" query:"text"`
+	Atoms     []*AtomicText `thrift:"atoms,2" form:"atoms" json:"atoms" query:"atoms"`
+	SegmentID int32         `thrift:"segment_id,3" form:"segment_id" json:"segment_id" query:"segment_id"`
+	Label     string        `thrift:"label,4" form:"label" json:"label" query:"label"`
 }
 
-func (p *DistillResp) GetHTML() (v string) {
-	return p.HTML
+func NewTextParseLabelSentence() *TextParseLabelSentence {
+	return &TextParseLabelSentence{}
 }
 
-func (p *DistillResp) GetText() (v string) {
+func (p *TextParseLabelSentence) GetText() (v string) {
 	return p.Text
 }
 
-func (p *DistillResp) GetImages() (v []string) {
-	return p.Images
+func (p *TextParseLabelSentence) GetAtoms() (v []*AtomicText) {
+	return p.Atoms
 }
 
-func (p *DistillResp) GetWorthless() (v bool) {
-	return p.Worthless
+func (p *TextParseLabelSentence) GetSegmentID() (v int32) {
+	return p.SegmentID
 }
 
-func (p *DistillResp) GetWorthType() (v int32) {
-	return p.WorthType
+func (p *TextParseLabelSentence) GetLabel() (v string) {
+	return p.Label
 }
 
-var fieldIDToName_DistillResp = map[int16]string{
-	1: "code",
-	2: "msg",
-	3: "sentence_ids",
-	4: "html",
-	5: "text",
-	6: "images",
-	7: "worthless",
-	8: "worth_type",
+var fieldIDToName_TextParseLabelSentence = map[int16]string{
+	1: "text",
+	2: "atoms",
+	3: "segment_id",
+	4: "label",
 }
 
-func (p *DistillResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *TextParseLabelSentence) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5299,7 +6207,7 @@ func (p *DistillResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5307,7 +6215,7 @@ func (p *DistillResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5315,7 +6223,7 @@ func (p *DistillResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5330,38 +6238,6 @@ func (p *DistillResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5381,7 +6257,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DistillResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TextParseLabelSentence[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5391,18 +6267,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DistillResp) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Code = _field
-	return nil
-}
-func (p *DistillResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *TextParseLabelSentence) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5410,22 +6275,21 @@ func (p *DistillResp) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Msg = _field
+	p.Text = _field
 	return nil
 }
-func (p *DistillResp) ReadField3(iprot thrift.TProtocol) error {
+func (p *TextParseLabelSentence) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]int32, 0, size)
+	_field := make([]*AtomicText, 0, size)
+	values := make([]AtomicText, size)
 	for i := 0; i < size; i++ {
+		_elem := &values[i]
 
-		var _elem int32
-		if v, err := iprot.ReadI32(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -5433,21 +6297,21 @@ func (p *DistillResp) ReadField3(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.SentenceIds = _field
+	p.Atoms = _field
 	return nil
 }
-func (p *DistillResp) ReadField4(iprot thrift.TProtocol) error {
+func (p *TextParseLabelSentence) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.HTML = _field
+	p.SegmentID = _field
 	return nil
 }
-func (p *DistillResp) ReadField5(iprot thrift.TProtocol) error {
+func (p *TextParseLabelSentence) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5455,58 +6319,13 @@ func (p *DistillResp) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Text = _field
+	p.Label = _field
 	return nil
 }
-func (p *DistillResp) ReadField6(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Images = _field
-	return nil
-}
-func (p *DistillResp) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Worthless = _field
-	return nil
-}
-func (p *DistillResp) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.WorthType = _field
-	return nil
-}
-
-func (p *DistillResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *TextParseLabelSentence) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DistillResp"); err != nil {
+	if err = oprot.WriteStructBegin("TextParseLabelSentence"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5526,22 +6345,6 @@ func (p *DistillResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5560,11 +6363,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DistillResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+func (p *TextParseLabelSentence) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Code); err != nil {
+	if err := oprot.WriteString(p.Text); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5577,91 +6380,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DistillResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Msg); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *DistillResp) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("sentence_ids", thrift.LIST, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.I32, len(p.SentenceIds)); err != nil {
-		return err
-	}
-	for _, v := range p.SentenceIds {
-		if err := oprot.WriteI32(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *DistillResp) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("html", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.HTML); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *DistillResp) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("text", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Text); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *DistillResp) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("images", thrift.LIST, 6); err != nil {
+func (p *TextParseLabelSentence) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("atoms", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Images)); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Atoms)); err != nil {
 		return err
 	}
-	for _, v := range p.Images {
-		if err := oprot.WriteString(v); err != nil {
+	for _, v := range p.Atoms {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
 	}
@@ -5673,16 +6400,16 @@ func (p *DistillResp) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *DistillResp) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("worthless", thrift.BOOL, 7); err != nil {
+func (p *TextParseLabelSentence) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("segment_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteBool(p.Worthless); err != nil {
+	if err := oprot.WriteI32(p.SegmentID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5690,16 +6417,16 @@ func (p *DistillResp) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *DistillResp) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("worth_type", thrift.I32, 8); err != nil {
+func (p *TextParseLabelSentence) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("label", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.WorthType); err != nil {
+	if err := oprot.WriteString(p.Label); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5707,137 +6434,63 @@ func (p *DistillResp) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *DistillResp) String() string {
+func (p *TextParseLabelSentence) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DistillResp(%+v)", *p)
+	return fmt.Sprintf("TextParseLabelSentence(%+v)", *p)
 
 }
 
-type BaseParseReq struct {
-	// 网页：网页链接；pdf则是pdf的oss链接
-	URL      string  `thrift:"url,3" form:"url" json:"url" query:"url"`
-	HTML     *string `thrift:"html,4,optional" form:"html" json:"html,omitempty" query:"html"`
-	FileName *string `thrift:"file_name,5,optional" form:"file_name" json:"file_name,omitempty" query:"file_name"`
-	// 是否返回原始html
-	WithRawHTML *bool `thrift:"with_raw_html,6,optional" form:"with_raw_html" json:"with_raw_html,omitempty" query:"with_raw_html"`
-	// 规则组，默认为ProdOnly
-	RuleStageGroup *RuleStageGroupEnum `thrift:"rule_stage_group,7,optional" form:"rule_stage_group" json:"rule_stage_group,omitempty" query:"rule_stage_group"`
-	// 解析时是否强制跳过缓存
-	SkipCache *bool `thrift:"skip_cache,8,optional" form:"skip_cache" json:"skip_cache,omitempty" query:"skip_cache"`
-	// 解析后是否保存抓取的html
-	SaveCrawlHTML *bool `thrift:"save_crawl_html,9,optional" form:"save_crawl_html" json:"save_crawl_html,omitempty" query:"save_crawl_html"`
+type SegmentReq struct {
+	HTML           string              `thrift:"html,1" form:"html" json:"html" query:"html"`
+	URL            string              `thrift:"url,2" form:"url" json:"url" query:"url"`
+	RuleStageGroup *RuleStageGroupEnum `thrift:"rule_stage_group,3,optional" form:"rule_stage_group" json:"rule_stage_group,omitempty" query:"rule_stage_group"`
 }
 
-func NewBaseParseReq() *BaseParseReq {
-	return &BaseParseReq{}
+func NewSegmentReq() *SegmentReq {
+	return &SegmentReq{}
 }
 
-func (p *BaseParseReq) GetURL() (v string) {
-	return p.URL
+func (p *SegmentReq) GetHTML() (v string) {
+	return p.HTML
 }
 
-var BaseParseReq_HTML_DEFAULT string
-
-func (p *BaseParseReq) GetHTML() (v string) {
-	if !p.IsSetHTML() {
-		return BaseParseReq_HTML_DEFAULT
-	}
-	return *p.HTML
+func (p *SegmentReq) GetURL() (v string) {
+	return p.URL
 }
 
-var BaseParseReq_FileName_DEFAULT string
+var SegmentReq_RuleStageGroup_DEFAULT RuleStageGroupEnum
 
-func (p *BaseParseReq) GetFileName() (v string) {
-	if !p.IsSetFileName() {
-		return BaseParseReq_FileName_DEFAULT
+func (p *SegmentReq) GetRuleStageGroup() (v RuleStageGroupEnum) {
+	if !p.IsSetRuleStageGroup() {
+		return SegmentReq_RuleStageGroup_DEFAULT
 	}
-	return *p.FileName
+	return *p.RuleStageGroup
 }
 
-var BaseParseReq_WithRawHTML_DEFAULT bool
-
-func (p *BaseParseReq) GetWithRawHTML() (v bool) {
-	if !p.IsSetWithRawHTML() {
-		return BaseParseReq_WithRawHTML_DEFAULT
-	}
-	return *p.WithRawHTML
+var fieldIDToName_SegmentReq = map[int16]string{
+	1: "html",
+	2: "url",
+	3: "rule_stage_group",
 }
 
-var BaseParseReq_RuleStageGroup_DEFAULT RuleStageGroupEnum
-
-func (p *BaseParseReq) GetRuleStageGroup() (v RuleStageGroupEnum) {
-	if !p.IsSetRuleStageGroup() {
-		return BaseParseReq_RuleStageGroup_DEFAULT
-	}
-	return *p.RuleStageGroup
+func (p *SegmentReq) IsSetRuleStageGroup() bool {
+	return p.RuleStageGroup != nil
 }
 
-var BaseParseReq_SkipCache_DEFAULT bool
+func (p *SegmentReq) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *BaseParseReq) GetSkipCache() (v bool) {
-	if !p.IsSetSkipCache() {
-		return BaseParseReq_SkipCache_DEFAULT
-	}
-	return *p.SkipCache
-}
-
-var BaseParseReq_SaveCrawlHTML_DEFAULT bool
-
-func (p *BaseParseReq) GetSaveCrawlHTML() (v bool) {
-	if !p.IsSetSaveCrawlHTML() {
-		return BaseParseReq_SaveCrawlHTML_DEFAULT
-	}
-	return *p.SaveCrawlHTML
-}
-
-var fieldIDToName_BaseParseReq = map[int16]string{
-	3: "url",
-	4: "html",
-	5: "file_name",
-	6: "with_raw_html",
-	7: "rule_stage_group",
-	8: "skip_cache",
-	9: "save_crawl_html",
-}
-
-func (p *BaseParseReq) IsSetHTML() bool {
-	return p.HTML != nil
-}
-
-func (p *BaseParseReq) IsSetFileName() bool {
-	return p.FileName != nil
-}
-
-func (p *BaseParseReq) IsSetWithRawHTML() bool {
-	return p.WithRawHTML != nil
-}
-
-func (p *BaseParseReq) IsSetRuleStageGroup() bool {
-	return p.RuleStageGroup != nil
-}
-
-func (p *BaseParseReq) IsSetSkipCache() bool {
-	return p.SkipCache != nil
-}
-
-func (p *BaseParseReq) IsSetSaveCrawlHTML() bool {
-	return p.SaveCrawlHTML != nil
-}
-
-func (p *BaseParseReq) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
 	for {
@@ -5850,57 +6503,25 @@ func (p *BaseParseReq) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
+		case 1:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 5:
+		case 2:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField6(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 7:
+		case 3:
 			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField9(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -5925,7 +6546,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseParseReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SegmentReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5935,7 +6556,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BaseParseReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *SegmentReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5943,43 +6564,21 @@ func (p *BaseParseReq) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.URL = _field
-	return nil
-}
-func (p *BaseParseReq) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
 	p.HTML = _field
 	return nil
 }
-func (p *BaseParseReq) ReadField5(iprot thrift.TProtocol) error {
+func (p *SegmentReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
-	}
-	p.FileName = _field
-	return nil
-}
-func (p *BaseParseReq) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
+		_field = v
 	}
-	p.WithRawHTML = _field
+	p.URL = _field
 	return nil
 }
-func (p *BaseParseReq) ReadField7(iprot thrift.TProtocol) error {
+func (p *SegmentReq) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *RuleStageGroupEnum
 	if v, err := iprot.ReadI32(); err != nil {
@@ -5991,61 +6590,23 @@ func (p *BaseParseReq) ReadField7(iprot thrift.TProtocol) error {
 	p.RuleStageGroup = _field
 	return nil
 }
-func (p *BaseParseReq) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.SkipCache = _field
-	return nil
-}
-func (p *BaseParseReq) ReadField9(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.SaveCrawlHTML = _field
-	return nil
-}
 
-func (p *BaseParseReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *SegmentReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BaseParseReq"); err != nil {
+	if err = oprot.WriteStructBegin("SegmentReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
 			goto WriteFieldError
 		}
 	}
@@ -6066,11 +6627,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BaseParseReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
+func (p *SegmentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("html", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.URL); err != nil {
+	if err := oprot.WriteString(p.HTML); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6078,36 +6639,34 @@ func (p *BaseParseReq) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *BaseParseReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetHTML() {
-		if err = oprot.WriteFieldBegin("html", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.HTML); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *SegmentReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *BaseParseReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFileName() {
-		if err = oprot.WriteFieldBegin("file_name", thrift.STRING, 5); err != nil {
+func (p *SegmentReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleStageGroup() {
+		if err = oprot.WriteFieldBegin("rule_stage_group", thrift.I32, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.FileName); err != nil {
+		if err := oprot.WriteI32(int32(*p.RuleStageGroup)); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6116,74 +6675,5820 @@ func (p *BaseParseReq) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *BaseParseReq) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWithRawHTML() {
-		if err = oprot.WriteFieldBegin("with_raw_html", thrift.BOOL, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.WithRawHTML); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *SegmentReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return fmt.Sprintf("SegmentReq(%+v)", *p)
+
 }
 
-func (p *BaseParseReq) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRuleStageGroup() {
-		if err = oprot.WriteFieldBegin("rule_stage_group", thrift.I32, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(int32(*p.RuleStageGroup)); err != nil {
-			return err
-		}
+type ArticleAuthorMeta struct {
+	Name        string `thrift:"name,1" form:"name" json:"name" query:"name"`
+	ProfileURL  string `thrift:"profile_url,2" form:"profile_url" json:"profile_url" query:"profile_url"`
+	Description string `thrift:"description,3" form:"description" json:"description" query:"description"`
+	UID         string `thrift:"uid,4" form:"uid" json:"uid" query:"uid"`
+}
+
+func NewArticleAuthorMeta() *ArticleAuthorMeta {
+	return &ArticleAuthorMeta{}
+}
+
+func (p *ArticleAuthorMeta) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ArticleAuthorMeta) GetProfileURL() (v string) {
+	return p.ProfileURL
+}
+
+func (p *ArticleAuthorMeta) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *ArticleAuthorMeta) GetUID() (v string) {
+	return p.UID
+}
+
+var fieldIDToName_ArticleAuthorMeta = map[int16]string{
+	1: "name",
+	2: "profile_url",
+	3: "description",
+	4: "uid",
+}
+
+func (p *ArticleAuthorMeta) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleAuthorMeta[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArticleAuthorMeta) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *ArticleAuthorMeta) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ProfileURL = _field
+	return nil
+}
+func (p *ArticleAuthorMeta) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *ArticleAuthorMeta) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UID = _field
+	return nil
+}
+
+func (p *ArticleAuthorMeta) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArticleAuthorMeta"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArticleAuthorMeta) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ArticleAuthorMeta) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("profile_url", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ProfileURL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ArticleAuthorMeta) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Description); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ArticleAuthorMeta) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uid", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ArticleAuthorMeta) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArticleAuthorMeta(%+v)", *p)
+
+}
+
+type ArticleMeta struct {
+	URL           string             `thrift:"url,1" form:"url" json:"url" query:"url"`
+	Title         string             `thrift:"title,2" form:"title" json:"title" query:"title"`
+	PublishTime   string             `thrift:"publish_time,3" form:"publish_time" json:"publish_time" query:"publish_time"`
+	Author        string             `thrift:"author,4" form:"author" json:"author" query:"author"`
+	ContentSource string             `thrift:"content_source,5" form:"content_source" json:"content_source" query:"content_source"`
+	AuthorMeta    *ArticleAuthorMeta `thrift:"author_meta,6,optional" form:"author_meta" json:"author_meta,omitempty" query:"author_meta"`
+	SiteIcon      *string            `thrift:"site_icon,7,optional" form:"site_icon" json:"site_icon,omitempty" query:"site_icon"`
+	Description   *string            `thrift:"description,8,optional" form:"description" json:"description,omitempty" query:"description"`
+	SurfaceImage  *string            `thrift:"surface_image,9,optional" form:"surface_image" json:"surface_image,omitempty" query:"surface_image"`
+}
+
+func NewArticleMeta() *ArticleMeta {
+	return &ArticleMeta{}
+}
+
+func (p *ArticleMeta) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *ArticleMeta) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *ArticleMeta) GetPublishTime() (v string) {
+	return p.PublishTime
+}
+
+func (p *ArticleMeta) GetAuthor() (v string) {
+	return p.Author
+}
+
+func (p *ArticleMeta) GetContentSource() (v string) {
+	return p.ContentSource
+}
+
+var ArticleMeta_AuthorMeta_DEFAULT *ArticleAuthorMeta
+
+func (p *ArticleMeta) GetAuthorMeta() (v *ArticleAuthorMeta) {
+	if !p.IsSetAuthorMeta() {
+		return ArticleMeta_AuthorMeta_DEFAULT
+	}
+	return p.AuthorMeta
+}
+
+var ArticleMeta_SiteIcon_DEFAULT string
+
+func (p *ArticleMeta) GetSiteIcon() (v string) {
+	if !p.IsSetSiteIcon() {
+		return ArticleMeta_SiteIcon_DEFAULT
+	}
+	return *p.SiteIcon
+}
+
+var ArticleMeta_Description_DEFAULT string
+
+func (p *ArticleMeta) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return ArticleMeta_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var ArticleMeta_SurfaceImage_DEFAULT string
+
+func (p *ArticleMeta) GetSurfaceImage() (v string) {
+	if !p.IsSetSurfaceImage() {
+		return ArticleMeta_SurfaceImage_DEFAULT
+	}
+	return *p.SurfaceImage
+}
+
+var fieldIDToName_ArticleMeta = map[int16]string{
+	1: "url",
+	2: "title",
+	3: "publish_time",
+	4: "author",
+	5: "content_source",
+	6: "author_meta",
+	7: "site_icon",
+	8: "description",
+	9: "surface_image",
+}
+
+func (p *ArticleMeta) IsSetAuthorMeta() bool {
+	return p.AuthorMeta != nil
+}
+
+func (p *ArticleMeta) IsSetSiteIcon() bool {
+	return p.SiteIcon != nil
+}
+
+func (p *ArticleMeta) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *ArticleMeta) IsSetSurfaceImage() bool {
+	return p.SurfaceImage != nil
+}
+
+func (p *ArticleMeta) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleMeta[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArticleMeta) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *ArticleMeta) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Title = _field
+	return nil
+}
+func (p *ArticleMeta) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PublishTime = _field
+	return nil
+}
+func (p *ArticleMeta) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Author = _field
+	return nil
+}
+func (p *ArticleMeta) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ContentSource = _field
+	return nil
+}
+func (p *ArticleMeta) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewArticleAuthorMeta()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.AuthorMeta = _field
+	return nil
+}
+func (p *ArticleMeta) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SiteIcon = _field
+	return nil
+}
+func (p *ArticleMeta) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *ArticleMeta) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SurfaceImage = _field
+	return nil
+}
+
+func (p *ArticleMeta) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArticleMeta"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("publish_time", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PublishTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Author); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content_source", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ContentSource); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthorMeta() {
+		if err = oprot.WriteFieldBegin("author_meta", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.AuthorMeta.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSiteIcon() {
+		if err = oprot.WriteFieldBegin("site_icon", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SiteIcon); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ArticleMeta) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSurfaceImage() {
+		if err = oprot.WriteFieldBegin("surface_image", thrift.STRING, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SurfaceImage); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *ArticleMeta) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArticleMeta(%+v)", *p)
+
+}
+
+type SegmentResp struct {
+	Code                 int32             `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg                  string            `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Sentences            []*AtomicSentence `thrift:"sentences,3" form:"sentences" json:"sentences" query:"sentences"`
+	HTML                 string            `thrift:"html,4" form:"html" json:"html" query:"html"`
+	OperationID          string            `thrift:"operation_id,5" form:"operation_id" json:"operation_id" query:"operation_id"`
+	ArticleMeta          *ArticleMeta      `thrift:"article_meta,6" form:"article_meta" json:"article_meta" query:"article_meta"`
+	ImagesWithPositionID map[string]string `thrift:"images_with_position_id,7" form:"images_with_position_id" json:"images_with_position_id" query:"images_with_position_id"`
+}
+
+func NewSegmentResp() *SegmentResp {
+	return &SegmentResp{}
+}
+
+func (p *SegmentResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *SegmentResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *SegmentResp) GetSentences() (v []*AtomicSentence) {
+	return p.Sentences
+}
+
+func (p *SegmentResp) GetHTML() (v string) {
+	return p.HTML
+}
+
+func (p *SegmentResp) GetOperationID() (v string) {
+	return p.OperationID
+}
+
+var SegmentResp_ArticleMeta_DEFAULT *ArticleMeta
+
+func (p *SegmentResp) GetArticleMeta() (v *ArticleMeta) {
+	if !p.IsSetArticleMeta() {
+		return SegmentResp_ArticleMeta_DEFAULT
+	}
+	return p.ArticleMeta
+}
+
+func (p *SegmentResp) GetImagesWithPositionID() (v map[string]string) {
+	return p.ImagesWithPositionID
+}
+
+var fieldIDToName_SegmentResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "sentences",
+	4: "html",
+	5: "operation_id",
+	6: "article_meta",
+	7: "images_with_position_id",
+}
+
+func (p *SegmentResp) IsSetArticleMeta() bool {
+	return p.ArticleMeta != nil
+}
+
+func (p *SegmentResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SegmentResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SegmentResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *SegmentResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *SegmentResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AtomicSentence, 0, size)
+	values := make([]AtomicSentence, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Sentences = _field
+	return nil
+}
+func (p *SegmentResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HTML = _field
+	return nil
+}
+func (p *SegmentResp) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OperationID = _field
+	return nil
+}
+func (p *SegmentResp) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewArticleMeta()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ArticleMeta = _field
+	return nil
+}
+func (p *SegmentResp) ReadField7(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.ImagesWithPositionID = _field
+	return nil
+}
+
+func (p *SegmentResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SegmentResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SegmentResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SegmentResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SegmentResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sentences", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sentences)); err != nil {
+		return err
+	}
+	for _, v := range p.Sentences {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SegmentResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("html", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.HTML); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SegmentResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("operation_id", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.OperationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SegmentResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("article_meta", thrift.STRUCT, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.ArticleMeta.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SegmentResp) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("images_with_position_id", thrift.MAP, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.ImagesWithPositionID)); err != nil {
+		return err
+	}
+	for k, v := range p.ImagesWithPositionID {
+		if err := oprot.WriteString(k); err != nil {
+			return err
+		}
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SegmentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SegmentResp(%+v)", *p)
+
+}
+
+type DistillReq struct {
+	Sentences   []*TextParseLabelSentence `thrift:"sentences,3" form:"sentences" json:"sentences" query:"sentences"`
+	HTML        string                    `thrift:"html,4" form:"html" json:"html" query:"html"`
+	URL         string                    `thrift:"url,5" form:"url" json:"url" query:"url"`
+	ArticleMeta *ArticleMeta              `thrift:"article_meta,6" form:"article_meta" json:"article_meta" query:"article_meta"`
+	// 规则组，默认为ProdOnly
+	RuleStageGroup *RuleStageGroupEnum `thrift:"rule_stage_group,7,optional" form:"rule_stage_group" json:"rule_stage_group,omitempty" query:"rule_stage_group"`
+	// 额外返回的格式：markdown、blocks
+	OutputFormats []string `thrift:"output_formats,8,optional" form:"output_formats" json:"output_formats,omitempty" query:"output_formats"`
+}
+
+func NewDistillReq() *DistillReq {
+	return &DistillReq{}
+}
+
+func (p *DistillReq) GetSentences() (v []*TextParseLabelSentence) {
+	return p.Sentences
+}
+
+func (p *DistillReq) GetHTML() (v string) {
+	return p.HTML
+}
+
+func (p *DistillReq) GetURL() (v string) {
+	return p.URL
+}
+
+var DistillReq_ArticleMeta_DEFAULT *ArticleMeta
+
+func (p *DistillReq) GetArticleMeta() (v *ArticleMeta) {
+	if !p.IsSetArticleMeta() {
+		return DistillReq_ArticleMeta_DEFAULT
+	}
+	return p.ArticleMeta
+}
+
+var DistillReq_RuleStageGroup_DEFAULT RuleStageGroupEnum
+
+func (p *DistillReq) GetRuleStageGroup() (v RuleStageGroupEnum) {
+	if !p.IsSetRuleStageGroup() {
+		return DistillReq_RuleStageGroup_DEFAULT
+	}
+	return *p.RuleStageGroup
+}
+
+var DistillReq_OutputFormats_DEFAULT []string
+
+func (p *DistillReq) GetOutputFormats() (v []string) {
+	if !p.IsSetOutputFormats() {
+		return DistillReq_OutputFormats_DEFAULT
+	}
+	return p.OutputFormats
+}
+
+var fieldIDToName_DistillReq = map[int16]string{
+	3: "sentences",
+	4: "html",
+	5: "url",
+	6: "article_meta",
+	7: "rule_stage_group",
+	8: "output_formats",
+}
+
+func (p *DistillReq) IsSetArticleMeta() bool {
+	return p.ArticleMeta != nil
+}
+
+func (p *DistillReq) IsSetRuleStageGroup() bool {
+	return p.RuleStageGroup != nil
+}
+
+func (p *DistillReq) IsSetOutputFormats() bool {
+	return p.OutputFormats != nil
+}
+
+func (p *DistillReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DistillReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DistillReq) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TextParseLabelSentence, 0, size)
+	values := make([]TextParseLabelSentence, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Sentences = _field
+	return nil
+}
+func (p *DistillReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HTML = _field
+	return nil
+}
+func (p *DistillReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *DistillReq) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewArticleMeta()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ArticleMeta = _field
+	return nil
+}
+func (p *DistillReq) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *RuleStageGroupEnum
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := RuleStageGroupEnum(v)
+		_field = &tmp
+	}
+	p.RuleStageGroup = _field
+	return nil
+}
+func (p *DistillReq) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OutputFormats = _field
+	return nil
+}
+
+func (p *DistillReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DistillReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DistillReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sentences", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sentences)); err != nil {
+		return err
+	}
+	for _, v := range p.Sentences {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *DistillReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("html", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.HTML); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *DistillReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *DistillReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("article_meta", thrift.STRUCT, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.ArticleMeta.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *DistillReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleStageGroup() {
+		if err = oprot.WriteFieldBegin("rule_stage_group", thrift.I32, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.RuleStageGroup)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *DistillReq) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputFormats() {
+		if err = oprot.WriteFieldBegin("output_formats", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.OutputFormats)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputFormats {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *DistillReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DistillReq(%+v)", *p)
+
+}
+
+type DistillResp struct {
+	Code        int32   `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg         string  `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	SentenceIds []int32 `thrift:"sentence_ids,3" form:"sentence_ids" json:"sentence_ids" query:"sentence_ids"`
+	// 去噪后html
+	HTML string `thrift:"html,4" form:"html" json:"html" query:"html"`
+	// 解析纯文本
+	Text string `thrift:"text,5" form:"text" json:"text" query:"text"`
+	// 正文图片url
+	Images []string `thrift:"images,6" form:"images" json:"images" query:"images"`
+	// 是否无意义
+	Worthless bool  `thrift:"worthless,7" form:"worthless" json:"worthless" query:"worthless"`
+	WorthType int32 `thrift:"worth_type,8" form:"worth_type" json:"worth_type" query:"worth_type"`
+	// 正文markdown
+	Markdown *string `thrift:"markdown,9,optional" form:"markdown" json:"markdown,omitempty" query:"markdown"`
+	// 结构化正文
+	Blocks []*ContentBlock `thrift:"blocks,10,optional" form:"blocks" json:"blocks,omitempty" query:"blocks"`
+	// 正文图片的尺寸、格式和图注
+	ImageInfos []*ImageInfo `thrift:"image_infos,11,optional" form:"image_infos" json:"image_infos,omitempty" query:"image_infos"`
+}
+
+func NewDistillResp() *DistillResp {
+	return &DistillResp{}
+}
+
+func (p *DistillResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *DistillResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *DistillResp) GetSentenceIds() (v []int32) {
+	return p.SentenceIds
+}
+
+func (p *DistillResp) GetHTML() (v string) {
+	return p.HTML
+}
+
+func (p *DistillResp) GetText() (v string) {
+	return p.Text
+}
+
+func (p *DistillResp) GetImages() (v []string) {
+	return p.Images
+}
+
+func (p *DistillResp) GetWorthless() (v bool) {
+	return p.Worthless
+}
+
+func (p *DistillResp) GetWorthType() (v int32) {
+	return p.WorthType
+}
+
+var DistillResp_Markdown_DEFAULT string
+
+func (p *DistillResp) GetMarkdown() (v string) {
+	if !p.IsSetMarkdown() {
+		return DistillResp_Markdown_DEFAULT
+	}
+	return *p.Markdown
+}
+
+var DistillResp_Blocks_DEFAULT []*ContentBlock
+
+func (p *DistillResp) GetBlocks() (v []*ContentBlock) {
+	if !p.IsSetBlocks() {
+		return DistillResp_Blocks_DEFAULT
+	}
+	return p.Blocks
+}
+
+var DistillResp_ImageInfos_DEFAULT []*ImageInfo
+
+func (p *DistillResp) GetImageInfos() (v []*ImageInfo) {
+	if !p.IsSetImageInfos() {
+		return DistillResp_ImageInfos_DEFAULT
+	}
+	return p.ImageInfos
+}
+
+var fieldIDToName_DistillResp = map[int16]string{
+	1:  "code",
+	2:  "msg",
+	3:  "sentence_ids",
+	4:  "html",
+	5:  "text",
+	6:  "images",
+	7:  "worthless",
+	8:  "worth_type",
+	9:  "markdown",
+	10: "blocks",
+	11: "image_infos",
+}
+
+func (p *DistillResp) IsSetMarkdown() bool {
+	return p.Markdown != nil
+}
+
+func (p *DistillResp) IsSetBlocks() bool {
+	return p.Blocks != nil
+}
+
+func (p *DistillResp) IsSetImageInfos() bool {
+	return p.ImageInfos != nil
+}
+
+func (p *DistillResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DistillResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DistillResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *DistillResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *DistillResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int32, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SentenceIds = _field
+	return nil
+}
+func (p *DistillResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HTML = _field
+	return nil
+}
+func (p *DistillResp) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Text = _field
+	return nil
+}
+func (p *DistillResp) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Images = _field
+	return nil
+}
+func (p *DistillResp) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Worthless = _field
+	return nil
+}
+func (p *DistillResp) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorthType = _field
+	return nil
+}
+func (p *DistillResp) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Markdown = _field
+	return nil
+}
+func (p *DistillResp) ReadField10(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ContentBlock, 0, size)
+	values := make([]ContentBlock, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Blocks = _field
+	return nil
+}
+func (p *DistillResp) ReadField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ImageInfo, 0, size)
+	values := make([]ImageInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ImageInfos = _field
+	return nil
+}
+
+func (p *DistillResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DistillResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DistillResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sentence_ids", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I32, len(p.SentenceIds)); err != nil {
+		return err
+	}
+	for _, v := range p.SentenceIds {
+		if err := oprot.WriteI32(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("html", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.HTML); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Text); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("images", thrift.LIST, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Images)); err != nil {
+		return err
+	}
+	for _, v := range p.Images {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("worthless", thrift.BOOL, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Worthless); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("worth_type", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.WorthType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMarkdown() {
+		if err = oprot.WriteFieldBegin("markdown", thrift.STRING, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Markdown); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBlocks() {
+		if err = oprot.WriteFieldBegin("blocks", thrift.LIST, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Blocks)); err != nil {
+			return err
+		}
+		for _, v := range p.Blocks {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *DistillResp) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetImageInfos() {
+		if err = oprot.WriteFieldBegin("image_infos", thrift.LIST, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ImageInfos)); err != nil {
+			return err
+		}
+		for _, v := range p.ImageInfos {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *DistillResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DistillResp(%+v)", *p)
+
+}
+
+type BaseParseReq struct {
+	// 网页：网页链接；pdf则是pdf的oss链接
+	URL      string  `thrift:"url,3" form:"url" json:"url" query:"url"`
+	HTML     *string `thrift:"html,4,optional" form:"html" json:"html,omitempty" query:"html"`
+	FileName *string `thrift:"file_name,5,optional" form:"file_name" json:"file_name,omitempty" query:"file_name"`
+	// 是否返回原始html
+	WithRawHTML *bool `thrift:"with_raw_html,6,optional" form:"with_raw_html" json:"with_raw_html,omitempty" query:"with_raw_html"`
+	// 规则组，默认为ProdOnly
+	RuleStageGroup *RuleStageGroupEnum `thrift:"rule_stage_group,7,optional" form:"rule_stage_group" json:"rule_stage_group,omitempty" query:"rule_stage_group"`
+	// 不读取网页缓存和解析结果缓存，重新抓取和解析，结果仍按配置写入缓存
+	SkipCache *bool `thrift:"skip_cache,8,optional" form:"skip_cache" json:"skip_cache,omitempty" query:"skip_cache"`
+	// 解析后是否缓存html，为空时缓存抓取到的网页，不缓存请求传入的html
+	SaveCrawlHTML *bool `thrift:"save_crawl_html,9,optional" form:"save_crawl_html" json:"save_crawl_html,omitempty" query:"save_crawl_html"`
+	// 指定标注器，为空时依次使用站点规则、全局配置中的标注器
+	Labeler *string `thrift:"labeler,10,optional" form:"labeler" json:"labeler,omitempty" query:"labeler"`
+	// 额外返回的格式：markdown、blocks
+	OutputFormats []string `thrift:"output_formats,11,optional" form:"output_formats" json:"output_formats,omitempty" query:"output_formats"`
+	// 是否遵守robots.txt和meta robots，为空时使用全局配置
+	RespectRobots *bool `thrift:"respect_robots,12,optional" form:"respect_robots" json:"respect_robots,omitempty" query:"respect_robots"`
+	// 是否把正文图片保存到对象存储并替换为镜像地址，为空时使用全局配置
+	MirrorImages *bool `thrift:"mirror_images,13,optional" form:"mirror_images" json:"mirror_images,omitempty" query:"mirror_images"`
+}
+
+func NewBaseParseReq() *BaseParseReq {
+	return &BaseParseReq{}
+}
+
+func (p *BaseParseReq) GetURL() (v string) {
+	return p.URL
+}
+
+var BaseParseReq_HTML_DEFAULT string
+
+func (p *BaseParseReq) GetHTML() (v string) {
+	if !p.IsSetHTML() {
+		return BaseParseReq_HTML_DEFAULT
+	}
+	return *p.HTML
+}
+
+var BaseParseReq_FileName_DEFAULT string
+
+func (p *BaseParseReq) GetFileName() (v string) {
+	if !p.IsSetFileName() {
+		return BaseParseReq_FileName_DEFAULT
+	}
+	return *p.FileName
+}
+
+var BaseParseReq_WithRawHTML_DEFAULT bool
+
+func (p *BaseParseReq) GetWithRawHTML() (v bool) {
+	if !p.IsSetWithRawHTML() {
+		return BaseParseReq_WithRawHTML_DEFAULT
+	}
+	return *p.WithRawHTML
+}
+
+var BaseParseReq_RuleStageGroup_DEFAULT RuleStageGroupEnum
+
+func (p *BaseParseReq) GetRuleStageGroup() (v RuleStageGroupEnum) {
+	if !p.IsSetRuleStageGroup() {
+		return BaseParseReq_RuleStageGroup_DEFAULT
+	}
+	return *p.RuleStageGroup
+}
+
+var BaseParseReq_SkipCache_DEFAULT bool
+
+func (p *BaseParseReq) GetSkipCache() (v bool) {
+	if !p.IsSetSkipCache() {
+		return BaseParseReq_SkipCache_DEFAULT
+	}
+	return *p.SkipCache
+}
+
+var BaseParseReq_SaveCrawlHTML_DEFAULT bool
+
+func (p *BaseParseReq) GetSaveCrawlHTML() (v bool) {
+	if !p.IsSetSaveCrawlHTML() {
+		return BaseParseReq_SaveCrawlHTML_DEFAULT
+	}
+	return *p.SaveCrawlHTML
+}
+
+var BaseParseReq_Labeler_DEFAULT string
+
+func (p *BaseParseReq) GetLabeler() (v string) {
+	if !p.IsSetLabeler() {
+		return BaseParseReq_Labeler_DEFAULT
+	}
+	return *p.Labeler
+}
+
+var BaseParseReq_OutputFormats_DEFAULT []string
+
+func (p *BaseParseReq) GetOutputFormats() (v []string) {
+	if !p.IsSetOutputFormats() {
+		return BaseParseReq_OutputFormats_DEFAULT
+	}
+	return p.OutputFormats
+}
+
+var BaseParseReq_RespectRobots_DEFAULT bool
+
+func (p *BaseParseReq) GetRespectRobots() (v bool) {
+	if !p.IsSetRespectRobots() {
+		return BaseParseReq_RespectRobots_DEFAULT
+	}
+	return *p.RespectRobots
+}
+
+var BaseParseReq_MirrorImages_DEFAULT bool
+
+func (p *BaseParseReq) GetMirrorImages() (v bool) {
+	if !p.IsSetMirrorImages() {
+		return BaseParseReq_MirrorImages_DEFAULT
+	}
+	return *p.MirrorImages
+}
+
+var fieldIDToName_BaseParseReq = map[int16]string{
+	3:  "url",
+	4:  "html",
+	5:  "file_name",
+	6:  "with_raw_html",
+	7:  "rule_stage_group",
+	8:  "skip_cache",
+	9:  "save_crawl_html",
+	10: "labeler",
+	11: "output_formats",
+	12: "respect_robots",
+	13: "mirror_images",
+}
+
+func (p *BaseParseReq) IsSetHTML() bool {
+	return p.HTML != nil
+}
+
+func (p *BaseParseReq) IsSetFileName() bool {
+	return p.FileName != nil
+}
+
+func (p *BaseParseReq) IsSetWithRawHTML() bool {
+	return p.WithRawHTML != nil
+}
+
+func (p *BaseParseReq) IsSetRuleStageGroup() bool {
+	return p.RuleStageGroup != nil
+}
+
+func (p *BaseParseReq) IsSetSkipCache() bool {
+	return p.SkipCache != nil
+}
+
+func (p *BaseParseReq) IsSetSaveCrawlHTML() bool {
+	return p.SaveCrawlHTML != nil
+}
+
+func (p *BaseParseReq) IsSetLabeler() bool {
+	return p.Labeler != nil
+}
+
+func (p *BaseParseReq) IsSetOutputFormats() bool {
+	return p.OutputFormats != nil
+}
+
+func (p *BaseParseReq) IsSetRespectRobots() bool {
+	return p.RespectRobots != nil
+}
+
+func (p *BaseParseReq) IsSetMirrorImages() bool {
+	return p.MirrorImages != nil
+}
+
+func (p *BaseParseReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseParseReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BaseParseReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.HTML = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FileName = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WithRawHTML = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *RuleStageGroupEnum
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := RuleStageGroupEnum(v)
+		_field = &tmp
+	}
+	p.RuleStageGroup = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SkipCache = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SaveCrawlHTML = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Labeler = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OutputFormats = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RespectRobots = _field
+	return nil
+}
+func (p *BaseParseReq) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MirrorImages = _field
+	return nil
+}
+
+func (p *BaseParseReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BaseParseReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHTML() {
+		if err = oprot.WriteFieldBegin("html", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.HTML); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFileName() {
+		if err = oprot.WriteFieldBegin("file_name", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FileName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWithRawHTML() {
+		if err = oprot.WriteFieldBegin("with_raw_html", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.WithRawHTML); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleStageGroup() {
+		if err = oprot.WriteFieldBegin("rule_stage_group", thrift.I32, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.RuleStageGroup)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSkipCache() {
+		if err = oprot.WriteFieldBegin("skip_cache", thrift.BOOL, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.SkipCache); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSaveCrawlHTML() {
+		if err = oprot.WriteFieldBegin("save_crawl_html", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.SaveCrawlHTML); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabeler() {
+		if err = oprot.WriteFieldBegin("labeler", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Labeler); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputFormats() {
+		if err = oprot.WriteFieldBegin("output_formats", thrift.LIST, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.OutputFormats)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputFormats {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRespectRobots() {
+		if err = oprot.WriteFieldBegin("respect_robots", thrift.BOOL, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.RespectRobots); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *BaseParseReq) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMirrorImages() {
+		if err = oprot.WriteFieldBegin("mirror_images", thrift.BOOL, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.MirrorImages); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *BaseParseReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BaseParseReq(%+v)", *p)
+
+}
+
+type BaseParseBatchReq struct {
+	// 相同url和html的请求只解析一次
+	Items []*BaseParseReq `thrift:"items,1" form:"items" json:"items" query:"items"`
+}
+
+func NewBaseParseBatchReq() *BaseParseBatchReq {
+	return &BaseParseBatchReq{}
+}
+
+func (p *BaseParseBatchReq) GetItems() (v []*BaseParseReq) {
+	return p.Items
+}
+
+var fieldIDToName_BaseParseBatchReq = map[int16]string{
+	1: "items",
+}
+
+func (p *BaseParseBatchReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseParseBatchReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BaseParseBatchReq) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*BaseParseReq, 0, size)
+	values := make([]BaseParseReq, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Items = _field
+	return nil
+}
+
+func (p *BaseParseBatchReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BaseParseBatchReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BaseParseBatchReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
+		return err
+	}
+	for _, v := range p.Items {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BaseParseBatchReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BaseParseBatchReq(%+v)", *p)
+
+}
+
+type BaseParseBatchResp struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	// 与请求的顺序一一对应，每项有各自的code
+	Items []*WcdParseResp `thrift:"items,3" form:"items" json:"items" query:"items"`
+}
+
+func NewBaseParseBatchResp() *BaseParseBatchResp {
+	return &BaseParseBatchResp{}
+}
+
+func (p *BaseParseBatchResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *BaseParseBatchResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *BaseParseBatchResp) GetItems() (v []*WcdParseResp) {
+	return p.Items
+}
+
+var fieldIDToName_BaseParseBatchResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "items",
+}
+
+func (p *BaseParseBatchResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BaseParseBatchResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BaseParseBatchResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *BaseParseBatchResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *BaseParseBatchResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*WcdParseResp, 0, size)
+	values := make([]WcdParseResp, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Items = _field
+	return nil
+}
+
+func (p *BaseParseBatchResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BaseParseBatchResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BaseParseBatchResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BaseParseBatchResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *BaseParseBatchResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("items", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
+		return err
+	}
+	for _, v := range p.Items {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *BaseParseBatchResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BaseParseBatchResp(%+v)", *p)
+
+}
+
+type ParseJobSubmitReq struct {
+	Req *BaseParseReq `thrift:"req,1" form:"req" json:"req" query:"req"`
+	// 任务结束后以POST方式推送ParseJobResp，失败时重试
+	CallbackURL *string `thrift:"callback_url,2,optional" form:"callback_url" json:"callback_url,omitempty" query:"callback_url"`
+}
+
+func NewParseJobSubmitReq() *ParseJobSubmitReq {
+	return &ParseJobSubmitReq{}
+}
+
+var ParseJobSubmitReq_Req_DEFAULT *BaseParseReq
+
+func (p *ParseJobSubmitReq) GetReq() (v *BaseParseReq) {
+	if !p.IsSetReq() {
+		return ParseJobSubmitReq_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var ParseJobSubmitReq_CallbackURL_DEFAULT string
+
+func (p *ParseJobSubmitReq) GetCallbackURL() (v string) {
+	if !p.IsSetCallbackURL() {
+		return ParseJobSubmitReq_CallbackURL_DEFAULT
+	}
+	return *p.CallbackURL
+}
+
+var fieldIDToName_ParseJobSubmitReq = map[int16]string{
+	1: "req",
+	2: "callback_url",
+}
+
+func (p *ParseJobSubmitReq) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ParseJobSubmitReq) IsSetCallbackURL() bool {
+	return p.CallbackURL != nil
+}
+
+func (p *ParseJobSubmitReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParseJobSubmitReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ParseJobSubmitReq) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBaseParseReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+func (p *ParseJobSubmitReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CallbackURL = _field
+	return nil
+}
+
+func (p *ParseJobSubmitReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ParseJobSubmitReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ParseJobSubmitReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ParseJobSubmitReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCallbackURL() {
+		if err = oprot.WriteFieldBegin("callback_url", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CallbackURL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ParseJobSubmitReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ParseJobSubmitReq(%+v)", *p)
+
+}
+
+type ParseJobSubmitResp struct {
+	Code  int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg   string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	JobID string `thrift:"job_id,3" form:"job_id" json:"job_id" query:"job_id"`
+}
+
+func NewParseJobSubmitResp() *ParseJobSubmitResp {
+	return &ParseJobSubmitResp{}
+}
+
+func (p *ParseJobSubmitResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ParseJobSubmitResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *ParseJobSubmitResp) GetJobID() (v string) {
+	return p.JobID
+}
+
+var fieldIDToName_ParseJobSubmitResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "job_id",
+}
+
+func (p *ParseJobSubmitResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParseJobSubmitResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ParseJobSubmitResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *ParseJobSubmitResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *ParseJobSubmitResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.JobID = _field
+	return nil
+}
+
+func (p *ParseJobSubmitResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ParseJobSubmitResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ParseJobSubmitResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ParseJobSubmitResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ParseJobSubmitResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_id", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.JobID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ParseJobSubmitResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ParseJobSubmitResp(%+v)", *p)
+
+}
+
+type ParseJobReq struct {
+	JobID string `thrift:"job_id,1" form:"job_id" json:"job_id" query:"job_id"`
+}
+
+func NewParseJobReq() *ParseJobReq {
+	return &ParseJobReq{}
+}
+
+func (p *ParseJobReq) GetJobID() (v string) {
+	return p.JobID
+}
+
+var fieldIDToName_ParseJobReq = map[int16]string{
+	1: "job_id",
+}
+
+func (p *ParseJobReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParseJobReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ParseJobReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.JobID = _field
+	return nil
+}
+
+func (p *ParseJobReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ParseJobReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ParseJobReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.JobID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ParseJobReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ParseJobReq(%+v)", *p)
+
+}
+
+type ParseJobResp struct {
+	Code   int32          `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg    string         `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	JobID  string         `thrift:"job_id,3" form:"job_id" json:"job_id" query:"job_id"`
+	URL    string         `thrift:"url,4" form:"url" json:"url" query:"url"`
+	Status ParseJobStatus `thrift:"status,5" form:"status" json:"status" query:"status"`
+	// 任务结束后才有，解析失败时result中的code非0
+	Result           *WcdParseResp          `thrift:"result,6,optional" form:"result" json:"result,omitempty" query:"result"`
+	CallbackStatus   ParseJobCallbackStatus `thrift:"callback_status,7" form:"callback_status" json:"callback_status" query:"callback_status"`
+	CallbackAttempts int32                  `thrift:"callback_attempts,8" form:"callback_attempts" json:"callback_attempts" query:"callback_attempts"`
+	CreateTime       string                 `thrift:"create_time,9" form:"create_time" json:"create_time" query:"create_time"`
+	UpdateTime       string                 `thrift:"update_time,10" form:"update_time" json:"update_time" query:"update_time"`
+	FinishTime       string                 `thrift:"finish_time,11" form:"finish_time" json:"finish_time" query:"finish_time"`
+}
+
+func NewParseJobResp() *ParseJobResp {
+	return &ParseJobResp{}
+}
+
+func (p *ParseJobResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ParseJobResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *ParseJobResp) GetJobID() (v string) {
+	return p.JobID
+}
+
+func (p *ParseJobResp) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *ParseJobResp) GetStatus() (v ParseJobStatus) {
+	return p.Status
+}
+
+var ParseJobResp_Result_DEFAULT *WcdParseResp
+
+func (p *ParseJobResp) GetResult() (v *WcdParseResp) {
+	if !p.IsSetResult() {
+		return ParseJobResp_Result_DEFAULT
+	}
+	return p.Result
+}
+
+func (p *ParseJobResp) GetCallbackStatus() (v ParseJobCallbackStatus) {
+	return p.CallbackStatus
+}
+
+func (p *ParseJobResp) GetCallbackAttempts() (v int32) {
+	return p.CallbackAttempts
+}
+
+func (p *ParseJobResp) GetCreateTime() (v string) {
+	return p.CreateTime
+}
+
+func (p *ParseJobResp) GetUpdateTime() (v string) {
+	return p.UpdateTime
+}
+
+func (p *ParseJobResp) GetFinishTime() (v string) {
+	return p.FinishTime
+}
+
+var fieldIDToName_ParseJobResp = map[int16]string{
+	1:  "code",
+	2:  "msg",
+	3:  "job_id",
+	4:  "url",
+	5:  "status",
+	6:  "result",
+	7:  "callback_status",
+	8:  "callback_attempts",
+	9:  "create_time",
+	10: "update_time",
+	11: "finish_time",
+}
+
+func (p *ParseJobResp) IsSetResult() bool {
+	return p.Result != nil
+}
+
+func (p *ParseJobResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ParseJobResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ParseJobResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.JobID = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field ParseJobStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = ParseJobStatus(v)
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewWcdParseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Result = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field ParseJobCallbackStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = ParseJobCallbackStatus(v)
+	}
+	p.CallbackStatus = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CallbackAttempts = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreateTime = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdateTime = _field
+	return nil
+}
+func (p *ParseJobResp) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FinishTime = _field
+	return nil
+}
+
+func (p *ParseJobResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ParseJobResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_id", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.JobID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.Status)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResult() {
+		if err = oprot.WriteFieldBegin("result", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Result.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("callback_status", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.CallbackStatus)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("callback_attempts", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.CallbackAttempts); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("create_time", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreateTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("update_time", thrift.STRING, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UpdateTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *ParseJobResp) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("finish_time", thrift.STRING, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FinishTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *ParseJobResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ParseJobResp(%+v)", *p)
+
+}
+
+type WcdService interface {
+	// 基础解析。pdf：切句。web：抓取、切句、去噪
+	BaseParse(ctx context.Context, req *BaseParseReq) (r *WcdParseResp, err error)
+	// 批量基础解析
+	BaseParseBatch(ctx context.Context, req *BaseParseBatchReq) (r *BaseParseBatchResp, err error)
+	// 提交异步解析任务
+	SubmitParseJob(ctx context.Context, req *ParseJobSubmitReq) (r *ParseJobSubmitResp, err error)
+	// 查询异步解析任务的状态和结果
+	GetParseJob(ctx context.Context, req *ParseJobReq) (r *ParseJobResp, err error)
+	// 切分+去噪
+	WcdParse(ctx context.Context, req *WcdParseReq) (r *WcdParseResp, err error)
+	// 切分网页
+	Segment(ctx context.Context, req *SegmentReq) (r *SegmentResp, err error)
+	// 去噪
+	Distill(ctx context.Context, req *DistillReq) (r *DistillResp, err error)
+}
+
+type WcdServiceClient struct {
+	c thrift.TClient
+}
+
+func NewWcdServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *WcdServiceClient {
+	return &WcdServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewWcdServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *WcdServiceClient {
+	return &WcdServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewWcdServiceClient(c thrift.TClient) *WcdServiceClient {
+	return &WcdServiceClient{
+		c: c,
+	}
+}
+
+func (p *WcdServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *WcdServiceClient) BaseParse(ctx context.Context, req *BaseParseReq) (r *WcdParseResp, err error) {
+	var _args WcdServiceBaseParseArgs
+	_args.Req = req
+	var _result WcdServiceBaseParseResult
+	if err = p.Client_().Call(ctx, "BaseParse", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *WcdServiceClient) BaseParseBatch(ctx context.Context, req *BaseParseBatchReq) (r *BaseParseBatchResp, err error) {
+	var _args WcdServiceBaseParseBatchArgs
+	_args.Req = req
+	var _result WcdServiceBaseParseBatchResult
+	if err = p.Client_().Call(ctx, "BaseParseBatch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *WcdServiceClient) SubmitParseJob(ctx context.Context, req *ParseJobSubmitReq) (r *ParseJobSubmitResp, err error) {
+	var _args WcdServiceSubmitParseJobArgs
+	_args.Req = req
+	var _result WcdServiceSubmitParseJobResult
+	if err = p.Client_().Call(ctx, "SubmitParseJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *WcdServiceClient) GetParseJob(ctx context.Context, req *ParseJobReq) (r *ParseJobResp, err error) {
+	var _args WcdServiceGetParseJobArgs
+	_args.Req = req
+	var _result WcdServiceGetParseJobResult
+	if err = p.Client_().Call(ctx, "GetParseJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *WcdServiceClient) WcdParse(ctx context.Context, req *WcdParseReq) (r *WcdParseResp, err error) {
+	var _args WcdServiceWcdParseArgs
+	_args.Req = req
+	var _result WcdServiceWcdParseResult
+	if err = p.Client_().Call(ctx, "WcdParse", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *WcdServiceClient) Segment(ctx context.Context, req *SegmentReq) (r *SegmentResp, err error) {
+	var _args WcdServiceSegmentArgs
+	_args.Req = req
+	var _result WcdServiceSegmentResult
+	if err = p.Client_().Call(ctx, "Segment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *WcdServiceClient) Distill(ctx context.Context, req *DistillReq) (r *DistillResp, err error) {
+	var _args WcdServiceDistillArgs
+	_args.Req = req
+	var _result WcdServiceDistillResult
+	if err = p.Client_().Call(ctx, "Distill", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type WcdServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      WcdService
+}
+
+func (p *WcdServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *WcdServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *WcdServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewWcdServiceProcessor(handler WcdService) *WcdServiceProcessor {
+	self := &WcdServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("BaseParse", &wcdServiceProcessorBaseParse{handler: handler})
+	self.AddToProcessorMap("BaseParseBatch", &wcdServiceProcessorBaseParseBatch{handler: handler})
+	self.AddToProcessorMap("SubmitParseJob", &wcdServiceProcessorSubmitParseJob{handler: handler})
+	self.AddToProcessorMap("GetParseJob", &wcdServiceProcessorGetParseJob{handler: handler})
+	self.AddToProcessorMap("WcdParse", &wcdServiceProcessorWcdParse{handler: handler})
+	self.AddToProcessorMap("Segment", &wcdServiceProcessorSegment{handler: handler})
+	self.AddToProcessorMap("Distill", &wcdServiceProcessorDistill{handler: handler})
+	return self
+}
+func (p *WcdServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type wcdServiceProcessorBaseParse struct {
+	handler WcdService
+}
+
+func (p *wcdServiceProcessorBaseParse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := WcdServiceBaseParseArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BaseParse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := WcdServiceBaseParseResult{}
+	var retval *WcdParseResp
+	if retval, err2 = p.handler.BaseParse(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BaseParse: "+err2.Error())
+		oprot.WriteMessageBegin("BaseParse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BaseParse", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type wcdServiceProcessorBaseParseBatch struct {
+	handler WcdService
+}
+
+func (p *wcdServiceProcessorBaseParseBatch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := WcdServiceBaseParseBatchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BaseParseBatch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := WcdServiceBaseParseBatchResult{}
+	var retval *BaseParseBatchResp
+	if retval, err2 = p.handler.BaseParseBatch(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BaseParseBatch: "+err2.Error())
+		oprot.WriteMessageBegin("BaseParseBatch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BaseParseBatch", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type wcdServiceProcessorSubmitParseJob struct {
+	handler WcdService
+}
+
+func (p *wcdServiceProcessorSubmitParseJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := WcdServiceSubmitParseJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SubmitParseJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := WcdServiceSubmitParseJobResult{}
+	var retval *ParseJobSubmitResp
+	if retval, err2 = p.handler.SubmitParseJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SubmitParseJob: "+err2.Error())
+		oprot.WriteMessageBegin("SubmitParseJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SubmitParseJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type wcdServiceProcessorGetParseJob struct {
+	handler WcdService
+}
+
+func (p *wcdServiceProcessorGetParseJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := WcdServiceGetParseJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetParseJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := WcdServiceGetParseJobResult{}
+	var retval *ParseJobResp
+	if retval, err2 = p.handler.GetParseJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetParseJob: "+err2.Error())
+		oprot.WriteMessageBegin("GetParseJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetParseJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type wcdServiceProcessorWcdParse struct {
+	handler WcdService
+}
+
+func (p *wcdServiceProcessorWcdParse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := WcdServiceWcdParseArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("WcdParse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := WcdServiceWcdParseResult{}
+	var retval *WcdParseResp
+	if retval, err2 = p.handler.WcdParse(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WcdParse: "+err2.Error())
+		oprot.WriteMessageBegin("WcdParse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("WcdParse", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type wcdServiceProcessorSegment struct {
+	handler WcdService
+}
+
+func (p *wcdServiceProcessorSegment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := WcdServiceSegmentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Segment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := WcdServiceSegmentResult{}
+	var retval *SegmentResp
+	if retval, err2 = p.handler.Segment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Segment: "+err2.Error())
+		oprot.WriteMessageBegin("Segment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Segment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type wcdServiceProcessorDistill struct {
+	handler WcdService
+}
+
+func (p *wcdServiceProcessorDistill) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := WcdServiceDistillArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Distill", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := WcdServiceDistillResult{}
+	var retval *DistillResp
+	if retval, err2 = p.handler.Distill(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Distill: "+err2.Error())
+		oprot.WriteMessageBegin("Distill", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Distill", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type WcdServiceBaseParseArgs struct {
+	Req *BaseParseReq `thrift:"req,1"`
+}
+
+func NewWcdServiceBaseParseArgs() *WcdServiceBaseParseArgs {
+	return &WcdServiceBaseParseArgs{}
+}
+
+var WcdServiceBaseParseArgs_Req_DEFAULT *BaseParseReq
+
+func (p *WcdServiceBaseParseArgs) GetReq() (v *BaseParseReq) {
+	if !p.IsSetReq() {
+		return WcdServiceBaseParseArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_WcdServiceBaseParseArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *WcdServiceBaseParseArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *WcdServiceBaseParseArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceBaseParseArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBaseParseReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *WcdServiceBaseParseArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BaseParse_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WcdServiceBaseParseArgs(%+v)", *p)
+
+}
+
+type WcdServiceBaseParseResult struct {
+	Success *WcdParseResp `thrift:"success,0,optional"`
+}
+
+func NewWcdServiceBaseParseResult() *WcdServiceBaseParseResult {
+	return &WcdServiceBaseParseResult{}
+}
+
+var WcdServiceBaseParseResult_Success_DEFAULT *WcdParseResp
+
+func (p *WcdServiceBaseParseResult) GetSuccess() (v *WcdParseResp) {
+	if !p.IsSetSuccess() {
+		return WcdServiceBaseParseResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_WcdServiceBaseParseResult = map[int16]string{
+	0: "success",
+}
+
+func (p *WcdServiceBaseParseResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *WcdServiceBaseParseResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceBaseParseResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewWcdParseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *WcdServiceBaseParseResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BaseParse_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
 		if err = oprot.WriteFieldEnd(); err != nil {
 			goto WriteFieldEndError
 		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *BaseParseReq) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSkipCache() {
-		if err = oprot.WriteFieldBegin("skip_cache", thrift.BOOL, 8); err != nil {
-			goto WriteFieldBeginError
+func (p *WcdServiceBaseParseResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WcdServiceBaseParseResult(%+v)", *p)
+
+}
+
+type WcdServiceBaseParseBatchArgs struct {
+	Req *BaseParseBatchReq `thrift:"req,1"`
+}
+
+func NewWcdServiceBaseParseBatchArgs() *WcdServiceBaseParseBatchArgs {
+	return &WcdServiceBaseParseBatchArgs{}
+}
+
+var WcdServiceBaseParseBatchArgs_Req_DEFAULT *BaseParseBatchReq
+
+func (p *WcdServiceBaseParseBatchArgs) GetReq() (v *BaseParseBatchReq) {
+	if !p.IsSetReq() {
+		return WcdServiceBaseParseBatchArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_WcdServiceBaseParseBatchArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *WcdServiceBaseParseBatchArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *WcdServiceBaseParseBatchArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.SkipCache); err != nil {
-			return err
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceBaseParseBatchArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseBatchArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBaseParseBatchReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *WcdServiceBaseParseBatchArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BaseParseBatch_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
 	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseBatchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseBatchArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WcdServiceBaseParseBatchArgs(%+v)", *p)
+
+}
+
+type WcdServiceBaseParseBatchResult struct {
+	Success *BaseParseBatchResp `thrift:"success,0,optional"`
+}
+
+func NewWcdServiceBaseParseBatchResult() *WcdServiceBaseParseBatchResult {
+	return &WcdServiceBaseParseBatchResult{}
+}
+
+var WcdServiceBaseParseBatchResult_Success_DEFAULT *BaseParseBatchResp
+
+func (p *WcdServiceBaseParseBatchResult) GetSuccess() (v *BaseParseBatchResp) {
+	if !p.IsSetSuccess() {
+		return WcdServiceBaseParseBatchResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_WcdServiceBaseParseBatchResult = map[int16]string{
+	0: "success",
+}
+
+func (p *WcdServiceBaseParseBatchResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *WcdServiceBaseParseBatchResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceBaseParseBatchResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *WcdServiceBaseParseBatchResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBaseParseBatchResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *WcdServiceBaseParseBatchResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BaseParseBatch_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BaseParseReq) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSaveCrawlHTML() {
-		if err = oprot.WriteFieldBegin("save_crawl_html", thrift.BOOL, 9); err != nil {
+func (p *WcdServiceBaseParseBatchResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.SaveCrawlHTML); err != nil {
+		if err := p.Success.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6192,355 +12497,333 @@ func (p *BaseParseReq) writeField9(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *BaseParseReq) String() string {
+func (p *WcdServiceBaseParseBatchResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BaseParseReq(%+v)", *p)
+	return fmt.Sprintf("WcdServiceBaseParseBatchResult(%+v)", *p)
 
 }
 
-type WcdService interface {
-	// 基础解析。pdf：切句。web：抓取、切句、去噪
-	BaseParse(ctx context.Context, req *BaseParseReq) (r *WcdParseResp, err error)
-	// 切分+去噪
-	WcdParse(ctx context.Context, req *WcdParseReq) (r *WcdParseResp, err error)
-	// 切分网页
-	Segment(ctx context.Context, req *SegmentReq) (r *SegmentResp, err error)
-	// 去噪
-	Distill(ctx context.Context, req *DistillReq) (r *DistillResp, err error)
-}
-
-type WcdServiceClient struct {
-	c thrift.TClient
+type WcdServiceSubmitParseJobArgs struct {
+	Req *ParseJobSubmitReq `thrift:"req,1"`
 }
 
-func NewWcdServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *WcdServiceClient {
-	return &WcdServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func NewWcdServiceSubmitParseJobArgs() *WcdServiceSubmitParseJobArgs {
+	return &WcdServiceSubmitParseJobArgs{}
 }
 
-func NewWcdServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *WcdServiceClient {
-	return &WcdServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
-}
+var WcdServiceSubmitParseJobArgs_Req_DEFAULT *ParseJobSubmitReq
 
-func NewWcdServiceClient(c thrift.TClient) *WcdServiceClient {
-	return &WcdServiceClient{
-		c: c,
+func (p *WcdServiceSubmitParseJobArgs) GetReq() (v *ParseJobSubmitReq) {
+	if !p.IsSetReq() {
+		return WcdServiceSubmitParseJobArgs_Req_DEFAULT
 	}
+	return p.Req
 }
 
-func (p *WcdServiceClient) Client_() thrift.TClient {
-	return p.c
+var fieldIDToName_WcdServiceSubmitParseJobArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *WcdServiceClient) BaseParse(ctx context.Context, req *BaseParseReq) (r *WcdParseResp, err error) {
-	var _args WcdServiceBaseParseArgs
-	_args.Req = req
-	var _result WcdServiceBaseParseResult
-	if err = p.Client_().Call(ctx, "BaseParse", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *WcdServiceClient) WcdParse(ctx context.Context, req *WcdParseReq) (r *WcdParseResp, err error) {
-	var _args WcdServiceWcdParseArgs
-	_args.Req = req
-	var _result WcdServiceWcdParseResult
-	if err = p.Client_().Call(ctx, "WcdParse", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *WcdServiceClient) Segment(ctx context.Context, req *SegmentReq) (r *SegmentResp, err error) {
-	var _args WcdServiceSegmentArgs
-	_args.Req = req
-	var _result WcdServiceSegmentResult
-	if err = p.Client_().Call(ctx, "Segment", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *WcdServiceClient) Distill(ctx context.Context, req *DistillReq) (r *DistillResp, err error) {
-	var _args WcdServiceDistillArgs
-	_args.Req = req
-	var _result WcdServiceDistillResult
-	if err = p.Client_().Call(ctx, "Distill", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *WcdServiceSubmitParseJobArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-type WcdServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      WcdService
-}
+func (p *WcdServiceSubmitParseJobArgs) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *WcdServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *WcdServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-func (p *WcdServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func NewWcdServiceProcessor(handler WcdService) *WcdServiceProcessor {
-	self := &WcdServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("BaseParse", &wcdServiceProcessorBaseParse{handler: handler})
-	self.AddToProcessorMap("WcdParse", &wcdServiceProcessorWcdParse{handler: handler})
-	self.AddToProcessorMap("Segment", &wcdServiceProcessorSegment{handler: handler})
-	self.AddToProcessorMap("Distill", &wcdServiceProcessorDistill{handler: handler})
-	return self
-}
-func (p *WcdServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
-
-type wcdServiceProcessorBaseParse struct {
-	handler WcdService
-}
 
-func (p *wcdServiceProcessorBaseParse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := WcdServiceBaseParseArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BaseParse", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceSubmitParseJobArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := WcdServiceBaseParseResult{}
-	var retval *WcdParseResp
-	if retval, err2 = p.handler.BaseParse(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BaseParse: "+err2.Error())
-		oprot.WriteMessageBegin("BaseParse", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("BaseParse", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type wcdServiceProcessorWcdParse struct {
-	handler WcdService
+func (p *WcdServiceSubmitParseJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewParseJobSubmitReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
 }
 
-func (p *wcdServiceProcessorWcdParse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := WcdServiceWcdParseArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("WcdParse", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *WcdServiceSubmitParseJobArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitParseJob_args"); err != nil {
+		goto WriteStructBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := WcdServiceWcdParseResult{}
-	var retval *WcdParseResp
-	if retval, err2 = p.handler.WcdParse(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WcdParse: "+err2.Error())
-		oprot.WriteMessageBegin("WcdParse", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("WcdParse", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *WcdServiceSubmitParseJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *WcdServiceSubmitParseJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("WcdServiceSubmitParseJobArgs(%+v)", *p)
+
 }
 
-type wcdServiceProcessorSegment struct {
-	handler WcdService
+type WcdServiceSubmitParseJobResult struct {
+	Success *ParseJobSubmitResp `thrift:"success,0,optional"`
 }
 
-func (p *wcdServiceProcessorSegment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := WcdServiceSegmentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Segment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewWcdServiceSubmitParseJobResult() *WcdServiceSubmitParseJobResult {
+	return &WcdServiceSubmitParseJobResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := WcdServiceSegmentResult{}
-	var retval *SegmentResp
-	if retval, err2 = p.handler.Segment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Segment: "+err2.Error())
-		oprot.WriteMessageBegin("Segment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Segment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+var WcdServiceSubmitParseJobResult_Success_DEFAULT *ParseJobSubmitResp
+
+func (p *WcdServiceSubmitParseJobResult) GetSuccess() (v *ParseJobSubmitResp) {
+	if !p.IsSetSuccess() {
+		return WcdServiceSubmitParseJobResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_WcdServiceSubmitParseJobResult = map[int16]string{
+	0: "success",
+}
+
+func (p *WcdServiceSubmitParseJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *WcdServiceSubmitParseJobResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type wcdServiceProcessorDistill struct {
-	handler WcdService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceSubmitParseJobResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *wcdServiceProcessorDistill) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := WcdServiceDistillArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Distill", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *WcdServiceSubmitParseJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewParseJobSubmitResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := WcdServiceDistillResult{}
-	var retval *DistillResp
-	if retval, err2 = p.handler.Distill(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Distill: "+err2.Error())
-		oprot.WriteMessageBegin("Distill", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *WcdServiceSubmitParseJobResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitParseJob_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("Distill", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *WcdServiceSubmitParseJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *WcdServiceSubmitParseJobResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("WcdServiceSubmitParseJobResult(%+v)", *p)
+
 }
 
-type WcdServiceBaseParseArgs struct {
-	Req *BaseParseReq `thrift:"req,1"`
+type WcdServiceGetParseJobArgs struct {
+	Req *ParseJobReq `thrift:"req,1"`
 }
 
-func NewWcdServiceBaseParseArgs() *WcdServiceBaseParseArgs {
-	return &WcdServiceBaseParseArgs{}
+func NewWcdServiceGetParseJobArgs() *WcdServiceGetParseJobArgs {
+	return &WcdServiceGetParseJobArgs{}
 }
 
-var WcdServiceBaseParseArgs_Req_DEFAULT *BaseParseReq
+var WcdServiceGetParseJobArgs_Req_DEFAULT *ParseJobReq
 
-func (p *WcdServiceBaseParseArgs) GetReq() (v *BaseParseReq) {
+func (p *WcdServiceGetParseJobArgs) GetReq() (v *ParseJobReq) {
 	if !p.IsSetReq() {
-		return WcdServiceBaseParseArgs_Req_DEFAULT
+		return WcdServiceGetParseJobArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_WcdServiceBaseParseArgs = map[int16]string{
+var fieldIDToName_WcdServiceGetParseJobArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *WcdServiceBaseParseArgs) IsSetReq() bool {
+func (p *WcdServiceGetParseJobArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *WcdServiceBaseParseArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *WcdServiceGetParseJobArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6586,7 +12869,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceBaseParseArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceGetParseJobArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6596,8 +12879,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *WcdServiceBaseParseArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBaseParseReq()
+func (p *WcdServiceGetParseJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewParseJobReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6605,9 +12888,9 @@ func (p *WcdServiceBaseParseArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WcdServiceBaseParseArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *WcdServiceGetParseJobArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BaseParse_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetParseJob_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6633,7 +12916,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *WcdServiceBaseParseArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WcdServiceGetParseJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6650,40 +12933,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *WcdServiceBaseParseArgs) String() string {
+func (p *WcdServiceGetParseJobArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WcdServiceBaseParseArgs(%+v)", *p)
+	return fmt.Sprintf("WcdServiceGetParseJobArgs(%+v)", *p)
 
 }
 
-type WcdServiceBaseParseResult struct {
-	Success *WcdParseResp `thrift:"success,0,optional"`
+type WcdServiceGetParseJobResult struct {
+	Success *ParseJobResp `thrift:"success,0,optional"`
 }
 
-func NewWcdServiceBaseParseResult() *WcdServiceBaseParseResult {
-	return &WcdServiceBaseParseResult{}
+func NewWcdServiceGetParseJobResult() *WcdServiceGetParseJobResult {
+	return &WcdServiceGetParseJobResult{}
 }
 
-var WcdServiceBaseParseResult_Success_DEFAULT *WcdParseResp
+var WcdServiceGetParseJobResult_Success_DEFAULT *ParseJobResp
 
-func (p *WcdServiceBaseParseResult) GetSuccess() (v *WcdParseResp) {
+func (p *WcdServiceGetParseJobResult) GetSuccess() (v *ParseJobResp) {
 	if !p.IsSetSuccess() {
-		return WcdServiceBaseParseResult_Success_DEFAULT
+		return WcdServiceGetParseJobResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_WcdServiceBaseParseResult = map[int16]string{
+var fieldIDToName_WcdServiceGetParseJobResult = map[int16]string{
 	0: "success",
 }
 
-func (p *WcdServiceBaseParseResult) IsSetSuccess() bool {
+func (p *WcdServiceGetParseJobResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *WcdServiceBaseParseResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *WcdServiceGetParseJobResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6729,7 +13012,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceBaseParseResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_WcdServiceGetParseJobResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6739,8 +13022,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *WcdServiceBaseParseResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewWcdParseResp()
+func (p *WcdServiceGetParseJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewParseJobResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6748,9 +13031,9 @@ func (p *WcdServiceBaseParseResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WcdServiceBaseParseResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *WcdServiceGetParseJobResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BaseParse_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetParseJob_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6776,7 +13059,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *WcdServiceBaseParseResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WcdServiceGetParseJobResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6795,11 +13078,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *WcdServiceBaseParseResult) String() string {
+func (p *WcdServiceGetParseJobResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WcdServiceBaseParseResult(%+v)", *p)
+	return fmt.Sprintf("WcdServiceGetParseJobResult(%+v)", *p)
 
 }
 
@@ -594,6 +594,32 @@ type SiteRuleData struct {
 	BodyUseRuleOnly   bool              `thrift:"body_use_rule_only,13" form:"body_use_rule_only" json:"body_use_rule_only" query:"body_use_rule_only"`
 	CreateTime        string            `thrift:"create_time,14" form:"create_time" json:"create_time" query:"create_time"`
 	UpdateTime        string            `thrift:"update_time,15" form:"update_time" json:"update_time" query:"update_time"`
+	// 指定标注器，为空时使用全局配置
+	Labeler string `thrift:"labeler,16" form:"labeler" json:"labeler" query:"labeler"`
+	// 依次尝试的抓取后端，为空时使用全局配置的抓取链
+	CrawlBackends []string `thrift:"crawl_backends,17" form:"crawl_backends" json:"crawl_backends" query:"crawl_backends"`
+	// 抓取时附加的请求头，格式为"Name: value"
+	CrawlHeaders []string `thrift:"crawl_headers,18" form:"crawl_headers" json:"crawl_headers" query:"crawl_headers"`
+	// 抓取时附加的Cookie，格式为"a=1; b=2"
+	CrawlCookie string `thrift:"crawl_cookie,19" form:"crawl_cookie" json:"crawl_cookie" query:"crawl_cookie"`
+	// 单次抓取的超时，如"30s"，为空时使用抓取后端的默认超时
+	CrawlTimeout string `thrift:"crawl_timeout,20" form:"crawl_timeout" json:"crawl_timeout" query:"crawl_timeout"`
+	// 每秒抓取次数上限，为0时使用全局配置
+	CrawlQPS float64 `thrift:"crawl_qps,21" form:"crawl_qps" json:"crawl_qps" query:"crawl_qps"`
+	// 同时抓取数上限，为0时使用全局配置
+	CrawlMaxInFlight int32 `thrift:"crawl_max_in_flight,22" form:"crawl_max_in_flight" json:"crawl_max_in_flight" query:"crawl_max_in_flight"`
+	// 更新规则时的修改人，记录在规则版本中
+	RevisionAuthor *string `thrift:"revision_author,23,optional" form:"revision_author" json:"revision_author,omitempty" query:"revision_author"`
+	// 更新规则时的修改说明，记录在规则版本中
+	RevisionComment *string `thrift:"revision_comment,24,optional" form:"revision_comment" json:"revision_comment,omitempty" query:"revision_comment"`
+	// host的匹配方式：exact、suffix、glob、regex，为空时按旧的前缀和正则匹配
+	MatchType string `thrift:"match_type,25" form:"match_type" json:"match_type" query:"match_type"`
+	// 按match_type匹配的host，为空时使用host
+	HostPattern string `thrift:"host_pattern,26" form:"host_pattern" json:"host_pattern" query:"host_pattern"`
+	// url路径的匹配规则，为空时匹配所有路径
+	PathPattern string `thrift:"path_pattern,27" form:"path_pattern" json:"path_pattern" query:"path_pattern"`
+	// 同时命中多条规则时，优先级高的生效
+	Priority int32 `thrift:"priority,28" form:"priority" json:"priority" query:"priority"`
 }
 
 func NewSiteRuleData() *SiteRuleData {
@@ -660,6 +686,68 @@ func (p *SiteRuleData) GetUpdateTime() (v string) {
 	return p.UpdateTime
 }
 
+func (p *SiteRuleData) GetLabeler() (v string) {
+	return p.Labeler
+}
+
+func (p *SiteRuleData) GetCrawlBackends() (v []string) {
+	return p.CrawlBackends
+}
+
+func (p *SiteRuleData) GetCrawlHeaders() (v []string) {
+	return p.CrawlHeaders
+}
+
+func (p *SiteRuleData) GetCrawlCookie() (v string) {
+	return p.CrawlCookie
+}
+
+func (p *SiteRuleData) GetCrawlTimeout() (v string) {
+	return p.CrawlTimeout
+}
+
+func (p *SiteRuleData) GetCrawlQPS() (v float64) {
+	return p.CrawlQPS
+}
+
+func (p *SiteRuleData) GetCrawlMaxInFlight() (v int32) {
+	return p.CrawlMaxInFlight
+}
+
+var SiteRuleData_RevisionAuthor_DEFAULT string
+
+func (p *SiteRuleData) GetRevisionAuthor() (v string) {
+	if !p.IsSetRevisionAuthor() {
+		return SiteRuleData_RevisionAuthor_DEFAULT
+	}
+	return *p.RevisionAuthor
+}
+
+var SiteRuleData_RevisionComment_DEFAULT string
+
+func (p *SiteRuleData) GetRevisionComment() (v string) {
+	if !p.IsSetRevisionComment() {
+		return SiteRuleData_RevisionComment_DEFAULT
+	}
+	return *p.RevisionComment
+}
+
+func (p *SiteRuleData) GetMatchType() (v string) {
+	return p.MatchType
+}
+
+func (p *SiteRuleData) GetHostPattern() (v string) {
+	return p.HostPattern
+}
+
+func (p *SiteRuleData) GetPathPattern() (v string) {
+	return p.PathPattern
+}
+
+func (p *SiteRuleData) GetPriority() (v int32) {
+	return p.Priority
+}
+
 var fieldIDToName_SiteRuleData = map[int16]string{
 	1:  "id",
 	2:  "host",
@@ -676,6 +764,27 @@ var fieldIDToName_SiteRuleData = map[int16]string{
 	13: "body_use_rule_only",
 	14: "create_time",
 	15: "update_time",
+	16: "labeler",
+	17: "crawl_backends",
+	18: "crawl_headers",
+	19: "crawl_cookie",
+	20: "crawl_timeout",
+	21: "crawl_qps",
+	22: "crawl_max_in_flight",
+	23: "revision_author",
+	24: "revision_comment",
+	25: "match_type",
+	26: "host_pattern",
+	27: "path_pattern",
+	28: "priority",
+}
+
+func (p *SiteRuleData) IsSetRevisionAuthor() bool {
+	return p.RevisionAuthor != nil
+}
+
+func (p *SiteRuleData) IsSetRevisionComment() bool {
+	return p.RevisionComment != nil
 }
 
 func (p *SiteRuleData) Read(iprot thrift.TProtocol) (err error) {
@@ -817,6 +926,110 @@ func (p *SiteRuleData) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 16:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 17:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField17(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 18:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField18(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 19:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField19(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 20:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField20(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 21:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField21(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 22:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField22(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 23:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField23(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 24:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField24(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 25:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField25(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 26:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField26(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 27:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField27(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 28:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField28(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1047,6 +1260,173 @@ func (p *SiteRuleData) ReadField15(iprot thrift.TProtocol) error {
 	p.UpdateTime = _field
 	return nil
 }
+func (p *SiteRuleData) ReadField16(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Labeler = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField17(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.CrawlBackends = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField18(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.CrawlHeaders = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField19(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CrawlCookie = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField20(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CrawlTimeout = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField21(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CrawlQPS = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField22(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CrawlMaxInFlight = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField23(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RevisionAuthor = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField24(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RevisionComment = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField25(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MatchType = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField26(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HostPattern = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField27(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PathPattern = _field
+	return nil
+}
+func (p *SiteRuleData) ReadField28(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Priority = _field
+	return nil
+}
 
 func (p *SiteRuleData) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1114,12 +1494,64 @@ func (p *SiteRuleData) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 15
 			goto WriteFieldError
 		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
+		if err = p.writeField18(oprot); err != nil {
+			fieldId = 18
+			goto WriteFieldError
+		}
+		if err = p.writeField19(oprot); err != nil {
+			fieldId = 19
+			goto WriteFieldError
+		}
+		if err = p.writeField20(oprot); err != nil {
+			fieldId = 20
+			goto WriteFieldError
+		}
+		if err = p.writeField21(oprot); err != nil {
+			fieldId = 21
+			goto WriteFieldError
+		}
+		if err = p.writeField22(oprot); err != nil {
+			fieldId = 22
+			goto WriteFieldError
+		}
+		if err = p.writeField23(oprot); err != nil {
+			fieldId = 23
+			goto WriteFieldError
+		}
+		if err = p.writeField24(oprot); err != nil {
+			fieldId = 24
+			goto WriteFieldError
+		}
+		if err = p.writeField25(oprot); err != nil {
+			fieldId = 25
+			goto WriteFieldError
+		}
+		if err = p.writeField26(oprot); err != nil {
+			fieldId = 26
+			goto WriteFieldError
+		}
+		if err = p.writeField27(oprot); err != nil {
+			fieldId = 27
+			goto WriteFieldError
+		}
+		if err = p.writeField28(oprot); err != nil {
+			fieldId = 28
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
 WriteStructBeginError:
@@ -1411,6 +1843,247 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
 }
 
+func (p *SiteRuleData) writeField16(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("labeler", thrift.STRING, 16); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Labeler); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField17(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("crawl_backends", thrift.LIST, 17); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.CrawlBackends)); err != nil {
+		return err
+	}
+	for _, v := range p.CrawlBackends {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField18(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("crawl_headers", thrift.LIST, 18); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.CrawlHeaders)); err != nil {
+		return err
+	}
+	for _, v := range p.CrawlHeaders {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField19(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("crawl_cookie", thrift.STRING, 19); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CrawlCookie); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField20(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("crawl_timeout", thrift.STRING, 20); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CrawlTimeout); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField21(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("crawl_qps", thrift.DOUBLE, 21); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.CrawlQPS); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField22(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("crawl_max_in_flight", thrift.I32, 22); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.CrawlMaxInFlight); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField23(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRevisionAuthor() {
+		if err = oprot.WriteFieldBegin("revision_author", thrift.STRING, 23); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RevisionAuthor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField24(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRevisionComment() {
+		if err = oprot.WriteFieldBegin("revision_comment", thrift.STRING, 24); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RevisionComment); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField25(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_type", thrift.STRING, 25); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MatchType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField26(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host_pattern", thrift.STRING, 26); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.HostPattern); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 26 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 26 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField27(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("path_pattern", thrift.STRING, 27); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PathPattern); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 27 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 27 end error: ", p), err)
+}
+
+func (p *SiteRuleData) writeField28(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("priority", thrift.I32, 28); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Priority); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 28 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 28 end error: ", p), err)
+}
+
 func (p *SiteRuleData) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2262,6 +2935,8 @@ type UpdateSiteRuleResp struct {
 	Code int32         `thrift:"code,1" form:"code" json:"code" query:"code"`
 	Msg  string        `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
 	Data *SiteRuleData `thrift:"data,3" form:"data" json:"data" query:"data"`
+	// 规则校验不通过时的字段错误
+	Errors []*SiteRuleFieldError `thrift:"errors,4,optional" form:"errors" json:"errors,omitempty" query:"errors"`
 }
 
 func NewUpdateSiteRuleResp() *UpdateSiteRuleResp {
@@ -2285,16 +2960,30 @@ func (p *UpdateSiteRuleResp) GetData() (v *SiteRuleData) {
 	return p.Data
 }
 
+var UpdateSiteRuleResp_Errors_DEFAULT []*SiteRuleFieldError
+
+func (p *UpdateSiteRuleResp) GetErrors() (v []*SiteRuleFieldError) {
+	if !p.IsSetErrors() {
+		return UpdateSiteRuleResp_Errors_DEFAULT
+	}
+	return p.Errors
+}
+
 var fieldIDToName_UpdateSiteRuleResp = map[int16]string{
 	1: "code",
 	2: "msg",
 	3: "data",
+	4: "errors",
 }
 
 func (p *UpdateSiteRuleResp) IsSetData() bool {
 	return p.Data != nil
 }
 
+func (p *UpdateSiteRuleResp) IsSetErrors() bool {
+	return p.Errors != nil
+}
+
 func (p *UpdateSiteRuleResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -2338,6 +3027,14 @@ func (p *UpdateSiteRuleResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2397,6 +3094,28 @@ func (p *UpdateSiteRuleResp) ReadField3(iprot thrift.TProtocol) error {
 	p.Data = _field
 	return nil
 }
+func (p *UpdateSiteRuleResp) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleFieldError, 0, size)
+	values := make([]SiteRuleFieldError, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Errors = _field
+	return nil
+}
 
 func (p *UpdateSiteRuleResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2416,6 +3135,10 @@ func (p *UpdateSiteRuleResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2485,12 +3208,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *UpdateSiteRuleResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UpdateSiteRuleResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrors() {
+		if err = oprot.WriteFieldBegin("errors", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Errors)); err != nil {
+			return err
+		}
+		for _, v := range p.Errors {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("UpdateSiteRuleResp(%+v)", *p)
-
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UpdateSiteRuleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateSiteRuleResp(%+v)", *p)
+
 }
 
 // 从正式规则导出一个测试规则
@@ -2869,6 +3619,9 @@ func (p *ExportProdRuleToTestResp) String() string {
 // 发布测试状态的规则至正式
 type PublishSiteRuleReq struct {
 	Host []string `thrift:"host,1" form:"host" json:"host" query:"host"`
+	// 记录在规则版本中
+	Author  *string `thrift:"author,2,optional" form:"author" json:"author,omitempty" query:"author"`
+	Comment *string `thrift:"comment,3,optional" form:"comment" json:"comment,omitempty" query:"comment"`
 }
 
 func NewPublishSiteRuleReq() *PublishSiteRuleReq {
@@ -2879,8 +3632,36 @@ func (p *PublishSiteRuleReq) GetHost() (v []string) {
 	return p.Host
 }
 
+var PublishSiteRuleReq_Author_DEFAULT string
+
+func (p *PublishSiteRuleReq) GetAuthor() (v string) {
+	if !p.IsSetAuthor() {
+		return PublishSiteRuleReq_Author_DEFAULT
+	}
+	return *p.Author
+}
+
+var PublishSiteRuleReq_Comment_DEFAULT string
+
+func (p *PublishSiteRuleReq) GetComment() (v string) {
+	if !p.IsSetComment() {
+		return PublishSiteRuleReq_Comment_DEFAULT
+	}
+	return *p.Comment
+}
+
 var fieldIDToName_PublishSiteRuleReq = map[int16]string{
 	1: "host",
+	2: "author",
+	3: "comment",
+}
+
+func (p *PublishSiteRuleReq) IsSetAuthor() bool {
+	return p.Author != nil
+}
+
+func (p *PublishSiteRuleReq) IsSetComment() bool {
+	return p.Comment != nil
 }
 
 func (p *PublishSiteRuleReq) Read(iprot thrift.TProtocol) (err error) {
@@ -2910,6 +3691,22 @@ func (p *PublishSiteRuleReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2962,6 +3759,28 @@ func (p *PublishSiteRuleReq) ReadField1(iprot thrift.TProtocol) error {
 	p.Host = _field
 	return nil
 }
+func (p *PublishSiteRuleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Author = _field
+	return nil
+}
+func (p *PublishSiteRuleReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Comment = _field
+	return nil
+}
 
 func (p *PublishSiteRuleReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2973,6 +3792,14 @@ func (p *PublishSiteRuleReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3016,6 +3843,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
+func (p *PublishSiteRuleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthor() {
+		if err = oprot.WriteFieldBegin("author", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Author); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *PublishSiteRuleReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetComment() {
+		if err = oprot.WriteFieldBegin("comment", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Comment); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
 func (p *PublishSiteRuleReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3263,6 +4128,9 @@ func (p *PublishSiteRuleResp) String() string {
 type DeleteSiteRuleReq struct {
 	Host  string            `thrift:"host,1" form:"host" json:"host" query:"host"`
 	Stage wcd.RuleStageType `thrift:"stage,2" form:"stage" json:"stage" query:"stage"`
+	// 记录在规则版本中
+	Author  *string `thrift:"author,3,optional" form:"author" json:"author,omitempty" query:"author"`
+	Comment *string `thrift:"comment,4,optional" form:"comment" json:"comment,omitempty" query:"comment"`
 }
 
 func NewDeleteSiteRuleReq() *DeleteSiteRuleReq {
@@ -3277,9 +4145,37 @@ func (p *DeleteSiteRuleReq) GetStage() (v wcd.RuleStageType) {
 	return p.Stage
 }
 
+var DeleteSiteRuleReq_Author_DEFAULT string
+
+func (p *DeleteSiteRuleReq) GetAuthor() (v string) {
+	if !p.IsSetAuthor() {
+		return DeleteSiteRuleReq_Author_DEFAULT
+	}
+	return *p.Author
+}
+
+var DeleteSiteRuleReq_Comment_DEFAULT string
+
+func (p *DeleteSiteRuleReq) GetComment() (v string) {
+	if !p.IsSetComment() {
+		return DeleteSiteRuleReq_Comment_DEFAULT
+	}
+	return *p.Comment
+}
+
 var fieldIDToName_DeleteSiteRuleReq = map[int16]string{
 	1: "host",
 	2: "stage",
+	3: "author",
+	4: "comment",
+}
+
+func (p *DeleteSiteRuleReq) IsSetAuthor() bool {
+	return p.Author != nil
+}
+
+func (p *DeleteSiteRuleReq) IsSetComment() bool {
+	return p.Comment != nil
 }
 
 func (p *DeleteSiteRuleReq) Read(iprot thrift.TProtocol) (err error) {
@@ -3317,6 +4213,22 @@ func (p *DeleteSiteRuleReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3368,6 +4280,28 @@ func (p *DeleteSiteRuleReq) ReadField2(iprot thrift.TProtocol) error {
 	p.Stage = _field
 	return nil
 }
+func (p *DeleteSiteRuleReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Author = _field
+	return nil
+}
+func (p *DeleteSiteRuleReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Comment = _field
+	return nil
+}
 
 func (p *DeleteSiteRuleReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -3383,6 +4317,14 @@ func (p *DeleteSiteRuleReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3435,6 +4377,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *DeleteSiteRuleReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthor() {
+		if err = oprot.WriteFieldBegin("author", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Author); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *DeleteSiteRuleReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetComment() {
+		if err = oprot.WriteFieldBegin("comment", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Comment); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *DeleteSiteRuleReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3880,6 +4860,8 @@ func (p *ExportSiteRulesResp) String() string {
 type ImportSiteRulesResp struct {
 	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
 	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	// 规则校验不通过时的字段错误
+	Errors []*SiteRuleFieldError `thrift:"errors,3,optional" form:"errors" json:"errors,omitempty" query:"errors"`
 }
 
 func NewImportSiteRulesResp() *ImportSiteRulesResp {
@@ -3894,9 +4876,23 @@ func (p *ImportSiteRulesResp) GetMsg() (v string) {
 	return p.Msg
 }
 
+var ImportSiteRulesResp_Errors_DEFAULT []*SiteRuleFieldError
+
+func (p *ImportSiteRulesResp) GetErrors() (v []*SiteRuleFieldError) {
+	if !p.IsSetErrors() {
+		return ImportSiteRulesResp_Errors_DEFAULT
+	}
+	return p.Errors
+}
+
 var fieldIDToName_ImportSiteRulesResp = map[int16]string{
 	1: "code",
 	2: "msg",
+	3: "errors",
+}
+
+func (p *ImportSiteRulesResp) IsSetErrors() bool {
+	return p.Errors != nil
 }
 
 func (p *ImportSiteRulesResp) Read(iprot thrift.TProtocol) (err error) {
@@ -3934,6 +4930,14 @@ func (p *ImportSiteRulesResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3985,6 +4989,28 @@ func (p *ImportSiteRulesResp) ReadField2(iprot thrift.TProtocol) error {
 	p.Msg = _field
 	return nil
 }
+func (p *ImportSiteRulesResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleFieldError, 0, size)
+	values := make([]SiteRuleFieldError, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Errors = _field
+	return nil
+}
 
 func (p *ImportSiteRulesResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -4000,6 +5026,10 @@ func (p *ImportSiteRulesResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4052,6 +5082,33 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *ImportSiteRulesResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrors() {
+		if err = oprot.WriteFieldBegin("errors", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Errors)); err != nil {
+			return err
+		}
+		for _, v := range p.Errors {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
 func (p *ImportSiteRulesResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -4060,642 +5117,10273 @@ func (p *ImportSiteRulesResp) String() string {
 
 }
 
-type RuleFactory interface {
-	// 查看各站点规则列表
-	SiteRuleList(ctx context.Context, req *SiteRuleListReq) (r *SiteRuleListResp, err error)
-	// 查看各站点规则详情
-	SiteRuleDetail(ctx context.Context, req *SiteRuleDetailReq) (r *SiteRuleDetailResp, err error)
-	// 新建规则，默认处于测试状态
-	CreateSiteRule(ctx context.Context, req *CreateSiteRuleReq) (r *CreateSiteRuleResp, err error)
-	// 更新规则，只能更新测试规则
-	UpdateSiteRule(ctx context.Context, req *SiteRuleData) (r *UpdateSiteRuleResp, err error)
-	// 从正式规则导出一个测试规则
-	CreateSiteRuleFromProd(ctx context.Context, req *ExportProdRuleToTestReq) (r *ExportProdRuleToTestResp, err error)
-	// 发布测试状态的规则至正式
-	PublishSiteRule(ctx context.Context, req *PublishSiteRuleReq) (r *PublishSiteRuleResp, err error)
-	// 删除规则
-	DeleteSiteRule(ctx context.Context, req *DeleteSiteRuleReq) (r *DeleteSiteRuleResp, err error)
-	// 导出所有站点规则
-	ExportSiteRules(ctx context.Context, req *EmptyReq) (r *ExportSiteRulesResp, err error)
-	// 导出所有站点规则
-	ImportSiteRules(ctx context.Context, req *EmptyReq) (r *ImportSiteRulesResp, err error)
+// 规则的一个版本，发布、更新、删除、回滚和导入时写入
+type SiteRuleRevision struct {
+	Host string `thrift:"host,1" form:"host" json:"host" query:"host"`
+	// 同一host内从1开始递增
+	Revision int64 `thrift:"revision,2" form:"revision" json:"revision" query:"revision"`
+	// update / publish / delete / rollback / import
+	Action string `thrift:"action,3" form:"action" json:"action" query:"action"`
+	// 变更后的规则，删除时为删除前的规则
+	Rule       *SiteRuleData `thrift:"rule,4" form:"rule" json:"rule" query:"rule"`
+	Author     string        `thrift:"author,5" form:"author" json:"author" query:"author"`
+	Comment    string        `thrift:"comment,6" form:"comment" json:"comment" query:"comment"`
+	CreateTime string        `thrift:"create_time,7" form:"create_time" json:"create_time" query:"create_time"`
 }
 
-type RuleFactoryClient struct {
-	c thrift.TClient
+func NewSiteRuleRevision() *SiteRuleRevision {
+	return &SiteRuleRevision{}
 }
 
-func NewRuleFactoryClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *RuleFactoryClient {
-	return &RuleFactoryClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *SiteRuleRevision) GetHost() (v string) {
+	return p.Host
 }
 
-func NewRuleFactoryClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *RuleFactoryClient {
-	return &RuleFactoryClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *SiteRuleRevision) GetRevision() (v int64) {
+	return p.Revision
 }
 
-func NewRuleFactoryClient(c thrift.TClient) *RuleFactoryClient {
-	return &RuleFactoryClient{
-		c: c,
+func (p *SiteRuleRevision) GetAction() (v string) {
+	return p.Action
+}
+
+var SiteRuleRevision_Rule_DEFAULT *SiteRuleData
+
+func (p *SiteRuleRevision) GetRule() (v *SiteRuleData) {
+	if !p.IsSetRule() {
+		return SiteRuleRevision_Rule_DEFAULT
 	}
+	return p.Rule
 }
 
-func (p *RuleFactoryClient) Client_() thrift.TClient {
-	return p.c
+func (p *SiteRuleRevision) GetAuthor() (v string) {
+	return p.Author
 }
 
-func (p *RuleFactoryClient) SiteRuleList(ctx context.Context, req *SiteRuleListReq) (r *SiteRuleListResp, err error) {
-	var _args RuleFactorySiteRuleListArgs
-	_args.Req = req
-	var _result RuleFactorySiteRuleListResult
-	if err = p.Client_().Call(ctx, "SiteRuleList", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *SiteRuleRevision) GetComment() (v string) {
+	return p.Comment
 }
-func (p *RuleFactoryClient) SiteRuleDetail(ctx context.Context, req *SiteRuleDetailReq) (r *SiteRuleDetailResp, err error) {
-	var _args RuleFactorySiteRuleDetailArgs
-	_args.Req = req
-	var _result RuleFactorySiteRuleDetailResult
-	if err = p.Client_().Call(ctx, "SiteRuleDetail", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *SiteRuleRevision) GetCreateTime() (v string) {
+	return p.CreateTime
 }
-func (p *RuleFactoryClient) CreateSiteRule(ctx context.Context, req *CreateSiteRuleReq) (r *CreateSiteRuleResp, err error) {
-	var _args RuleFactoryCreateSiteRuleArgs
-	_args.Req = req
-	var _result RuleFactoryCreateSiteRuleResult
-	if err = p.Client_().Call(ctx, "CreateSiteRule", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+var fieldIDToName_SiteRuleRevision = map[int16]string{
+	1: "host",
+	2: "revision",
+	3: "action",
+	4: "rule",
+	5: "author",
+	6: "comment",
+	7: "create_time",
 }
-func (p *RuleFactoryClient) UpdateSiteRule(ctx context.Context, req *SiteRuleData) (r *UpdateSiteRuleResp, err error) {
-	var _args RuleFactoryUpdateSiteRuleArgs
-	_args.Req = req
-	var _result RuleFactoryUpdateSiteRuleResult
-	if err = p.Client_().Call(ctx, "UpdateSiteRule", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *SiteRuleRevision) IsSetRule() bool {
+	return p.Rule != nil
 }
-func (p *RuleFactoryClient) CreateSiteRuleFromProd(ctx context.Context, req *ExportProdRuleToTestReq) (r *ExportProdRuleToTestResp, err error) {
-	var _args RuleFactoryCreateSiteRuleFromProdArgs
-	_args.Req = req
-	var _result RuleFactoryCreateSiteRuleFromProdResult
-	if err = p.Client_().Call(ctx, "CreateSiteRuleFromProd", &_args, &_result); err != nil {
-		return
+
+func (p *SiteRuleRevision) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *RuleFactoryClient) PublishSiteRule(ctx context.Context, req *PublishSiteRuleReq) (r *PublishSiteRuleResp, err error) {
-	var _args RuleFactoryPublishSiteRuleArgs
-	_args.Req = req
-	var _result RuleFactoryPublishSiteRuleResult
-	if err = p.Client_().Call(ctx, "PublishSiteRule", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *RuleFactoryClient) DeleteSiteRule(ctx context.Context, req *DeleteSiteRuleReq) (r *DeleteSiteRuleResp, err error) {
-	var _args RuleFactoryDeleteSiteRuleArgs
-	_args.Req = req
-	var _result RuleFactoryDeleteSiteRuleResult
-	if err = p.Client_().Call(ctx, "DeleteSiteRule", &_args, &_result); err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleRevision[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *RuleFactoryClient) ExportSiteRules(ctx context.Context, req *EmptyReq) (r *ExportSiteRulesResp, err error) {
-	var _args RuleFactoryExportSiteRulesArgs
-	_args.Req = req
-	var _result RuleFactoryExportSiteRulesResult
-	if err = p.Client_().Call(ctx, "ExportSiteRules", &_args, &_result); err != nil {
-		return
+
+func (p *SiteRuleRevision) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.Host = _field
+	return nil
 }
-func (p *RuleFactoryClient) ImportSiteRules(ctx context.Context, req *EmptyReq) (r *ImportSiteRulesResp, err error) {
-	var _args RuleFactoryImportSiteRulesArgs
-	_args.Req = req
-	var _result RuleFactoryImportSiteRulesResult
-	if err = p.Client_().Call(ctx, "ImportSiteRules", &_args, &_result); err != nil {
-		return
+func (p *SiteRuleRevision) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.Revision = _field
+	return nil
 }
+func (p *SiteRuleRevision) ReadField3(iprot thrift.TProtocol) error {
 
-type RuleFactoryProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      RuleFactory
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *SiteRuleRevision) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Rule = _field
+	return nil
 }
+func (p *SiteRuleRevision) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *RuleFactoryProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Author = _field
+	return nil
 }
+func (p *SiteRuleRevision) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *RuleFactoryProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Comment = _field
+	return nil
 }
+func (p *SiteRuleRevision) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *RuleFactoryProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreateTime = _field
+	return nil
 }
 
-func NewRuleFactoryProcessor(handler RuleFactory) *RuleFactoryProcessor {
-	self := &RuleFactoryProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("SiteRuleList", &ruleFactoryProcessorSiteRuleList{handler: handler})
-	self.AddToProcessorMap("SiteRuleDetail", &ruleFactoryProcessorSiteRuleDetail{handler: handler})
-	self.AddToProcessorMap("CreateSiteRule", &ruleFactoryProcessorCreateSiteRule{handler: handler})
-	self.AddToProcessorMap("UpdateSiteRule", &ruleFactoryProcessorUpdateSiteRule{handler: handler})
-	self.AddToProcessorMap("CreateSiteRuleFromProd", &ruleFactoryProcessorCreateSiteRuleFromProd{handler: handler})
-	self.AddToProcessorMap("PublishSiteRule", &ruleFactoryProcessorPublishSiteRule{handler: handler})
-	self.AddToProcessorMap("DeleteSiteRule", &ruleFactoryProcessorDeleteSiteRule{handler: handler})
-	self.AddToProcessorMap("ExportSiteRules", &ruleFactoryProcessorExportSiteRules{handler: handler})
-	self.AddToProcessorMap("ImportSiteRules", &ruleFactoryProcessorImportSiteRules{handler: handler})
-	return self
-}
-func (p *RuleFactoryProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+func (p *SiteRuleRevision) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleRevision"); err != nil {
+		goto WriteStructBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type ruleFactoryProcessorSiteRuleList struct {
-	handler RuleFactory
+func (p *SiteRuleRevision) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Host); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ruleFactoryProcessorSiteRuleList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactorySiteRuleListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SiteRuleList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *SiteRuleRevision) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("revision", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Revision); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactorySiteRuleListResult{}
-	var retval *SiteRuleListResp
-	if retval, err2 = p.handler.SiteRuleList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SiteRuleList: "+err2.Error())
-		oprot.WriteMessageBegin("SiteRuleList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *SiteRuleRevision) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("SiteRuleList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleRevision) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule", thrift.STRUCT, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.Rule.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-type ruleFactoryProcessorSiteRuleDetail struct {
-	handler RuleFactory
+func (p *SiteRuleRevision) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Author); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *ruleFactoryProcessorSiteRuleDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactorySiteRuleDetailArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SiteRuleDetail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *SiteRuleRevision) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactorySiteRuleDetailResult{}
-	var retval *SiteRuleDetailResp
-	if retval, err2 = p.handler.SiteRuleDetail(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SiteRuleDetail: "+err2.Error())
-		oprot.WriteMessageBegin("SiteRuleDetail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := oprot.WriteString(p.Comment); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("SiteRuleDetail", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SiteRuleRevision) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("create_time", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteString(p.CreateTime); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SiteRuleRevision) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("SiteRuleRevision(%+v)", *p)
+
 }
 
-type ruleFactoryProcessorCreateSiteRule struct {
-	handler RuleFactory
+// 查看规则的版本列表
+type SiteRuleRevisionListReq struct {
+	Host string `thrift:"host,1" form:"host" json:"host" query:"host"`
+	// 为空时返回全部
+	Limit *int32 `thrift:"limit,2,optional" form:"limit" json:"limit,omitempty" query:"limit"`
 }
 
-func (p *ruleFactoryProcessorCreateSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactoryCreateSiteRuleArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateSiteRule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func NewSiteRuleRevisionListReq() *SiteRuleRevisionListReq {
+	return &SiteRuleRevisionListReq{}
+}
+
+func (p *SiteRuleRevisionListReq) GetHost() (v string) {
+	return p.Host
+}
+
+var SiteRuleRevisionListReq_Limit_DEFAULT int32
+
+func (p *SiteRuleRevisionListReq) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return SiteRuleRevisionListReq_Limit_DEFAULT
 	}
+	return *p.Limit
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactoryCreateSiteRuleResult{}
-	var retval *CreateSiteRuleResp
-	if retval, err2 = p.handler.CreateSiteRule(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateSiteRule: "+err2.Error())
-		oprot.WriteMessageBegin("CreateSiteRule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+var fieldIDToName_SiteRuleRevisionListReq = map[int16]string{
+	1: "host",
+	2: "limit",
+}
+
+func (p *SiteRuleRevisionListReq) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *SiteRuleRevisionListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleRevisionListReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("CreateSiteRule", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.Host = _field
+	return nil
+}
+func (p *SiteRuleRevisionListReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Limit = _field
+	return nil
+}
+
+func (p *SiteRuleRevisionListReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleRevisionListReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Host); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleRevisionListReq(%+v)", *p)
+
+}
+
+type SiteRuleRevisionListResp struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	// 按版本号从新到旧
+	Data []*SiteRuleRevision `thrift:"data,3" form:"data" json:"data" query:"data"`
+}
+
+func NewSiteRuleRevisionListResp() *SiteRuleRevisionListResp {
+	return &SiteRuleRevisionListResp{}
+}
+
+func (p *SiteRuleRevisionListResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *SiteRuleRevisionListResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *SiteRuleRevisionListResp) GetData() (v []*SiteRuleRevision) {
+	return p.Data
+}
+
+var fieldIDToName_SiteRuleRevisionListResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "data",
+}
+
+func (p *SiteRuleRevisionListResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleRevisionListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *SiteRuleRevisionListResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *SiteRuleRevisionListResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleRevision, 0, size)
+	values := make([]SiteRuleRevision, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+
+func (p *SiteRuleRevisionListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleRevisionListResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
+		return err
+	}
+	for _, v := range p.Data {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleRevisionListResp(%+v)", *p)
+
+}
+
+// 比较两个版本
+type SiteRuleRevisionDiffReq struct {
+	Host         string `thrift:"host,1" form:"host" json:"host" query:"host"`
+	FromRevision int64  `thrift:"from_revision,2" form:"from_revision" json:"from_revision" query:"from_revision"`
+	ToRevision   int64  `thrift:"to_revision,3" form:"to_revision" json:"to_revision" query:"to_revision"`
+}
+
+func NewSiteRuleRevisionDiffReq() *SiteRuleRevisionDiffReq {
+	return &SiteRuleRevisionDiffReq{}
+}
+
+func (p *SiteRuleRevisionDiffReq) GetHost() (v string) {
+	return p.Host
+}
+
+func (p *SiteRuleRevisionDiffReq) GetFromRevision() (v int64) {
+	return p.FromRevision
+}
+
+func (p *SiteRuleRevisionDiffReq) GetToRevision() (v int64) {
+	return p.ToRevision
+}
+
+var fieldIDToName_SiteRuleRevisionDiffReq = map[int16]string{
+	1: "host",
+	2: "from_revision",
+	3: "to_revision",
+}
+
+func (p *SiteRuleRevisionDiffReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleRevisionDiffReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Host = _field
+	return nil
+}
+func (p *SiteRuleRevisionDiffReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FromRevision = _field
+	return nil
+}
+func (p *SiteRuleRevisionDiffReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ToRevision = _field
+	return nil
+}
+
+func (p *SiteRuleRevisionDiffReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleRevisionDiffReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Host); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("from_revision", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.FromRevision); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("to_revision", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ToRevision); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleRevisionDiffReq(%+v)", *p)
+
+}
+
+type SiteRuleFieldDiff struct {
+	Field string `thrift:"field,1" form:"field" json:"field" query:"field"`
+	// 字段值的json
+	FromValue string `thrift:"from_value,2" form:"from_value" json:"from_value" query:"from_value"`
+	ToValue   string `thrift:"to_value,3" form:"to_value" json:"to_value" query:"to_value"`
+}
+
+func NewSiteRuleFieldDiff() *SiteRuleFieldDiff {
+	return &SiteRuleFieldDiff{}
+}
+
+func (p *SiteRuleFieldDiff) GetField() (v string) {
+	return p.Field
+}
+
+func (p *SiteRuleFieldDiff) GetFromValue() (v string) {
+	return p.FromValue
+}
+
+func (p *SiteRuleFieldDiff) GetToValue() (v string) {
+	return p.ToValue
+}
+
+var fieldIDToName_SiteRuleFieldDiff = map[int16]string{
+	1: "field",
+	2: "from_value",
+	3: "to_value",
+}
+
+func (p *SiteRuleFieldDiff) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleFieldDiff[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleFieldDiff) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Field = _field
+	return nil
+}
+func (p *SiteRuleFieldDiff) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FromValue = _field
+	return nil
+}
+func (p *SiteRuleFieldDiff) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ToValue = _field
+	return nil
+}
+
+func (p *SiteRuleFieldDiff) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleFieldDiff"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleFieldDiff) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Field); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleFieldDiff) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("from_value", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FromValue); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleFieldDiff) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("to_value", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ToValue); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleFieldDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleFieldDiff(%+v)", *p)
+
+}
+
+type SiteRuleRevisionDiffResp struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	// 只包含有变化的字段
+	Data []*SiteRuleFieldDiff `thrift:"data,3" form:"data" json:"data" query:"data"`
+}
+
+func NewSiteRuleRevisionDiffResp() *SiteRuleRevisionDiffResp {
+	return &SiteRuleRevisionDiffResp{}
+}
+
+func (p *SiteRuleRevisionDiffResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *SiteRuleRevisionDiffResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *SiteRuleRevisionDiffResp) GetData() (v []*SiteRuleFieldDiff) {
+	return p.Data
+}
+
+var fieldIDToName_SiteRuleRevisionDiffResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "data",
+}
+
+func (p *SiteRuleRevisionDiffResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleRevisionDiffResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *SiteRuleRevisionDiffResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *SiteRuleRevisionDiffResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleFieldDiff, 0, size)
+	values := make([]SiteRuleFieldDiff, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+
+func (p *SiteRuleRevisionDiffResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleRevisionDiffResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
+		return err
+	}
+	for _, v := range p.Data {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleRevisionDiffResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleRevisionDiffResp(%+v)", *p)
+
+}
+
+// 把正式规则回滚到指定版本
+type RollbackSiteRuleReq struct {
+	Host     string  `thrift:"host,1" form:"host" json:"host" query:"host"`
+	Revision int64   `thrift:"revision,2" form:"revision" json:"revision" query:"revision"`
+	Author   *string `thrift:"author,3,optional" form:"author" json:"author,omitempty" query:"author"`
+	Comment  *string `thrift:"comment,4,optional" form:"comment" json:"comment,omitempty" query:"comment"`
+}
+
+func NewRollbackSiteRuleReq() *RollbackSiteRuleReq {
+	return &RollbackSiteRuleReq{}
+}
+
+func (p *RollbackSiteRuleReq) GetHost() (v string) {
+	return p.Host
+}
+
+func (p *RollbackSiteRuleReq) GetRevision() (v int64) {
+	return p.Revision
+}
+
+var RollbackSiteRuleReq_Author_DEFAULT string
+
+func (p *RollbackSiteRuleReq) GetAuthor() (v string) {
+	if !p.IsSetAuthor() {
+		return RollbackSiteRuleReq_Author_DEFAULT
+	}
+	return *p.Author
+}
+
+var RollbackSiteRuleReq_Comment_DEFAULT string
+
+func (p *RollbackSiteRuleReq) GetComment() (v string) {
+	if !p.IsSetComment() {
+		return RollbackSiteRuleReq_Comment_DEFAULT
+	}
+	return *p.Comment
+}
+
+var fieldIDToName_RollbackSiteRuleReq = map[int16]string{
+	1: "host",
+	2: "revision",
+	3: "author",
+	4: "comment",
+}
+
+func (p *RollbackSiteRuleReq) IsSetAuthor() bool {
+	return p.Author != nil
+}
+
+func (p *RollbackSiteRuleReq) IsSetComment() bool {
+	return p.Comment != nil
+}
+
+func (p *RollbackSiteRuleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RollbackSiteRuleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Host = _field
+	return nil
+}
+func (p *RollbackSiteRuleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Revision = _field
+	return nil
+}
+func (p *RollbackSiteRuleReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Author = _field
+	return nil
+}
+func (p *RollbackSiteRuleReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Comment = _field
+	return nil
+}
+
+func (p *RollbackSiteRuleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RollbackSiteRuleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Host); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("revision", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Revision); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthor() {
+		if err = oprot.WriteFieldBegin("author", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Author); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetComment() {
+		if err = oprot.WriteFieldBegin("comment", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Comment); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RollbackSiteRuleReq(%+v)", *p)
+
+}
+
+type RollbackSiteRuleResp struct {
+	Code int32         `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string        `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Data *SiteRuleData `thrift:"data,3" form:"data" json:"data" query:"data"`
+}
+
+func NewRollbackSiteRuleResp() *RollbackSiteRuleResp {
+	return &RollbackSiteRuleResp{}
+}
+
+func (p *RollbackSiteRuleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *RollbackSiteRuleResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+var RollbackSiteRuleResp_Data_DEFAULT *SiteRuleData
+
+func (p *RollbackSiteRuleResp) GetData() (v *SiteRuleData) {
+	if !p.IsSetData() {
+		return RollbackSiteRuleResp_Data_DEFAULT
+	}
+	return p.Data
+}
+
+var fieldIDToName_RollbackSiteRuleResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "data",
+}
+
+func (p *RollbackSiteRuleResp) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *RollbackSiteRuleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RollbackSiteRuleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *RollbackSiteRuleResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *RollbackSiteRuleResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+
+func (p *RollbackSiteRuleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RollbackSiteRuleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Data.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RollbackSiteRuleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RollbackSiteRuleResp(%+v)", *p)
+
+}
+
+// 用测试规则和正式规则分别解析样例网页，比较结果
+type SiteRuleDryRunPage struct {
+	URL string `thrift:"url,1" form:"url" json:"url" query:"url"`
+	// 为空时依次使用缓存的网页、重新抓取
+	HTML *string `thrift:"html,2,optional" form:"html" json:"html,omitempty" query:"html"`
+}
+
+func NewSiteRuleDryRunPage() *SiteRuleDryRunPage {
+	return &SiteRuleDryRunPage{}
+}
+
+func (p *SiteRuleDryRunPage) GetURL() (v string) {
+	return p.URL
+}
+
+var SiteRuleDryRunPage_HTML_DEFAULT string
+
+func (p *SiteRuleDryRunPage) GetHTML() (v string) {
+	if !p.IsSetHTML() {
+		return SiteRuleDryRunPage_HTML_DEFAULT
+	}
+	return *p.HTML
+}
+
+var fieldIDToName_SiteRuleDryRunPage = map[int16]string{
+	1: "url",
+	2: "html",
+}
+
+func (p *SiteRuleDryRunPage) IsSetHTML() bool {
+	return p.HTML != nil
+}
+
+func (p *SiteRuleDryRunPage) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleDryRunPage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunPage) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *SiteRuleDryRunPage) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.HTML = _field
+	return nil
+}
+
+func (p *SiteRuleDryRunPage) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleDryRunPage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunPage) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunPage) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHTML() {
+		if err = oprot.WriteFieldBegin("html", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.HTML); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunPage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleDryRunPage(%+v)", *p)
+
+}
+
+type SiteRuleDryRunReq struct {
+	Host  string                `thrift:"host,1" form:"host" json:"host" query:"host"`
+	Pages []*SiteRuleDryRunPage `thrift:"pages,2" form:"pages" json:"pages" query:"pages"`
+	// pages为空时，使用该host最近缓存的网页，默认5个
+	CachedLimit *int32 `thrift:"cached_limit,3,optional" form:"cached_limit" json:"cached_limit,omitempty" query:"cached_limit"`
+	// 指定标注器，为空时依次使用站点规则、全局配置中的标注器
+	Labeler *string `thrift:"labeler,4,optional" form:"labeler" json:"labeler,omitempty" query:"labeler"`
+}
+
+func NewSiteRuleDryRunReq() *SiteRuleDryRunReq {
+	return &SiteRuleDryRunReq{}
+}
+
+func (p *SiteRuleDryRunReq) GetHost() (v string) {
+	return p.Host
+}
+
+func (p *SiteRuleDryRunReq) GetPages() (v []*SiteRuleDryRunPage) {
+	return p.Pages
+}
+
+var SiteRuleDryRunReq_CachedLimit_DEFAULT int32
+
+func (p *SiteRuleDryRunReq) GetCachedLimit() (v int32) {
+	if !p.IsSetCachedLimit() {
+		return SiteRuleDryRunReq_CachedLimit_DEFAULT
+	}
+	return *p.CachedLimit
+}
+
+var SiteRuleDryRunReq_Labeler_DEFAULT string
+
+func (p *SiteRuleDryRunReq) GetLabeler() (v string) {
+	if !p.IsSetLabeler() {
+		return SiteRuleDryRunReq_Labeler_DEFAULT
+	}
+	return *p.Labeler
+}
+
+var fieldIDToName_SiteRuleDryRunReq = map[int16]string{
+	1: "host",
+	2: "pages",
+	3: "cached_limit",
+	4: "labeler",
+}
+
+func (p *SiteRuleDryRunReq) IsSetCachedLimit() bool {
+	return p.CachedLimit != nil
+}
+
+func (p *SiteRuleDryRunReq) IsSetLabeler() bool {
+	return p.Labeler != nil
+}
+
+func (p *SiteRuleDryRunReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleDryRunReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Host = _field
+	return nil
+}
+func (p *SiteRuleDryRunReq) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleDryRunPage, 0, size)
+	values := make([]SiteRuleDryRunPage, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Pages = _field
+	return nil
+}
+func (p *SiteRuleDryRunReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CachedLimit = _field
+	return nil
+}
+func (p *SiteRuleDryRunReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Labeler = _field
+	return nil
+}
+
+func (p *SiteRuleDryRunReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleDryRunReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Host); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pages", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Pages)); err != nil {
+		return err
+	}
+	for _, v := range p.Pages {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCachedLimit() {
+		if err = oprot.WriteFieldBegin("cached_limit", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.CachedLimit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabeler() {
+		if err = oprot.WriteFieldBegin("labeler", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Labeler); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleDryRunReq(%+v)", *p)
+
+}
+
+type SiteRuleDryRunResult struct {
+	Title   string `thrift:"title,1" form:"title" json:"title" query:"title"`
+	Author  string `thrift:"author,2" form:"author" json:"author" query:"author"`
+	PubTime string `thrift:"pub_time,3" form:"pub_time" json:"pub_time" query:"pub_time"`
+	// 字符数
+	TextLength int32 `thrift:"text_length,4" form:"text_length" json:"text_length" query:"text_length"`
+	ImageCount int32 `thrift:"image_count,5" form:"image_count" json:"image_count" query:"image_count"`
+	Worthless  bool  `thrift:"worthless,6" form:"worthless" json:"worthless" query:"worthless"`
+	WorthType  int32 `thrift:"worth_type,7" form:"worth_type" json:"worth_type" query:"worth_type"`
+	// 命中的规则，未命中时为空
+	RuleHost string `thrift:"rule_host,8" form:"rule_host" json:"rule_host" query:"rule_host"`
+	// 解析失败时的错误
+	Error string `thrift:"error,9" form:"error" json:"error" query:"error"`
+}
+
+func NewSiteRuleDryRunResult() *SiteRuleDryRunResult {
+	return &SiteRuleDryRunResult{}
+}
+
+func (p *SiteRuleDryRunResult) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *SiteRuleDryRunResult) GetAuthor() (v string) {
+	return p.Author
+}
+
+func (p *SiteRuleDryRunResult) GetPubTime() (v string) {
+	return p.PubTime
+}
+
+func (p *SiteRuleDryRunResult) GetTextLength() (v int32) {
+	return p.TextLength
+}
+
+func (p *SiteRuleDryRunResult) GetImageCount() (v int32) {
+	return p.ImageCount
+}
+
+func (p *SiteRuleDryRunResult) GetWorthless() (v bool) {
+	return p.Worthless
+}
+
+func (p *SiteRuleDryRunResult) GetWorthType() (v int32) {
+	return p.WorthType
+}
+
+func (p *SiteRuleDryRunResult) GetRuleHost() (v string) {
+	return p.RuleHost
+}
+
+func (p *SiteRuleDryRunResult) GetError() (v string) {
+	return p.Error
+}
+
+var fieldIDToName_SiteRuleDryRunResult = map[int16]string{
+	1: "title",
+	2: "author",
+	3: "pub_time",
+	4: "text_length",
+	5: "image_count",
+	6: "worthless",
+	7: "worth_type",
+	8: "rule_host",
+	9: "error",
+}
+
+func (p *SiteRuleDryRunResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleDryRunResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Title = _field
+	return nil
+}
+func (p *SiteRuleDryRunResult) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Author = _field
+	return nil
+}
+func (p *SiteRuleDryRunResult) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PubTime = _field
+	return nil
+}
+func (p *SiteRuleDryRunResult) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TextLength = _field
+	return nil
+}
+func (p *SiteRuleDryRunResult) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ImageCount = _field
+	return nil
+}
+func (p *SiteRuleDryRunResult) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Worthless = _field
+	return nil
+}
+func (p *SiteRuleDryRunResult) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorthType = _field
+	return nil
+}
+func (p *SiteRuleDryRunResult) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RuleHost = _field
+	return nil
+}
+func (p *SiteRuleDryRunResult) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Error = _field
+	return nil
+}
+
+func (p *SiteRuleDryRunResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleDryRunResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Author); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pub_time", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PubTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text_length", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TextLength); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("image_count", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ImageCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("worthless", thrift.BOOL, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Worthless); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("worth_type", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.WorthType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule_host", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RuleHost); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("error", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Error); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleDryRunResult(%+v)", *p)
+
+}
+
+type SiteRuleDryRunItem struct {
+	URL string `thrift:"url,1" form:"url" json:"url" query:"url"`
+	// request / cache / 抓取后端名
+	HTMLSource string `thrift:"html_source,2" form:"html_source" json:"html_source" query:"html_source"`
+	// 只使用正式规则
+	Prod *SiteRuleDryRunResult `thrift:"prod,3" form:"prod" json:"prod" query:"prod"`
+	// 优先使用测试规则
+	Testing *SiteRuleDryRunResult `thrift:"testing,4" form:"testing" json:"testing" query:"testing"`
+	// 有变化的字段
+	ChangedFields []string `thrift:"changed_fields,5" form:"changed_fields" json:"changed_fields" query:"changed_fields"`
+	// 正文按行比较，"-"开头为只在正式结果中的行，"+"开头为只在测试结果中的行
+	TextDiff []string `thrift:"text_diff,6" form:"text_diff" json:"text_diff" query:"text_diff"`
+	// 获取网页失败时的错误
+	Error string `thrift:"error,7" form:"error" json:"error" query:"error"`
+}
+
+func NewSiteRuleDryRunItem() *SiteRuleDryRunItem {
+	return &SiteRuleDryRunItem{}
+}
+
+func (p *SiteRuleDryRunItem) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *SiteRuleDryRunItem) GetHTMLSource() (v string) {
+	return p.HTMLSource
+}
+
+var SiteRuleDryRunItem_Prod_DEFAULT *SiteRuleDryRunResult
+
+func (p *SiteRuleDryRunItem) GetProd() (v *SiteRuleDryRunResult) {
+	if !p.IsSetProd() {
+		return SiteRuleDryRunItem_Prod_DEFAULT
+	}
+	return p.Prod
+}
+
+var SiteRuleDryRunItem_Testing_DEFAULT *SiteRuleDryRunResult
+
+func (p *SiteRuleDryRunItem) GetTesting() (v *SiteRuleDryRunResult) {
+	if !p.IsSetTesting() {
+		return SiteRuleDryRunItem_Testing_DEFAULT
+	}
+	return p.Testing
+}
+
+func (p *SiteRuleDryRunItem) GetChangedFields() (v []string) {
+	return p.ChangedFields
+}
+
+func (p *SiteRuleDryRunItem) GetTextDiff() (v []string) {
+	return p.TextDiff
+}
+
+func (p *SiteRuleDryRunItem) GetError() (v string) {
+	return p.Error
+}
+
+var fieldIDToName_SiteRuleDryRunItem = map[int16]string{
+	1: "url",
+	2: "html_source",
+	3: "prod",
+	4: "testing",
+	5: "changed_fields",
+	6: "text_diff",
+	7: "error",
+}
+
+func (p *SiteRuleDryRunItem) IsSetProd() bool {
+	return p.Prod != nil
+}
+
+func (p *SiteRuleDryRunItem) IsSetTesting() bool {
+	return p.Testing != nil
+}
+
+func (p *SiteRuleDryRunItem) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleDryRunItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *SiteRuleDryRunItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HTMLSource = _field
+	return nil
+}
+func (p *SiteRuleDryRunItem) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleDryRunResult()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Prod = _field
+	return nil
+}
+func (p *SiteRuleDryRunItem) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleDryRunResult()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Testing = _field
+	return nil
+}
+func (p *SiteRuleDryRunItem) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ChangedFields = _field
+	return nil
+}
+func (p *SiteRuleDryRunItem) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TextDiff = _field
+	return nil
+}
+func (p *SiteRuleDryRunItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Error = _field
+	return nil
+}
+
+func (p *SiteRuleDryRunItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleDryRunItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("html_source", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.HTMLSource); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("prod", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Prod.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("testing", thrift.STRUCT, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Testing.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("changed_fields", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.ChangedFields)); err != nil {
+		return err
+	}
+	for _, v := range p.ChangedFields {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text_diff", thrift.LIST, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.TextDiff)); err != nil {
+		return err
+	}
+	for _, v := range p.TextDiff {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("error", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Error); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleDryRunItem(%+v)", *p)
+
+}
+
+type SiteRuleDryRunResp struct {
+	Code int32                 `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string                `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Data []*SiteRuleDryRunItem `thrift:"data,3" form:"data" json:"data" query:"data"`
+}
+
+func NewSiteRuleDryRunResp() *SiteRuleDryRunResp {
+	return &SiteRuleDryRunResp{}
+}
+
+func (p *SiteRuleDryRunResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *SiteRuleDryRunResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *SiteRuleDryRunResp) GetData() (v []*SiteRuleDryRunItem) {
+	return p.Data
+}
+
+var fieldIDToName_SiteRuleDryRunResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "data",
+}
+
+func (p *SiteRuleDryRunResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleDryRunResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *SiteRuleDryRunResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *SiteRuleDryRunResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleDryRunItem, 0, size)
+	values := make([]SiteRuleDryRunItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+
+func (p *SiteRuleDryRunResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleDryRunResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
+		return err
+	}
+	for _, v := range p.Data {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleDryRunResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleDryRunResp(%+v)", *p)
+
+}
+
+// 规则字段的校验错误
+type SiteRuleFieldError struct {
+	Host string `thrift:"host,1" form:"host" json:"host" query:"host"`
+	// 字段的json名，如bodies、title
+	Field string `thrift:"field,2" form:"field" json:"field" query:"field"`
+	// 列表字段中的序号，非列表字段为0
+	Index   int32  `thrift:"index,3" form:"index" json:"index" query:"index"`
+	Value   string `thrift:"value,4" form:"value" json:"value" query:"value"`
+	Message string `thrift:"message,5" form:"message" json:"message" query:"message"`
+}
+
+func NewSiteRuleFieldError() *SiteRuleFieldError {
+	return &SiteRuleFieldError{}
+}
+
+func (p *SiteRuleFieldError) GetHost() (v string) {
+	return p.Host
+}
+
+func (p *SiteRuleFieldError) GetField() (v string) {
+	return p.Field
+}
+
+func (p *SiteRuleFieldError) GetIndex() (v int32) {
+	return p.Index
+}
+
+func (p *SiteRuleFieldError) GetValue() (v string) {
+	return p.Value
+}
+
+func (p *SiteRuleFieldError) GetMessage() (v string) {
+	return p.Message
+}
+
+var fieldIDToName_SiteRuleFieldError = map[int16]string{
+	1: "host",
+	2: "field",
+	3: "index",
+	4: "value",
+	5: "message",
+}
+
+func (p *SiteRuleFieldError) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleFieldError[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleFieldError) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Host = _field
+	return nil
+}
+func (p *SiteRuleFieldError) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Field = _field
+	return nil
+}
+func (p *SiteRuleFieldError) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Index = _field
+	return nil
+}
+func (p *SiteRuleFieldError) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Value = _field
+	return nil
+}
+func (p *SiteRuleFieldError) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *SiteRuleFieldError) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleFieldError"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleFieldError) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Host); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleFieldError) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Field); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleFieldError) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("index", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Index); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleFieldError) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("value", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Value); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SiteRuleFieldError) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SiteRuleFieldError) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleFieldError(%+v)", *p)
+
+}
+
+// xpath在样例网页中命中的节点数
+type SiteRuleXpathMatch struct {
+	Field string `thrift:"field,1" form:"field" json:"field" query:"field"`
+	Index int32  `thrift:"index,2" form:"index" json:"index" query:"index"`
+	Xpath string `thrift:"xpath,3" form:"xpath" json:"xpath" query:"xpath"`
+	Count int32  `thrift:"count,4" form:"count" json:"count" query:"count"`
+}
+
+func NewSiteRuleXpathMatch() *SiteRuleXpathMatch {
+	return &SiteRuleXpathMatch{}
+}
+
+func (p *SiteRuleXpathMatch) GetField() (v string) {
+	return p.Field
+}
+
+func (p *SiteRuleXpathMatch) GetIndex() (v int32) {
+	return p.Index
+}
+
+func (p *SiteRuleXpathMatch) GetXpath() (v string) {
+	return p.Xpath
+}
+
+func (p *SiteRuleXpathMatch) GetCount() (v int32) {
+	return p.Count
+}
+
+var fieldIDToName_SiteRuleXpathMatch = map[int16]string{
+	1: "field",
+	2: "index",
+	3: "xpath",
+	4: "count",
+}
+
+func (p *SiteRuleXpathMatch) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleXpathMatch[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleXpathMatch) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Field = _field
+	return nil
+}
+func (p *SiteRuleXpathMatch) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Index = _field
+	return nil
+}
+func (p *SiteRuleXpathMatch) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Xpath = _field
+	return nil
+}
+func (p *SiteRuleXpathMatch) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *SiteRuleXpathMatch) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleXpathMatch"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleXpathMatch) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Field); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathMatch) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("index", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Index); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathMatch) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("xpath", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Xpath); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathMatch) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathMatch) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleXpathMatch(%+v)", *p)
+
+}
+
+// 校验规则，不保存
+type ValidateSiteRuleReq struct {
+	Rule *SiteRuleData `thrift:"rule,1" form:"rule" json:"rule" query:"rule"`
+	// 样例网页，用于统计xpath命中的节点数
+	SampleURL *string `thrift:"sample_url,2,optional" form:"sample_url" json:"sample_url,omitempty" query:"sample_url"`
+	// 为空时使用sample_url缓存的网页
+	SampleHTML *string `thrift:"sample_html,3,optional" form:"sample_html" json:"sample_html,omitempty" query:"sample_html"`
+}
+
+func NewValidateSiteRuleReq() *ValidateSiteRuleReq {
+	return &ValidateSiteRuleReq{}
+}
+
+var ValidateSiteRuleReq_Rule_DEFAULT *SiteRuleData
+
+func (p *ValidateSiteRuleReq) GetRule() (v *SiteRuleData) {
+	if !p.IsSetRule() {
+		return ValidateSiteRuleReq_Rule_DEFAULT
+	}
+	return p.Rule
+}
+
+var ValidateSiteRuleReq_SampleURL_DEFAULT string
+
+func (p *ValidateSiteRuleReq) GetSampleURL() (v string) {
+	if !p.IsSetSampleURL() {
+		return ValidateSiteRuleReq_SampleURL_DEFAULT
+	}
+	return *p.SampleURL
+}
+
+var ValidateSiteRuleReq_SampleHTML_DEFAULT string
+
+func (p *ValidateSiteRuleReq) GetSampleHTML() (v string) {
+	if !p.IsSetSampleHTML() {
+		return ValidateSiteRuleReq_SampleHTML_DEFAULT
+	}
+	return *p.SampleHTML
+}
+
+var fieldIDToName_ValidateSiteRuleReq = map[int16]string{
+	1: "rule",
+	2: "sample_url",
+	3: "sample_html",
+}
+
+func (p *ValidateSiteRuleReq) IsSetRule() bool {
+	return p.Rule != nil
+}
+
+func (p *ValidateSiteRuleReq) IsSetSampleURL() bool {
+	return p.SampleURL != nil
+}
+
+func (p *ValidateSiteRuleReq) IsSetSampleHTML() bool {
+	return p.SampleHTML != nil
+}
+
+func (p *ValidateSiteRuleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateSiteRuleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleReq) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Rule = _field
+	return nil
+}
+func (p *ValidateSiteRuleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SampleURL = _field
+	return nil
+}
+func (p *ValidateSiteRuleReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SampleHTML = _field
+	return nil
+}
+
+func (p *ValidateSiteRuleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ValidateSiteRuleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Rule.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleURL() {
+		if err = oprot.WriteFieldBegin("sample_url", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SampleURL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleHTML() {
+		if err = oprot.WriteFieldBegin("sample_html", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SampleHTML); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ValidateSiteRuleReq(%+v)", *p)
+
+}
+
+type ValidateSiteRuleResp struct {
+	Code   int32                 `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg    string                `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Errors []*SiteRuleFieldError `thrift:"errors,3" form:"errors" json:"errors" query:"errors"`
+	// 传入样例网页时返回
+	Matches []*SiteRuleXpathMatch `thrift:"matches,4" form:"matches" json:"matches" query:"matches"`
+}
+
+func NewValidateSiteRuleResp() *ValidateSiteRuleResp {
+	return &ValidateSiteRuleResp{}
+}
+
+func (p *ValidateSiteRuleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ValidateSiteRuleResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *ValidateSiteRuleResp) GetErrors() (v []*SiteRuleFieldError) {
+	return p.Errors
+}
+
+func (p *ValidateSiteRuleResp) GetMatches() (v []*SiteRuleXpathMatch) {
+	return p.Matches
+}
+
+var fieldIDToName_ValidateSiteRuleResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "errors",
+	4: "matches",
+}
+
+func (p *ValidateSiteRuleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateSiteRuleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *ValidateSiteRuleResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *ValidateSiteRuleResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleFieldError, 0, size)
+	values := make([]SiteRuleFieldError, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Errors = _field
+	return nil
+}
+func (p *ValidateSiteRuleResp) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleXpathMatch, 0, size)
+	values := make([]SiteRuleXpathMatch, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Matches = _field
+	return nil
+}
+
+func (p *ValidateSiteRuleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ValidateSiteRuleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("errors", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Errors)); err != nil {
+		return err
+	}
+	for _, v := range p.Errors {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("matches", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Matches)); err != nil {
+		return err
+	}
+	for _, v := range p.Matches {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ValidateSiteRuleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ValidateSiteRuleResp(%+v)", *p)
+
+}
+
+// 查看url会命中哪条规则及原因
+type ExplainSiteRuleMatchReq struct {
+	URL        string                 `thrift:"url,1" form:"url" json:"url" query:"url"`
+	StageGroup wcd.RuleStageGroupEnum `thrift:"stage_group,2" form:"stage_group" json:"stage_group" query:"stage_group"`
+}
+
+func NewExplainSiteRuleMatchReq() *ExplainSiteRuleMatchReq {
+	return &ExplainSiteRuleMatchReq{}
+}
+
+func (p *ExplainSiteRuleMatchReq) GetURL() (v string) {
+	return p.URL
+}
+
+func (p *ExplainSiteRuleMatchReq) GetStageGroup() (v wcd.RuleStageGroupEnum) {
+	return p.StageGroup
+}
+
+var fieldIDToName_ExplainSiteRuleMatchReq = map[int16]string{
+	1: "url",
+	2: "stage_group",
+}
+
+func (p *ExplainSiteRuleMatchReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExplainSiteRuleMatchReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *ExplainSiteRuleMatchReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field wcd.RuleStageGroupEnum
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = wcd.RuleStageGroupEnum(v)
+	}
+	p.StageGroup = _field
+	return nil
+}
+
+func (p *ExplainSiteRuleMatchReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExplainSiteRuleMatchReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stage_group", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.StageGroup)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExplainSiteRuleMatchReq(%+v)", *p)
+
+}
+
+type SiteRuleMatchCandidate struct {
+	Host        string            `thrift:"host,1" form:"host" json:"host" query:"host"`
+	Stage       wcd.RuleStageType `thrift:"stage,2" form:"stage" json:"stage" query:"stage"`
+	MatchType   string            `thrift:"match_type,3" form:"match_type" json:"match_type" query:"match_type"`
+	HostPattern string            `thrift:"host_pattern,4" form:"host_pattern" json:"host_pattern" query:"host_pattern"`
+	PathPattern string            `thrift:"path_pattern,5" form:"path_pattern" json:"path_pattern" query:"path_pattern"`
+	Priority    int32             `thrift:"priority,6" form:"priority" json:"priority" query:"priority"`
+	Matched     bool              `thrift:"matched,7" form:"matched" json:"matched" query:"matched"`
+	// 命中或未命中的原因
+	Reason string `thrift:"reason,8" form:"reason" json:"reason" query:"reason"`
+}
+
+func NewSiteRuleMatchCandidate() *SiteRuleMatchCandidate {
+	return &SiteRuleMatchCandidate{}
+}
+
+func (p *SiteRuleMatchCandidate) GetHost() (v string) {
+	return p.Host
+}
+
+func (p *SiteRuleMatchCandidate) GetStage() (v wcd.RuleStageType) {
+	return p.Stage
+}
+
+func (p *SiteRuleMatchCandidate) GetMatchType() (v string) {
+	return p.MatchType
+}
+
+func (p *SiteRuleMatchCandidate) GetHostPattern() (v string) {
+	return p.HostPattern
+}
+
+func (p *SiteRuleMatchCandidate) GetPathPattern() (v string) {
+	return p.PathPattern
+}
+
+func (p *SiteRuleMatchCandidate) GetPriority() (v int32) {
+	return p.Priority
+}
+
+func (p *SiteRuleMatchCandidate) GetMatched() (v bool) {
+	return p.Matched
+}
+
+func (p *SiteRuleMatchCandidate) GetReason() (v string) {
+	return p.Reason
+}
+
+var fieldIDToName_SiteRuleMatchCandidate = map[int16]string{
+	1: "host",
+	2: "stage",
+	3: "match_type",
+	4: "host_pattern",
+	5: "path_pattern",
+	6: "priority",
+	7: "matched",
+	8: "reason",
+}
+
+func (p *SiteRuleMatchCandidate) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleMatchCandidate[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Host = _field
+	return nil
+}
+func (p *SiteRuleMatchCandidate) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field wcd.RuleStageType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = wcd.RuleStageType(v)
+	}
+	p.Stage = _field
+	return nil
+}
+func (p *SiteRuleMatchCandidate) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MatchType = _field
+	return nil
+}
+func (p *SiteRuleMatchCandidate) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HostPattern = _field
+	return nil
+}
+func (p *SiteRuleMatchCandidate) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PathPattern = _field
+	return nil
+}
+func (p *SiteRuleMatchCandidate) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Priority = _field
+	return nil
+}
+func (p *SiteRuleMatchCandidate) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Matched = _field
+	return nil
+}
+func (p *SiteRuleMatchCandidate) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *SiteRuleMatchCandidate) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleMatchCandidate"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Host); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stage", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.Stage)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_type", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MatchType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("host_pattern", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.HostPattern); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("path_pattern", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PathPattern); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("priority", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Priority); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("matched", thrift.BOOL, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Matched); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *SiteRuleMatchCandidate) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleMatchCandidate(%+v)", *p)
+
+}
+
+type ExplainSiteRuleMatchResp struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	// 生效的规则，未命中时为空
+	Data *SiteRuleData `thrift:"data,3,optional" form:"data" json:"data,omitempty" query:"data"`
+	// 命中的规则按优先顺序在前，其后为host相近但未命中的规则
+	Candidates []*SiteRuleMatchCandidate `thrift:"candidates,4" form:"candidates" json:"candidates" query:"candidates"`
+	// 用于匹配的host，已去掉www前缀
+	MatchHost string `thrift:"match_host,5" form:"match_host" json:"match_host" query:"match_host"`
+	MatchPath string `thrift:"match_path,6" form:"match_path" json:"match_path" query:"match_path"`
+}
+
+func NewExplainSiteRuleMatchResp() *ExplainSiteRuleMatchResp {
+	return &ExplainSiteRuleMatchResp{}
+}
+
+func (p *ExplainSiteRuleMatchResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *ExplainSiteRuleMatchResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+var ExplainSiteRuleMatchResp_Data_DEFAULT *SiteRuleData
+
+func (p *ExplainSiteRuleMatchResp) GetData() (v *SiteRuleData) {
+	if !p.IsSetData() {
+		return ExplainSiteRuleMatchResp_Data_DEFAULT
+	}
+	return p.Data
+}
+
+func (p *ExplainSiteRuleMatchResp) GetCandidates() (v []*SiteRuleMatchCandidate) {
+	return p.Candidates
+}
+
+func (p *ExplainSiteRuleMatchResp) GetMatchHost() (v string) {
+	return p.MatchHost
+}
+
+func (p *ExplainSiteRuleMatchResp) GetMatchPath() (v string) {
+	return p.MatchPath
+}
+
+var fieldIDToName_ExplainSiteRuleMatchResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "data",
+	4: "candidates",
+	5: "match_host",
+	6: "match_path",
+}
+
+func (p *ExplainSiteRuleMatchResp) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *ExplainSiteRuleMatchResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExplainSiteRuleMatchResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *ExplainSiteRuleMatchResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *ExplainSiteRuleMatchResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+func (p *ExplainSiteRuleMatchResp) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleMatchCandidate, 0, size)
+	values := make([]SiteRuleMatchCandidate, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Candidates = _field
+	return nil
+}
+func (p *ExplainSiteRuleMatchResp) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MatchHost = _field
+	return nil
+}
+func (p *ExplainSiteRuleMatchResp) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MatchPath = _field
+	return nil
+}
+
+func (p *ExplainSiteRuleMatchResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExplainSiteRuleMatchResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetData() {
+		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Data.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("candidates", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Candidates)); err != nil {
+		return err
+	}
+	for _, v := range p.Candidates {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_host", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MatchHost); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_path", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MatchPath); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ExplainSiteRuleMatchResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExplainSiteRuleMatchResp(%+v)", *p)
+
+}
+
+// 根据样例网页推荐正文和噪声的xpath
+type SuggestSiteRuleReq struct {
+	URL string `thrift:"url,1" form:"url" json:"url" query:"url"`
+	// 为空时依次使用缓存的网页、重新抓取
+	HTML *string `thrift:"html,2,optional" form:"html" json:"html,omitempty" query:"html"`
+	// 最多推荐的噪声数，默认10
+	MaxNoises *int32 `thrift:"max_noises,3,optional" form:"max_noises" json:"max_noises,omitempty" query:"max_noises"`
+}
+
+func NewSuggestSiteRuleReq() *SuggestSiteRuleReq {
+	return &SuggestSiteRuleReq{}
+}
+
+func (p *SuggestSiteRuleReq) GetURL() (v string) {
+	return p.URL
+}
+
+var SuggestSiteRuleReq_HTML_DEFAULT string
+
+func (p *SuggestSiteRuleReq) GetHTML() (v string) {
+	if !p.IsSetHTML() {
+		return SuggestSiteRuleReq_HTML_DEFAULT
+	}
+	return *p.HTML
+}
+
+var SuggestSiteRuleReq_MaxNoises_DEFAULT int32
+
+func (p *SuggestSiteRuleReq) GetMaxNoises() (v int32) {
+	if !p.IsSetMaxNoises() {
+		return SuggestSiteRuleReq_MaxNoises_DEFAULT
+	}
+	return *p.MaxNoises
+}
+
+var fieldIDToName_SuggestSiteRuleReq = map[int16]string{
+	1: "url",
+	2: "html",
+	3: "max_noises",
+}
+
+func (p *SuggestSiteRuleReq) IsSetHTML() bool {
+	return p.HTML != nil
+}
+
+func (p *SuggestSiteRuleReq) IsSetMaxNoises() bool {
+	return p.MaxNoises != nil
+}
+
+func (p *SuggestSiteRuleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SuggestSiteRuleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *SuggestSiteRuleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.HTML = _field
+	return nil
+}
+func (p *SuggestSiteRuleReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxNoises = _field
+	return nil
+}
+
+func (p *SuggestSiteRuleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SuggestSiteRuleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHTML() {
+		if err = oprot.WriteFieldBegin("html", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.HTML); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxNoises() {
+		if err = oprot.WriteFieldBegin("max_noises", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxNoises); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SuggestSiteRuleReq(%+v)", *p)
+
+}
+
+type SiteRuleXpathSuggestion struct {
+	Xpath string `thrift:"xpath,1" form:"xpath" json:"xpath" query:"xpath"`
+	// 字符数
+	TextLength int32 `thrift:"text_length,2" form:"text_length" json:"text_length" query:"text_length"`
+	// 节点文本占整个网页文本的比例
+	TextShare float64 `thrift:"text_share,3" form:"text_share" json:"text_share" query:"text_share"`
+	// 链接文本占节点文本的比例
+	LinkDensity float64 `thrift:"link_density,4" form:"link_density" json:"link_density" query:"link_density"`
+	// xpath在样例网页中命中的节点数
+	MatchCount int32 `thrift:"match_count,5" form:"match_count" json:"match_count" query:"match_count"`
+	// 只使用id、class定位，不依赖节点位置
+	Stable bool `thrift:"stable,6" form:"stable" json:"stable" query:"stable"`
+	// 推荐的原因
+	Reason string `thrift:"reason,7" form:"reason" json:"reason" query:"reason"`
+	// 节点文本的开头
+	Preview string `thrift:"preview,8" form:"preview" json:"preview" query:"preview"`
+}
+
+func NewSiteRuleXpathSuggestion() *SiteRuleXpathSuggestion {
+	return &SiteRuleXpathSuggestion{}
+}
+
+func (p *SiteRuleXpathSuggestion) GetXpath() (v string) {
+	return p.Xpath
+}
+
+func (p *SiteRuleXpathSuggestion) GetTextLength() (v int32) {
+	return p.TextLength
+}
+
+func (p *SiteRuleXpathSuggestion) GetTextShare() (v float64) {
+	return p.TextShare
+}
+
+func (p *SiteRuleXpathSuggestion) GetLinkDensity() (v float64) {
+	return p.LinkDensity
+}
+
+func (p *SiteRuleXpathSuggestion) GetMatchCount() (v int32) {
+	return p.MatchCount
+}
+
+func (p *SiteRuleXpathSuggestion) GetStable() (v bool) {
+	return p.Stable
+}
+
+func (p *SiteRuleXpathSuggestion) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *SiteRuleXpathSuggestion) GetPreview() (v string) {
+	return p.Preview
+}
+
+var fieldIDToName_SiteRuleXpathSuggestion = map[int16]string{
+	1: "xpath",
+	2: "text_length",
+	3: "text_share",
+	4: "link_density",
+	5: "match_count",
+	6: "stable",
+	7: "reason",
+	8: "preview",
+}
+
+func (p *SiteRuleXpathSuggestion) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SiteRuleXpathSuggestion[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Xpath = _field
+	return nil
+}
+func (p *SiteRuleXpathSuggestion) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TextLength = _field
+	return nil
+}
+func (p *SiteRuleXpathSuggestion) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TextShare = _field
+	return nil
+}
+func (p *SiteRuleXpathSuggestion) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LinkDensity = _field
+	return nil
+}
+func (p *SiteRuleXpathSuggestion) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MatchCount = _field
+	return nil
+}
+func (p *SiteRuleXpathSuggestion) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Stable = _field
+	return nil
+}
+func (p *SiteRuleXpathSuggestion) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+func (p *SiteRuleXpathSuggestion) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Preview = _field
+	return nil
+}
+
+func (p *SiteRuleXpathSuggestion) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleXpathSuggestion"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("xpath", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Xpath); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text_length", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TextLength); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text_share", thrift.DOUBLE, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.TextShare); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("link_density", thrift.DOUBLE, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.LinkDensity); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_count", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MatchCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stable", thrift.BOOL, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Stable); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("preview", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Preview); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *SiteRuleXpathSuggestion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SiteRuleXpathSuggestion(%+v)", *p)
+
+}
+
+type SuggestSiteRuleResp struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	// 第一条为推荐的正文节点，其后为范围更大的备选
+	Bodies []*SiteRuleXpathSuggestion `thrift:"bodies,3" form:"bodies" json:"bodies" query:"bodies"`
+	// 正文节点中的噪声块
+	Noises []*SiteRuleXpathSuggestion `thrift:"noises,4" form:"noises" json:"noises" query:"noises"`
+	// 使用第一条正文和全部噪声后的正文，未经语义去噪
+	Preview string `thrift:"preview,5" form:"preview" json:"preview" query:"preview"`
+	// request / cache / 抓取后端名
+	HTMLSource string `thrift:"html_source,6" form:"html_source" json:"html_source" query:"html_source"`
+}
+
+func NewSuggestSiteRuleResp() *SuggestSiteRuleResp {
+	return &SuggestSiteRuleResp{}
+}
+
+func (p *SuggestSiteRuleResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *SuggestSiteRuleResp) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *SuggestSiteRuleResp) GetBodies() (v []*SiteRuleXpathSuggestion) {
+	return p.Bodies
+}
+
+func (p *SuggestSiteRuleResp) GetNoises() (v []*SiteRuleXpathSuggestion) {
+	return p.Noises
+}
+
+func (p *SuggestSiteRuleResp) GetPreview() (v string) {
+	return p.Preview
+}
+
+func (p *SuggestSiteRuleResp) GetHTMLSource() (v string) {
+	return p.HTMLSource
+}
+
+var fieldIDToName_SuggestSiteRuleResp = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "bodies",
+	4: "noises",
+	5: "preview",
+	6: "html_source",
+}
+
+func (p *SuggestSiteRuleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SuggestSiteRuleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *SuggestSiteRuleResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *SuggestSiteRuleResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleXpathSuggestion, 0, size)
+	values := make([]SiteRuleXpathSuggestion, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Bodies = _field
+	return nil
+}
+func (p *SuggestSiteRuleResp) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SiteRuleXpathSuggestion, 0, size)
+	values := make([]SiteRuleXpathSuggestion, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Noises = _field
+	return nil
+}
+func (p *SuggestSiteRuleResp) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Preview = _field
+	return nil
+}
+func (p *SuggestSiteRuleResp) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HTMLSource = _field
+	return nil
+}
+
+func (p *SuggestSiteRuleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SuggestSiteRuleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("bodies", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Bodies)); err != nil {
+		return err
+	}
+	for _, v := range p.Bodies {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("noises", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Noises)); err != nil {
+		return err
+	}
+	for _, v := range p.Noises {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("preview", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Preview); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("html_source", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.HTMLSource); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SuggestSiteRuleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SuggestSiteRuleResp(%+v)", *p)
+
+}
+
+type RuleFactory interface {
+	// 查看各站点规则列表
+	SiteRuleList(ctx context.Context, req *SiteRuleListReq) (r *SiteRuleListResp, err error)
+	// 查看各站点规则详情
+	SiteRuleDetail(ctx context.Context, req *SiteRuleDetailReq) (r *SiteRuleDetailResp, err error)
+	// 新建规则，默认处于测试状态
+	CreateSiteRule(ctx context.Context, req *CreateSiteRuleReq) (r *CreateSiteRuleResp, err error)
+	// 更新规则，只能更新测试规则
+	UpdateSiteRule(ctx context.Context, req *SiteRuleData) (r *UpdateSiteRuleResp, err error)
+	// 从正式规则导出一个测试规则
+	CreateSiteRuleFromProd(ctx context.Context, req *ExportProdRuleToTestReq) (r *ExportProdRuleToTestResp, err error)
+	// 发布测试状态的规则至正式
+	PublishSiteRule(ctx context.Context, req *PublishSiteRuleReq) (r *PublishSiteRuleResp, err error)
+	// 删除规则
+	DeleteSiteRule(ctx context.Context, req *DeleteSiteRuleReq) (r *DeleteSiteRuleResp, err error)
+	// 导出所有站点规则
+	ExportSiteRules(ctx context.Context, req *EmptyReq) (r *ExportSiteRulesResp, err error)
+	// 导出所有站点规则
+	ImportSiteRules(ctx context.Context, req *EmptyReq) (r *ImportSiteRulesResp, err error)
+	// 查看规则的版本列表
+	SiteRuleRevisionList(ctx context.Context, req *SiteRuleRevisionListReq) (r *SiteRuleRevisionListResp, err error)
+	// 比较规则的两个版本
+	SiteRuleRevisionDiff(ctx context.Context, req *SiteRuleRevisionDiffReq) (r *SiteRuleRevisionDiffResp, err error)
+	// 把正式规则回滚到指定版本
+	RollbackSiteRule(ctx context.Context, req *RollbackSiteRuleReq) (r *RollbackSiteRuleResp, err error)
+	// 校验规则的xpath和host，可以统计xpath在样例网页中命中的节点数
+	ValidateSiteRule(ctx context.Context, req *ValidateSiteRuleReq) (r *ValidateSiteRuleResp, err error)
+	// 用测试规则和正式规则分别解析样例网页，比较结果
+	SiteRuleDryRun(ctx context.Context, req *SiteRuleDryRunReq) (r *SiteRuleDryRunResp, err error)
+	// 查看url会命中哪条规则及原因
+	ExplainSiteRuleMatch(ctx context.Context, req *ExplainSiteRuleMatchReq) (r *ExplainSiteRuleMatchResp, err error)
+	// 根据样例网页推荐正文和噪声的xpath
+	SuggestSiteRule(ctx context.Context, req *SuggestSiteRuleReq) (r *SuggestSiteRuleResp, err error)
+}
+
+type RuleFactoryClient struct {
+	c thrift.TClient
+}
+
+func NewRuleFactoryClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *RuleFactoryClient {
+	return &RuleFactoryClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewRuleFactoryClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *RuleFactoryClient {
+	return &RuleFactoryClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewRuleFactoryClient(c thrift.TClient) *RuleFactoryClient {
+	return &RuleFactoryClient{
+		c: c,
+	}
+}
+
+func (p *RuleFactoryClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *RuleFactoryClient) SiteRuleList(ctx context.Context, req *SiteRuleListReq) (r *SiteRuleListResp, err error) {
+	var _args RuleFactorySiteRuleListArgs
+	_args.Req = req
+	var _result RuleFactorySiteRuleListResult
+	if err = p.Client_().Call(ctx, "SiteRuleList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) SiteRuleDetail(ctx context.Context, req *SiteRuleDetailReq) (r *SiteRuleDetailResp, err error) {
+	var _args RuleFactorySiteRuleDetailArgs
+	_args.Req = req
+	var _result RuleFactorySiteRuleDetailResult
+	if err = p.Client_().Call(ctx, "SiteRuleDetail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) CreateSiteRule(ctx context.Context, req *CreateSiteRuleReq) (r *CreateSiteRuleResp, err error) {
+	var _args RuleFactoryCreateSiteRuleArgs
+	_args.Req = req
+	var _result RuleFactoryCreateSiteRuleResult
+	if err = p.Client_().Call(ctx, "CreateSiteRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) UpdateSiteRule(ctx context.Context, req *SiteRuleData) (r *UpdateSiteRuleResp, err error) {
+	var _args RuleFactoryUpdateSiteRuleArgs
+	_args.Req = req
+	var _result RuleFactoryUpdateSiteRuleResult
+	if err = p.Client_().Call(ctx, "UpdateSiteRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) CreateSiteRuleFromProd(ctx context.Context, req *ExportProdRuleToTestReq) (r *ExportProdRuleToTestResp, err error) {
+	var _args RuleFactoryCreateSiteRuleFromProdArgs
+	_args.Req = req
+	var _result RuleFactoryCreateSiteRuleFromProdResult
+	if err = p.Client_().Call(ctx, "CreateSiteRuleFromProd", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) PublishSiteRule(ctx context.Context, req *PublishSiteRuleReq) (r *PublishSiteRuleResp, err error) {
+	var _args RuleFactoryPublishSiteRuleArgs
+	_args.Req = req
+	var _result RuleFactoryPublishSiteRuleResult
+	if err = p.Client_().Call(ctx, "PublishSiteRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) DeleteSiteRule(ctx context.Context, req *DeleteSiteRuleReq) (r *DeleteSiteRuleResp, err error) {
+	var _args RuleFactoryDeleteSiteRuleArgs
+	_args.Req = req
+	var _result RuleFactoryDeleteSiteRuleResult
+	if err = p.Client_().Call(ctx, "DeleteSiteRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) ExportSiteRules(ctx context.Context, req *EmptyReq) (r *ExportSiteRulesResp, err error) {
+	var _args RuleFactoryExportSiteRulesArgs
+	_args.Req = req
+	var _result RuleFactoryExportSiteRulesResult
+	if err = p.Client_().Call(ctx, "ExportSiteRules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) ImportSiteRules(ctx context.Context, req *EmptyReq) (r *ImportSiteRulesResp, err error) {
+	var _args RuleFactoryImportSiteRulesArgs
+	_args.Req = req
+	var _result RuleFactoryImportSiteRulesResult
+	if err = p.Client_().Call(ctx, "ImportSiteRules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) SiteRuleRevisionList(ctx context.Context, req *SiteRuleRevisionListReq) (r *SiteRuleRevisionListResp, err error) {
+	var _args RuleFactorySiteRuleRevisionListArgs
+	_args.Req = req
+	var _result RuleFactorySiteRuleRevisionListResult
+	if err = p.Client_().Call(ctx, "SiteRuleRevisionList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) SiteRuleRevisionDiff(ctx context.Context, req *SiteRuleRevisionDiffReq) (r *SiteRuleRevisionDiffResp, err error) {
+	var _args RuleFactorySiteRuleRevisionDiffArgs
+	_args.Req = req
+	var _result RuleFactorySiteRuleRevisionDiffResult
+	if err = p.Client_().Call(ctx, "SiteRuleRevisionDiff", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) RollbackSiteRule(ctx context.Context, req *RollbackSiteRuleReq) (r *RollbackSiteRuleResp, err error) {
+	var _args RuleFactoryRollbackSiteRuleArgs
+	_args.Req = req
+	var _result RuleFactoryRollbackSiteRuleResult
+	if err = p.Client_().Call(ctx, "RollbackSiteRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) ValidateSiteRule(ctx context.Context, req *ValidateSiteRuleReq) (r *ValidateSiteRuleResp, err error) {
+	var _args RuleFactoryValidateSiteRuleArgs
+	_args.Req = req
+	var _result RuleFactoryValidateSiteRuleResult
+	if err = p.Client_().Call(ctx, "ValidateSiteRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) SiteRuleDryRun(ctx context.Context, req *SiteRuleDryRunReq) (r *SiteRuleDryRunResp, err error) {
+	var _args RuleFactorySiteRuleDryRunArgs
+	_args.Req = req
+	var _result RuleFactorySiteRuleDryRunResult
+	if err = p.Client_().Call(ctx, "SiteRuleDryRun", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) ExplainSiteRuleMatch(ctx context.Context, req *ExplainSiteRuleMatchReq) (r *ExplainSiteRuleMatchResp, err error) {
+	var _args RuleFactoryExplainSiteRuleMatchArgs
+	_args.Req = req
+	var _result RuleFactoryExplainSiteRuleMatchResult
+	if err = p.Client_().Call(ctx, "ExplainSiteRuleMatch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RuleFactoryClient) SuggestSiteRule(ctx context.Context, req *SuggestSiteRuleReq) (r *SuggestSiteRuleResp, err error) {
+	var _args RuleFactorySuggestSiteRuleArgs
+	_args.Req = req
+	var _result RuleFactorySuggestSiteRuleResult
+	if err = p.Client_().Call(ctx, "SuggestSiteRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type RuleFactoryProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      RuleFactory
+}
+
+func (p *RuleFactoryProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *RuleFactoryProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *RuleFactoryProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewRuleFactoryProcessor(handler RuleFactory) *RuleFactoryProcessor {
+	self := &RuleFactoryProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("SiteRuleList", &ruleFactoryProcessorSiteRuleList{handler: handler})
+	self.AddToProcessorMap("SiteRuleDetail", &ruleFactoryProcessorSiteRuleDetail{handler: handler})
+	self.AddToProcessorMap("CreateSiteRule", &ruleFactoryProcessorCreateSiteRule{handler: handler})
+	self.AddToProcessorMap("UpdateSiteRule", &ruleFactoryProcessorUpdateSiteRule{handler: handler})
+	self.AddToProcessorMap("CreateSiteRuleFromProd", &ruleFactoryProcessorCreateSiteRuleFromProd{handler: handler})
+	self.AddToProcessorMap("PublishSiteRule", &ruleFactoryProcessorPublishSiteRule{handler: handler})
+	self.AddToProcessorMap("DeleteSiteRule", &ruleFactoryProcessorDeleteSiteRule{handler: handler})
+	self.AddToProcessorMap("ExportSiteRules", &ruleFactoryProcessorExportSiteRules{handler: handler})
+	self.AddToProcessorMap("ImportSiteRules", &ruleFactoryProcessorImportSiteRules{handler: handler})
+	self.AddToProcessorMap("SiteRuleRevisionList", &ruleFactoryProcessorSiteRuleRevisionList{handler: handler})
+	self.AddToProcessorMap("SiteRuleRevisionDiff", &ruleFactoryProcessorSiteRuleRevisionDiff{handler: handler})
+	self.AddToProcessorMap("RollbackSiteRule", &ruleFactoryProcessorRollbackSiteRule{handler: handler})
+	self.AddToProcessorMap("ValidateSiteRule", &ruleFactoryProcessorValidateSiteRule{handler: handler})
+	self.AddToProcessorMap("SiteRuleDryRun", &ruleFactoryProcessorSiteRuleDryRun{handler: handler})
+	self.AddToProcessorMap("ExplainSiteRuleMatch", &ruleFactoryProcessorExplainSiteRuleMatch{handler: handler})
+	self.AddToProcessorMap("SuggestSiteRule", &ruleFactoryProcessorSuggestSiteRule{handler: handler})
+	return self
+}
+func (p *RuleFactoryProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type ruleFactoryProcessorSiteRuleList struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorSiteRuleList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactorySiteRuleListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SiteRuleList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactorySiteRuleListResult{}
+	var retval *SiteRuleListResp
+	if retval, err2 = p.handler.SiteRuleList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SiteRuleList: "+err2.Error())
+		oprot.WriteMessageBegin("SiteRuleList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SiteRuleList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorSiteRuleDetail struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorSiteRuleDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactorySiteRuleDetailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SiteRuleDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactorySiteRuleDetailResult{}
+	var retval *SiteRuleDetailResp
+	if retval, err2 = p.handler.SiteRuleDetail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SiteRuleDetail: "+err2.Error())
+		oprot.WriteMessageBegin("SiteRuleDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SiteRuleDetail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorCreateSiteRule struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorCreateSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryCreateSiteRuleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryCreateSiteRuleResult{}
+	var retval *CreateSiteRuleResp
+	if retval, err2 = p.handler.CreateSiteRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateSiteRule: "+err2.Error())
+		oprot.WriteMessageBegin("CreateSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateSiteRule", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorUpdateSiteRule struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorUpdateSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryUpdateSiteRuleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryUpdateSiteRuleResult{}
+	var retval *UpdateSiteRuleResp
+	if retval, err2 = p.handler.UpdateSiteRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateSiteRule: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateSiteRule", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorCreateSiteRuleFromProd struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorCreateSiteRuleFromProd) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryCreateSiteRuleFromProdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateSiteRuleFromProd", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryCreateSiteRuleFromProdResult{}
+	var retval *ExportProdRuleToTestResp
+	if retval, err2 = p.handler.CreateSiteRuleFromProd(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateSiteRuleFromProd: "+err2.Error())
+		oprot.WriteMessageBegin("CreateSiteRuleFromProd", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateSiteRuleFromProd", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorPublishSiteRule struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorPublishSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryPublishSiteRuleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PublishSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryPublishSiteRuleResult{}
+	var retval *PublishSiteRuleResp
+	if retval, err2 = p.handler.PublishSiteRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishSiteRule: "+err2.Error())
+		oprot.WriteMessageBegin("PublishSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PublishSiteRule", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorDeleteSiteRule struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorDeleteSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryDeleteSiteRuleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryDeleteSiteRuleResult{}
+	var retval *DeleteSiteRuleResp
+	if retval, err2 = p.handler.DeleteSiteRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteSiteRule: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteSiteRule", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorExportSiteRules struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorExportSiteRules) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryExportSiteRulesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExportSiteRules", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryExportSiteRulesResult{}
+	var retval *ExportSiteRulesResp
+	if retval, err2 = p.handler.ExportSiteRules(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportSiteRules: "+err2.Error())
+		oprot.WriteMessageBegin("ExportSiteRules", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExportSiteRules", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorImportSiteRules struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorImportSiteRules) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryImportSiteRulesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ImportSiteRules", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryImportSiteRulesResult{}
+	var retval *ImportSiteRulesResp
+	if retval, err2 = p.handler.ImportSiteRules(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ImportSiteRules: "+err2.Error())
+		oprot.WriteMessageBegin("ImportSiteRules", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ImportSiteRules", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorSiteRuleRevisionList struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorSiteRuleRevisionList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactorySiteRuleRevisionListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SiteRuleRevisionList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactorySiteRuleRevisionListResult{}
+	var retval *SiteRuleRevisionListResp
+	if retval, err2 = p.handler.SiteRuleRevisionList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SiteRuleRevisionList: "+err2.Error())
+		oprot.WriteMessageBegin("SiteRuleRevisionList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SiteRuleRevisionList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorSiteRuleRevisionDiff struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorSiteRuleRevisionDiff) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactorySiteRuleRevisionDiffArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SiteRuleRevisionDiff", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactorySiteRuleRevisionDiffResult{}
+	var retval *SiteRuleRevisionDiffResp
+	if retval, err2 = p.handler.SiteRuleRevisionDiff(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SiteRuleRevisionDiff: "+err2.Error())
+		oprot.WriteMessageBegin("SiteRuleRevisionDiff", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SiteRuleRevisionDiff", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorRollbackSiteRule struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorRollbackSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryRollbackSiteRuleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RollbackSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryRollbackSiteRuleResult{}
+	var retval *RollbackSiteRuleResp
+	if retval, err2 = p.handler.RollbackSiteRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RollbackSiteRule: "+err2.Error())
+		oprot.WriteMessageBegin("RollbackSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RollbackSiteRule", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorValidateSiteRule struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorValidateSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryValidateSiteRuleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ValidateSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryValidateSiteRuleResult{}
+	var retval *ValidateSiteRuleResp
+	if retval, err2 = p.handler.ValidateSiteRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ValidateSiteRule: "+err2.Error())
+		oprot.WriteMessageBegin("ValidateSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ValidateSiteRule", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorSiteRuleDryRun struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorSiteRuleDryRun) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactorySiteRuleDryRunArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SiteRuleDryRun", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactorySiteRuleDryRunResult{}
+	var retval *SiteRuleDryRunResp
+	if retval, err2 = p.handler.SiteRuleDryRun(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SiteRuleDryRun: "+err2.Error())
+		oprot.WriteMessageBegin("SiteRuleDryRun", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SiteRuleDryRun", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorExplainSiteRuleMatch struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorExplainSiteRuleMatch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactoryExplainSiteRuleMatchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExplainSiteRuleMatch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactoryExplainSiteRuleMatchResult{}
+	var retval *ExplainSiteRuleMatchResp
+	if retval, err2 = p.handler.ExplainSiteRuleMatch(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExplainSiteRuleMatch: "+err2.Error())
+		oprot.WriteMessageBegin("ExplainSiteRuleMatch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExplainSiteRuleMatch", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ruleFactoryProcessorSuggestSiteRule struct {
+	handler RuleFactory
+}
+
+func (p *ruleFactoryProcessorSuggestSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RuleFactorySuggestSiteRuleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SuggestSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RuleFactorySuggestSiteRuleResult{}
+	var retval *SuggestSiteRuleResp
+	if retval, err2 = p.handler.SuggestSiteRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SuggestSiteRule: "+err2.Error())
+		oprot.WriteMessageBegin("SuggestSiteRule", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SuggestSiteRule", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type RuleFactorySiteRuleListArgs struct {
+	Req *SiteRuleListReq `thrift:"req,1"`
+}
+
+func NewRuleFactorySiteRuleListArgs() *RuleFactorySiteRuleListArgs {
+	return &RuleFactorySiteRuleListArgs{}
+}
+
+var RuleFactorySiteRuleListArgs_Req_DEFAULT *SiteRuleListReq
+
+func (p *RuleFactorySiteRuleListArgs) GetReq() (v *SiteRuleListReq) {
+	if !p.IsSetReq() {
+		return RuleFactorySiteRuleListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RuleFactorySiteRuleListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RuleFactorySiteRuleListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RuleFactorySiteRuleListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RuleFactorySiteRuleListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactorySiteRuleListArgs(%+v)", *p)
+
+}
+
+type RuleFactorySiteRuleListResult struct {
+	Success *SiteRuleListResp `thrift:"success,0,optional"`
+}
+
+func NewRuleFactorySiteRuleListResult() *RuleFactorySiteRuleListResult {
+	return &RuleFactorySiteRuleListResult{}
+}
+
+var RuleFactorySiteRuleListResult_Success_DEFAULT *SiteRuleListResp
+
+func (p *RuleFactorySiteRuleListResult) GetSuccess() (v *SiteRuleListResp) {
+	if !p.IsSetSuccess() {
+		return RuleFactorySiteRuleListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RuleFactorySiteRuleListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RuleFactorySiteRuleListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RuleFactorySiteRuleListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RuleFactorySiteRuleListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactorySiteRuleListResult(%+v)", *p)
+
+}
+
+type RuleFactorySiteRuleDetailArgs struct {
+	Req *SiteRuleDetailReq `thrift:"req,1"`
+}
+
+func NewRuleFactorySiteRuleDetailArgs() *RuleFactorySiteRuleDetailArgs {
+	return &RuleFactorySiteRuleDetailArgs{}
+}
+
+var RuleFactorySiteRuleDetailArgs_Req_DEFAULT *SiteRuleDetailReq
+
+func (p *RuleFactorySiteRuleDetailArgs) GetReq() (v *SiteRuleDetailReq) {
+	if !p.IsSetReq() {
+		return RuleFactorySiteRuleDetailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RuleFactorySiteRuleDetailArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RuleFactorySiteRuleDetailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RuleFactorySiteRuleDetailArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleDetailArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleDetailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleDetailReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RuleFactorySiteRuleDetailArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleDetail_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleDetailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactorySiteRuleDetailArgs(%+v)", *p)
+
+}
+
+type RuleFactorySiteRuleDetailResult struct {
+	Success *SiteRuleDetailResp `thrift:"success,0,optional"`
+}
+
+func NewRuleFactorySiteRuleDetailResult() *RuleFactorySiteRuleDetailResult {
+	return &RuleFactorySiteRuleDetailResult{}
+}
+
+var RuleFactorySiteRuleDetailResult_Success_DEFAULT *SiteRuleDetailResp
+
+func (p *RuleFactorySiteRuleDetailResult) GetSuccess() (v *SiteRuleDetailResp) {
+	if !p.IsSetSuccess() {
+		return RuleFactorySiteRuleDetailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RuleFactorySiteRuleDetailResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RuleFactorySiteRuleDetailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RuleFactorySiteRuleDetailResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleDetailResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleDetailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleDetailResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RuleFactorySiteRuleDetailResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SiteRuleDetail_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RuleFactorySiteRuleDetailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactorySiteRuleDetailResult(%+v)", *p)
+
+}
+
+type RuleFactoryCreateSiteRuleArgs struct {
+	Req *CreateSiteRuleReq `thrift:"req,1"`
+}
+
+func NewRuleFactoryCreateSiteRuleArgs() *RuleFactoryCreateSiteRuleArgs {
+	return &RuleFactoryCreateSiteRuleArgs{}
+}
+
+var RuleFactoryCreateSiteRuleArgs_Req_DEFAULT *CreateSiteRuleReq
+
+func (p *RuleFactoryCreateSiteRuleArgs) GetReq() (v *CreateSiteRuleReq) {
+	if !p.IsSetReq() {
+		return RuleFactoryCreateSiteRuleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RuleFactoryCreateSiteRuleArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RuleFactoryCreateSiteRuleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RuleFactoryCreateSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryCreateSiteRuleArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateSiteRuleReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RuleFactoryCreateSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateSiteRule_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactoryCreateSiteRuleArgs(%+v)", *p)
+
+}
+
+type RuleFactoryCreateSiteRuleResult struct {
+	Success *CreateSiteRuleResp `thrift:"success,0,optional"`
+}
+
+func NewRuleFactoryCreateSiteRuleResult() *RuleFactoryCreateSiteRuleResult {
+	return &RuleFactoryCreateSiteRuleResult{}
+}
+
+var RuleFactoryCreateSiteRuleResult_Success_DEFAULT *CreateSiteRuleResp
+
+func (p *RuleFactoryCreateSiteRuleResult) GetSuccess() (v *CreateSiteRuleResp) {
+	if !p.IsSetSuccess() {
+		return RuleFactoryCreateSiteRuleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RuleFactoryCreateSiteRuleResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RuleFactoryCreateSiteRuleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RuleFactoryCreateSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryCreateSiteRuleResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateSiteRuleResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RuleFactoryCreateSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateSiteRule_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactoryCreateSiteRuleResult(%+v)", *p)
+
+}
+
+type RuleFactoryUpdateSiteRuleArgs struct {
+	Req *SiteRuleData `thrift:"req,1"`
+}
+
+func NewRuleFactoryUpdateSiteRuleArgs() *RuleFactoryUpdateSiteRuleArgs {
+	return &RuleFactoryUpdateSiteRuleArgs{}
+}
+
+var RuleFactoryUpdateSiteRuleArgs_Req_DEFAULT *SiteRuleData
+
+func (p *RuleFactoryUpdateSiteRuleArgs) GetReq() (v *SiteRuleData) {
+	if !p.IsSetReq() {
+		return RuleFactoryUpdateSiteRuleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RuleFactoryUpdateSiteRuleArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RuleFactoryUpdateSiteRuleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RuleFactoryUpdateSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryUpdateSiteRuleArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactoryUpdateSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RuleFactoryUpdateSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateSiteRule_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryUpdateSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RuleFactoryUpdateSiteRuleArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactoryUpdateSiteRuleArgs(%+v)", *p)
+
+}
+
+type RuleFactoryUpdateSiteRuleResult struct {
+	Success *UpdateSiteRuleResp `thrift:"success,0,optional"`
+}
+
+func NewRuleFactoryUpdateSiteRuleResult() *RuleFactoryUpdateSiteRuleResult {
+	return &RuleFactoryUpdateSiteRuleResult{}
+}
+
+var RuleFactoryUpdateSiteRuleResult_Success_DEFAULT *UpdateSiteRuleResp
+
+func (p *RuleFactoryUpdateSiteRuleResult) GetSuccess() (v *UpdateSiteRuleResp) {
+	if !p.IsSetSuccess() {
+		return RuleFactoryUpdateSiteRuleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RuleFactoryUpdateSiteRuleResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RuleFactoryUpdateSiteRuleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RuleFactoryUpdateSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryUpdateSiteRuleResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactoryUpdateSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateSiteRuleResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RuleFactoryUpdateSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateSiteRule_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryUpdateSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RuleFactoryUpdateSiteRuleResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactoryUpdateSiteRuleResult(%+v)", *p)
+
+}
+
+type RuleFactoryCreateSiteRuleFromProdArgs struct {
+	Req *ExportProdRuleToTestReq `thrift:"req,1"`
+}
+
+func NewRuleFactoryCreateSiteRuleFromProdArgs() *RuleFactoryCreateSiteRuleFromProdArgs {
+	return &RuleFactoryCreateSiteRuleFromProdArgs{}
+}
+
+var RuleFactoryCreateSiteRuleFromProdArgs_Req_DEFAULT *ExportProdRuleToTestReq
+
+func (p *RuleFactoryCreateSiteRuleFromProdArgs) GetReq() (v *ExportProdRuleToTestReq) {
+	if !p.IsSetReq() {
+		return RuleFactoryCreateSiteRuleFromProdArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RuleFactoryCreateSiteRuleFromProdArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryCreateSiteRuleFromProdArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExportProdRuleToTestReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateSiteRuleFromProd_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactoryCreateSiteRuleFromProdArgs(%+v)", *p)
+
+}
+
+type RuleFactoryCreateSiteRuleFromProdResult struct {
+	Success *ExportProdRuleToTestResp `thrift:"success,0,optional"`
+}
+
+func NewRuleFactoryCreateSiteRuleFromProdResult() *RuleFactoryCreateSiteRuleFromProdResult {
+	return &RuleFactoryCreateSiteRuleFromProdResult{}
+}
+
+var RuleFactoryCreateSiteRuleFromProdResult_Success_DEFAULT *ExportProdRuleToTestResp
+
+func (p *RuleFactoryCreateSiteRuleFromProdResult) GetSuccess() (v *ExportProdRuleToTestResp) {
+	if !p.IsSetSuccess() {
+		return RuleFactoryCreateSiteRuleFromProdResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RuleFactoryCreateSiteRuleFromProdResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryCreateSiteRuleFromProdResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExportProdRuleToTestResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateSiteRuleFromProd_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RuleFactoryCreateSiteRuleFromProdResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RuleFactoryCreateSiteRuleFromProdResult(%+v)", *p)
+
+}
+
+type RuleFactoryPublishSiteRuleArgs struct {
+	Req *PublishSiteRuleReq `thrift:"req,1"`
+}
+
+func NewRuleFactoryPublishSiteRuleArgs() *RuleFactoryPublishSiteRuleArgs {
+	return &RuleFactoryPublishSiteRuleArgs{}
+}
+
+var RuleFactoryPublishSiteRuleArgs_Req_DEFAULT *PublishSiteRuleReq
+
+func (p *RuleFactoryPublishSiteRuleArgs) GetReq() (v *PublishSiteRuleReq) {
+	if !p.IsSetReq() {
+		return RuleFactoryPublishSiteRuleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RuleFactoryPublishSiteRuleArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RuleFactoryPublishSiteRuleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RuleFactoryPublishSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryPublishSiteRuleArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RuleFactoryPublishSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPublishSiteRuleReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RuleFactoryPublishSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishSiteRule_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryPublishSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RuleFactoryPublishSiteRuleArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("RuleFactoryPublishSiteRuleArgs(%+v)", *p)
+
+}
+
+type RuleFactoryPublishSiteRuleResult struct {
+	Success *PublishSiteRuleResp `thrift:"success,0,optional"`
+}
+
+func NewRuleFactoryPublishSiteRuleResult() *RuleFactoryPublishSiteRuleResult {
+	return &RuleFactoryPublishSiteRuleResult{}
+}
+
+var RuleFactoryPublishSiteRuleResult_Success_DEFAULT *PublishSiteRuleResp
+
+func (p *RuleFactoryPublishSiteRuleResult) GetSuccess() (v *PublishSiteRuleResp) {
+	if !p.IsSetSuccess() {
+		return RuleFactoryPublishSiteRuleResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_RuleFactoryPublishSiteRuleResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RuleFactoryPublishSiteRuleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RuleFactoryPublishSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type ruleFactoryProcessorUpdateSiteRule struct {
-	handler RuleFactory
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryPublishSiteRuleResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ruleFactoryProcessorUpdateSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactoryUpdateSiteRuleArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateSiteRule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RuleFactoryPublishSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPublishSiteRuleResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactoryUpdateSiteRuleResult{}
-	var retval *UpdateSiteRuleResp
-	if retval, err2 = p.handler.UpdateSiteRule(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateSiteRule: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateSiteRule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *RuleFactoryPublishSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishSiteRule_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateSiteRule", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryPublishSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RuleFactoryPublishSiteRuleResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("RuleFactoryPublishSiteRuleResult(%+v)", *p)
+
+}
+
+type RuleFactoryDeleteSiteRuleArgs struct {
+	Req *DeleteSiteRuleReq `thrift:"req,1"`
+}
+
+func NewRuleFactoryDeleteSiteRuleArgs() *RuleFactoryDeleteSiteRuleArgs {
+	return &RuleFactoryDeleteSiteRuleArgs{}
+}
+
+var RuleFactoryDeleteSiteRuleArgs_Req_DEFAULT *DeleteSiteRuleReq
+
+func (p *RuleFactoryDeleteSiteRuleArgs) GetReq() (v *DeleteSiteRuleReq) {
+	if !p.IsSetReq() {
+		return RuleFactoryDeleteSiteRuleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RuleFactoryDeleteSiteRuleArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RuleFactoryDeleteSiteRuleArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-type ruleFactoryProcessorCreateSiteRuleFromProd struct {
-	handler RuleFactory
+func (p *RuleFactoryDeleteSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryDeleteSiteRuleArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ruleFactoryProcessorCreateSiteRuleFromProd) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactoryCreateSiteRuleFromProdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateSiteRuleFromProd", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RuleFactoryDeleteSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteSiteRuleReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactoryCreateSiteRuleFromProdResult{}
-	var retval *ExportProdRuleToTestResp
-	if retval, err2 = p.handler.CreateSiteRuleFromProd(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateSiteRuleFromProd: "+err2.Error())
-		oprot.WriteMessageBegin("CreateSiteRuleFromProd", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateSiteRuleFromProd", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *RuleFactoryDeleteSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteSiteRule_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type ruleFactoryProcessorPublishSiteRule struct {
-	handler RuleFactory
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ruleFactoryProcessorPublishSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactoryPublishSiteRuleArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PublishSiteRule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactoryPublishSiteRuleResult{}
-	var retval *PublishSiteRuleResp
-	if retval, err2 = p.handler.PublishSiteRule(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishSiteRule: "+err2.Error())
-		oprot.WriteMessageBegin("PublishSiteRule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("PublishSiteRule", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *RuleFactoryDeleteSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RuleFactoryDeleteSiteRuleArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("RuleFactoryDeleteSiteRuleArgs(%+v)", *p)
+
 }
 
-type ruleFactoryProcessorDeleteSiteRule struct {
-	handler RuleFactory
+type RuleFactoryDeleteSiteRuleResult struct {
+	Success *DeleteSiteRuleResp `thrift:"success,0,optional"`
 }
 
-func (p *ruleFactoryProcessorDeleteSiteRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactoryDeleteSiteRuleArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteSiteRule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewRuleFactoryDeleteSiteRuleResult() *RuleFactoryDeleteSiteRuleResult {
+	return &RuleFactoryDeleteSiteRuleResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactoryDeleteSiteRuleResult{}
-	var retval *DeleteSiteRuleResp
-	if retval, err2 = p.handler.DeleteSiteRule(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteSiteRule: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteSiteRule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteSiteRule", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+var RuleFactoryDeleteSiteRuleResult_Success_DEFAULT *DeleteSiteRuleResp
+
+func (p *RuleFactoryDeleteSiteRuleResult) GetSuccess() (v *DeleteSiteRuleResp) {
+	if !p.IsSetSuccess() {
+		return RuleFactoryDeleteSiteRuleResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type ruleFactoryProcessorExportSiteRules struct {
-	handler RuleFactory
+var fieldIDToName_RuleFactoryDeleteSiteRuleResult = map[int16]string{
+	0: "success",
 }
 
-func (p *ruleFactoryProcessorExportSiteRules) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactoryExportSiteRulesArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ExportSiteRules", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *RuleFactoryDeleteSiteRuleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactoryExportSiteRulesResult{}
-	var retval *ExportSiteRulesResp
-	if retval, err2 = p.handler.ExportSiteRules(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportSiteRules: "+err2.Error())
-		oprot.WriteMessageBegin("ExportSiteRules", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ExportSiteRules", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *RuleFactoryDeleteSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type ruleFactoryProcessorImportSiteRules struct {
-	handler RuleFactory
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryDeleteSiteRuleResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ruleFactoryProcessorImportSiteRules) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RuleFactoryImportSiteRulesArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ImportSiteRules", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *RuleFactoryDeleteSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteSiteRuleResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RuleFactoryImportSiteRulesResult{}
-	var retval *ImportSiteRulesResp
-	if retval, err2 = p.handler.ImportSiteRules(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ImportSiteRules: "+err2.Error())
-		oprot.WriteMessageBegin("ImportSiteRules", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *RuleFactoryDeleteSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteSiteRule_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("ImportSiteRules", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RuleFactoryDeleteSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RuleFactoryDeleteSiteRuleResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("RuleFactoryDeleteSiteRuleResult(%+v)", *p)
+
 }
 
-type RuleFactorySiteRuleListArgs struct {
-	Req *SiteRuleListReq `thrift:"req,1"`
+type RuleFactoryExportSiteRulesArgs struct {
+	Req *EmptyReq `thrift:"req,1"`
 }
 
-func NewRuleFactorySiteRuleListArgs() *RuleFactorySiteRuleListArgs {
-	return &RuleFactorySiteRuleListArgs{}
+func NewRuleFactoryExportSiteRulesArgs() *RuleFactoryExportSiteRulesArgs {
+	return &RuleFactoryExportSiteRulesArgs{}
 }
 
-var RuleFactorySiteRuleListArgs_Req_DEFAULT *SiteRuleListReq
+var RuleFactoryExportSiteRulesArgs_Req_DEFAULT *EmptyReq
 
-func (p *RuleFactorySiteRuleListArgs) GetReq() (v *SiteRuleListReq) {
+func (p *RuleFactoryExportSiteRulesArgs) GetReq() (v *EmptyReq) {
 	if !p.IsSetReq() {
-		return RuleFactorySiteRuleListArgs_Req_DEFAULT
+		return RuleFactoryExportSiteRulesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactorySiteRuleListArgs = map[int16]string{
+var fieldIDToName_RuleFactoryExportSiteRulesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactorySiteRuleListArgs) IsSetReq() bool {
+func (p *RuleFactoryExportSiteRulesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactorySiteRuleListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExportSiteRulesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4741,7 +15429,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryExportSiteRulesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4751,8 +15439,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSiteRuleListReq()
+func (p *RuleFactoryExportSiteRulesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewEmptyReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4760,9 +15448,9 @@ func (p *RuleFactorySiteRuleListArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *RuleFactorySiteRuleListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExportSiteRulesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SiteRuleList_args"); err != nil {
+	if err = oprot.WriteStructBegin("ExportSiteRules_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4788,7 +15476,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExportSiteRulesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4805,40 +15493,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleListArgs) String() string {
+func (p *RuleFactoryExportSiteRulesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactorySiteRuleListArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryExportSiteRulesArgs(%+v)", *p)
 
 }
 
-type RuleFactorySiteRuleListResult struct {
-	Success *SiteRuleListResp `thrift:"success,0,optional"`
+type RuleFactoryExportSiteRulesResult struct {
+	Success *ExportSiteRulesResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactorySiteRuleListResult() *RuleFactorySiteRuleListResult {
-	return &RuleFactorySiteRuleListResult{}
+func NewRuleFactoryExportSiteRulesResult() *RuleFactoryExportSiteRulesResult {
+	return &RuleFactoryExportSiteRulesResult{}
 }
 
-var RuleFactorySiteRuleListResult_Success_DEFAULT *SiteRuleListResp
+var RuleFactoryExportSiteRulesResult_Success_DEFAULT *ExportSiteRulesResp
 
-func (p *RuleFactorySiteRuleListResult) GetSuccess() (v *SiteRuleListResp) {
+func (p *RuleFactoryExportSiteRulesResult) GetSuccess() (v *ExportSiteRulesResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactorySiteRuleListResult_Success_DEFAULT
+		return RuleFactoryExportSiteRulesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactorySiteRuleListResult = map[int16]string{
+var fieldIDToName_RuleFactoryExportSiteRulesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactorySiteRuleListResult) IsSetSuccess() bool {
+func (p *RuleFactoryExportSiteRulesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactorySiteRuleListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExportSiteRulesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4884,7 +15572,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryExportSiteRulesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4894,8 +15582,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSiteRuleListResp()
+func (p *RuleFactoryExportSiteRulesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExportSiteRulesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4903,9 +15591,9 @@ func (p *RuleFactorySiteRuleListResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *RuleFactorySiteRuleListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExportSiteRulesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SiteRuleList_result"); err != nil {
+	if err = oprot.WriteStructBegin("ExportSiteRules_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4931,7 +15619,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExportSiteRulesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4950,40 +15638,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleListResult) String() string {
+func (p *RuleFactoryExportSiteRulesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactorySiteRuleListResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryExportSiteRulesResult(%+v)", *p)
 
 }
 
-type RuleFactorySiteRuleDetailArgs struct {
-	Req *SiteRuleDetailReq `thrift:"req,1"`
+type RuleFactoryImportSiteRulesArgs struct {
+	Req *EmptyReq `thrift:"req,1"`
 }
 
-func NewRuleFactorySiteRuleDetailArgs() *RuleFactorySiteRuleDetailArgs {
-	return &RuleFactorySiteRuleDetailArgs{}
+func NewRuleFactoryImportSiteRulesArgs() *RuleFactoryImportSiteRulesArgs {
+	return &RuleFactoryImportSiteRulesArgs{}
 }
 
-var RuleFactorySiteRuleDetailArgs_Req_DEFAULT *SiteRuleDetailReq
+var RuleFactoryImportSiteRulesArgs_Req_DEFAULT *EmptyReq
 
-func (p *RuleFactorySiteRuleDetailArgs) GetReq() (v *SiteRuleDetailReq) {
+func (p *RuleFactoryImportSiteRulesArgs) GetReq() (v *EmptyReq) {
 	if !p.IsSetReq() {
-		return RuleFactorySiteRuleDetailArgs_Req_DEFAULT
+		return RuleFactoryImportSiteRulesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactorySiteRuleDetailArgs = map[int16]string{
+var fieldIDToName_RuleFactoryImportSiteRulesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactorySiteRuleDetailArgs) IsSetReq() bool {
+func (p *RuleFactoryImportSiteRulesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactorySiteRuleDetailArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryImportSiteRulesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5029,7 +15717,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleDetailArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryImportSiteRulesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5039,8 +15727,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleDetailArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSiteRuleDetailReq()
+func (p *RuleFactoryImportSiteRulesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewEmptyReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5048,9 +15736,9 @@ func (p *RuleFactorySiteRuleDetailArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *RuleFactorySiteRuleDetailArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryImportSiteRulesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SiteRuleDetail_args"); err != nil {
+	if err = oprot.WriteStructBegin("ImportSiteRules_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5076,7 +15764,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryImportSiteRulesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5093,40 +15781,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleDetailArgs) String() string {
+func (p *RuleFactoryImportSiteRulesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactorySiteRuleDetailArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryImportSiteRulesArgs(%+v)", *p)
 
 }
 
-type RuleFactorySiteRuleDetailResult struct {
-	Success *SiteRuleDetailResp `thrift:"success,0,optional"`
+type RuleFactoryImportSiteRulesResult struct {
+	Success *ImportSiteRulesResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactorySiteRuleDetailResult() *RuleFactorySiteRuleDetailResult {
-	return &RuleFactorySiteRuleDetailResult{}
+func NewRuleFactoryImportSiteRulesResult() *RuleFactoryImportSiteRulesResult {
+	return &RuleFactoryImportSiteRulesResult{}
 }
 
-var RuleFactorySiteRuleDetailResult_Success_DEFAULT *SiteRuleDetailResp
+var RuleFactoryImportSiteRulesResult_Success_DEFAULT *ImportSiteRulesResp
 
-func (p *RuleFactorySiteRuleDetailResult) GetSuccess() (v *SiteRuleDetailResp) {
+func (p *RuleFactoryImportSiteRulesResult) GetSuccess() (v *ImportSiteRulesResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactorySiteRuleDetailResult_Success_DEFAULT
+		return RuleFactoryImportSiteRulesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactorySiteRuleDetailResult = map[int16]string{
+var fieldIDToName_RuleFactoryImportSiteRulesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactorySiteRuleDetailResult) IsSetSuccess() bool {
+func (p *RuleFactoryImportSiteRulesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactorySiteRuleDetailResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryImportSiteRulesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5172,7 +15860,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleDetailResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryImportSiteRulesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5182,8 +15870,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleDetailResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSiteRuleDetailResp()
+func (p *RuleFactoryImportSiteRulesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewImportSiteRulesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5191,9 +15879,9 @@ func (p *RuleFactorySiteRuleDetailResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *RuleFactorySiteRuleDetailResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryImportSiteRulesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SiteRuleDetail_result"); err != nil {
+	if err = oprot.WriteStructBegin("ImportSiteRules_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5219,7 +15907,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryImportSiteRulesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5238,40 +15926,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactorySiteRuleDetailResult) String() string {
+func (p *RuleFactoryImportSiteRulesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactorySiteRuleDetailResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryImportSiteRulesResult(%+v)", *p)
 
 }
 
-type RuleFactoryCreateSiteRuleArgs struct {
-	Req *CreateSiteRuleReq `thrift:"req,1"`
+type RuleFactorySiteRuleRevisionListArgs struct {
+	Req *SiteRuleRevisionListReq `thrift:"req,1"`
 }
 
-func NewRuleFactoryCreateSiteRuleArgs() *RuleFactoryCreateSiteRuleArgs {
-	return &RuleFactoryCreateSiteRuleArgs{}
+func NewRuleFactorySiteRuleRevisionListArgs() *RuleFactorySiteRuleRevisionListArgs {
+	return &RuleFactorySiteRuleRevisionListArgs{}
 }
 
-var RuleFactoryCreateSiteRuleArgs_Req_DEFAULT *CreateSiteRuleReq
+var RuleFactorySiteRuleRevisionListArgs_Req_DEFAULT *SiteRuleRevisionListReq
 
-func (p *RuleFactoryCreateSiteRuleArgs) GetReq() (v *CreateSiteRuleReq) {
+func (p *RuleFactorySiteRuleRevisionListArgs) GetReq() (v *SiteRuleRevisionListReq) {
 	if !p.IsSetReq() {
-		return RuleFactoryCreateSiteRuleArgs_Req_DEFAULT
+		return RuleFactorySiteRuleRevisionListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactoryCreateSiteRuleArgs = map[int16]string{
+var fieldIDToName_RuleFactorySiteRuleRevisionListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactoryCreateSiteRuleArgs) IsSetReq() bool {
+func (p *RuleFactorySiteRuleRevisionListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactoryCreateSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5317,7 +16005,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryCreateSiteRuleArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleRevisionListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5327,8 +16015,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateSiteRuleReq()
+func (p *RuleFactorySiteRuleRevisionListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleRevisionListReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5336,9 +16024,9 @@ func (p *RuleFactoryCreateSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *RuleFactoryCreateSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateSiteRule_args"); err != nil {
+	if err = oprot.WriteStructBegin("SiteRuleRevisionList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5364,7 +16052,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5381,40 +16069,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleArgs) String() string {
+func (p *RuleFactorySiteRuleRevisionListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryCreateSiteRuleArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactorySiteRuleRevisionListArgs(%+v)", *p)
 
 }
 
-type RuleFactoryCreateSiteRuleResult struct {
-	Success *CreateSiteRuleResp `thrift:"success,0,optional"`
+type RuleFactorySiteRuleRevisionListResult struct {
+	Success *SiteRuleRevisionListResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactoryCreateSiteRuleResult() *RuleFactoryCreateSiteRuleResult {
-	return &RuleFactoryCreateSiteRuleResult{}
+func NewRuleFactorySiteRuleRevisionListResult() *RuleFactorySiteRuleRevisionListResult {
+	return &RuleFactorySiteRuleRevisionListResult{}
 }
 
-var RuleFactoryCreateSiteRuleResult_Success_DEFAULT *CreateSiteRuleResp
+var RuleFactorySiteRuleRevisionListResult_Success_DEFAULT *SiteRuleRevisionListResp
 
-func (p *RuleFactoryCreateSiteRuleResult) GetSuccess() (v *CreateSiteRuleResp) {
+func (p *RuleFactorySiteRuleRevisionListResult) GetSuccess() (v *SiteRuleRevisionListResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactoryCreateSiteRuleResult_Success_DEFAULT
+		return RuleFactorySiteRuleRevisionListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactoryCreateSiteRuleResult = map[int16]string{
+var fieldIDToName_RuleFactorySiteRuleRevisionListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactoryCreateSiteRuleResult) IsSetSuccess() bool {
+func (p *RuleFactorySiteRuleRevisionListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactoryCreateSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5460,7 +16148,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryCreateSiteRuleResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleRevisionListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5470,8 +16158,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateSiteRuleResp()
+func (p *RuleFactorySiteRuleRevisionListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleRevisionListResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5479,9 +16167,9 @@ func (p *RuleFactoryCreateSiteRuleResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *RuleFactoryCreateSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateSiteRule_result"); err != nil {
+	if err = oprot.WriteStructBegin("SiteRuleRevisionList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5507,7 +16195,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5526,40 +16214,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleResult) String() string {
+func (p *RuleFactorySiteRuleRevisionListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryCreateSiteRuleResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactorySiteRuleRevisionListResult(%+v)", *p)
 
 }
 
-type RuleFactoryUpdateSiteRuleArgs struct {
-	Req *SiteRuleData `thrift:"req,1"`
+type RuleFactorySiteRuleRevisionDiffArgs struct {
+	Req *SiteRuleRevisionDiffReq `thrift:"req,1"`
 }
 
-func NewRuleFactoryUpdateSiteRuleArgs() *RuleFactoryUpdateSiteRuleArgs {
-	return &RuleFactoryUpdateSiteRuleArgs{}
+func NewRuleFactorySiteRuleRevisionDiffArgs() *RuleFactorySiteRuleRevisionDiffArgs {
+	return &RuleFactorySiteRuleRevisionDiffArgs{}
 }
 
-var RuleFactoryUpdateSiteRuleArgs_Req_DEFAULT *SiteRuleData
+var RuleFactorySiteRuleRevisionDiffArgs_Req_DEFAULT *SiteRuleRevisionDiffReq
 
-func (p *RuleFactoryUpdateSiteRuleArgs) GetReq() (v *SiteRuleData) {
+func (p *RuleFactorySiteRuleRevisionDiffArgs) GetReq() (v *SiteRuleRevisionDiffReq) {
 	if !p.IsSetReq() {
-		return RuleFactoryUpdateSiteRuleArgs_Req_DEFAULT
+		return RuleFactorySiteRuleRevisionDiffArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactoryUpdateSiteRuleArgs = map[int16]string{
+var fieldIDToName_RuleFactorySiteRuleRevisionDiffArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactoryUpdateSiteRuleArgs) IsSetReq() bool {
+func (p *RuleFactorySiteRuleRevisionDiffArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactoryUpdateSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionDiffArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5605,7 +16293,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryUpdateSiteRuleArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleRevisionDiffArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5615,8 +16303,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryUpdateSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSiteRuleData()
+func (p *RuleFactorySiteRuleRevisionDiffArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleRevisionDiffReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5624,9 +16312,9 @@ func (p *RuleFactoryUpdateSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *RuleFactoryUpdateSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionDiffArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateSiteRule_args"); err != nil {
+	if err = oprot.WriteStructBegin("SiteRuleRevisionDiff_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5652,7 +16340,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryUpdateSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionDiffArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5669,40 +16357,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactoryUpdateSiteRuleArgs) String() string {
+func (p *RuleFactorySiteRuleRevisionDiffArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryUpdateSiteRuleArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactorySiteRuleRevisionDiffArgs(%+v)", *p)
 
 }
 
-type RuleFactoryUpdateSiteRuleResult struct {
-	Success *UpdateSiteRuleResp `thrift:"success,0,optional"`
+type RuleFactorySiteRuleRevisionDiffResult struct {
+	Success *SiteRuleRevisionDiffResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactoryUpdateSiteRuleResult() *RuleFactoryUpdateSiteRuleResult {
-	return &RuleFactoryUpdateSiteRuleResult{}
+func NewRuleFactorySiteRuleRevisionDiffResult() *RuleFactorySiteRuleRevisionDiffResult {
+	return &RuleFactorySiteRuleRevisionDiffResult{}
 }
 
-var RuleFactoryUpdateSiteRuleResult_Success_DEFAULT *UpdateSiteRuleResp
+var RuleFactorySiteRuleRevisionDiffResult_Success_DEFAULT *SiteRuleRevisionDiffResp
 
-func (p *RuleFactoryUpdateSiteRuleResult) GetSuccess() (v *UpdateSiteRuleResp) {
+func (p *RuleFactorySiteRuleRevisionDiffResult) GetSuccess() (v *SiteRuleRevisionDiffResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactoryUpdateSiteRuleResult_Success_DEFAULT
+		return RuleFactorySiteRuleRevisionDiffResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactoryUpdateSiteRuleResult = map[int16]string{
+var fieldIDToName_RuleFactorySiteRuleRevisionDiffResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactoryUpdateSiteRuleResult) IsSetSuccess() bool {
+func (p *RuleFactorySiteRuleRevisionDiffResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactoryUpdateSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionDiffResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5748,7 +16436,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryUpdateSiteRuleResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleRevisionDiffResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5758,8 +16446,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryUpdateSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateSiteRuleResp()
+func (p *RuleFactorySiteRuleRevisionDiffResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleRevisionDiffResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5767,9 +16455,9 @@ func (p *RuleFactoryUpdateSiteRuleResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *RuleFactoryUpdateSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionDiffResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateSiteRule_result"); err != nil {
+	if err = oprot.WriteStructBegin("SiteRuleRevisionDiff_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5795,7 +16483,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryUpdateSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleRevisionDiffResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5814,40 +16502,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactoryUpdateSiteRuleResult) String() string {
+func (p *RuleFactorySiteRuleRevisionDiffResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryUpdateSiteRuleResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactorySiteRuleRevisionDiffResult(%+v)", *p)
 
 }
 
-type RuleFactoryCreateSiteRuleFromProdArgs struct {
-	Req *ExportProdRuleToTestReq `thrift:"req,1"`
+type RuleFactoryRollbackSiteRuleArgs struct {
+	Req *RollbackSiteRuleReq `thrift:"req,1"`
 }
 
-func NewRuleFactoryCreateSiteRuleFromProdArgs() *RuleFactoryCreateSiteRuleFromProdArgs {
-	return &RuleFactoryCreateSiteRuleFromProdArgs{}
+func NewRuleFactoryRollbackSiteRuleArgs() *RuleFactoryRollbackSiteRuleArgs {
+	return &RuleFactoryRollbackSiteRuleArgs{}
 }
 
-var RuleFactoryCreateSiteRuleFromProdArgs_Req_DEFAULT *ExportProdRuleToTestReq
+var RuleFactoryRollbackSiteRuleArgs_Req_DEFAULT *RollbackSiteRuleReq
 
-func (p *RuleFactoryCreateSiteRuleFromProdArgs) GetReq() (v *ExportProdRuleToTestReq) {
+func (p *RuleFactoryRollbackSiteRuleArgs) GetReq() (v *RollbackSiteRuleReq) {
 	if !p.IsSetReq() {
-		return RuleFactoryCreateSiteRuleFromProdArgs_Req_DEFAULT
+		return RuleFactoryRollbackSiteRuleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactoryCreateSiteRuleFromProdArgs = map[int16]string{
+var fieldIDToName_RuleFactoryRollbackSiteRuleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdArgs) IsSetReq() bool {
+func (p *RuleFactoryRollbackSiteRuleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryRollbackSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5893,7 +16581,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryCreateSiteRuleFromProdArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryRollbackSiteRuleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5903,8 +16591,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewExportProdRuleToTestReq()
+func (p *RuleFactoryRollbackSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRollbackSiteRuleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5912,9 +16600,9 @@ func (p *RuleFactoryCreateSiteRuleFromProdArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryRollbackSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateSiteRuleFromProd_args"); err != nil {
+	if err = oprot.WriteStructBegin("RollbackSiteRule_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5940,7 +16628,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryRollbackSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5957,40 +16645,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdArgs) String() string {
+func (p *RuleFactoryRollbackSiteRuleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryCreateSiteRuleFromProdArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryRollbackSiteRuleArgs(%+v)", *p)
 
 }
 
-type RuleFactoryCreateSiteRuleFromProdResult struct {
-	Success *ExportProdRuleToTestResp `thrift:"success,0,optional"`
+type RuleFactoryRollbackSiteRuleResult struct {
+	Success *RollbackSiteRuleResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactoryCreateSiteRuleFromProdResult() *RuleFactoryCreateSiteRuleFromProdResult {
-	return &RuleFactoryCreateSiteRuleFromProdResult{}
+func NewRuleFactoryRollbackSiteRuleResult() *RuleFactoryRollbackSiteRuleResult {
+	return &RuleFactoryRollbackSiteRuleResult{}
 }
 
-var RuleFactoryCreateSiteRuleFromProdResult_Success_DEFAULT *ExportProdRuleToTestResp
+var RuleFactoryRollbackSiteRuleResult_Success_DEFAULT *RollbackSiteRuleResp
 
-func (p *RuleFactoryCreateSiteRuleFromProdResult) GetSuccess() (v *ExportProdRuleToTestResp) {
+func (p *RuleFactoryRollbackSiteRuleResult) GetSuccess() (v *RollbackSiteRuleResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactoryCreateSiteRuleFromProdResult_Success_DEFAULT
+		return RuleFactoryRollbackSiteRuleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactoryCreateSiteRuleFromProdResult = map[int16]string{
+var fieldIDToName_RuleFactoryRollbackSiteRuleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdResult) IsSetSuccess() bool {
+func (p *RuleFactoryRollbackSiteRuleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryRollbackSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6036,7 +16724,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryCreateSiteRuleFromProdResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryRollbackSiteRuleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6046,8 +16734,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewExportProdRuleToTestResp()
+func (p *RuleFactoryRollbackSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRollbackSiteRuleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6055,9 +16743,9 @@ func (p *RuleFactoryCreateSiteRuleFromProdResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryRollbackSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateSiteRuleFromProd_result"); err != nil {
+	if err = oprot.WriteStructBegin("RollbackSiteRule_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6083,7 +16771,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryRollbackSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6102,40 +16790,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactoryCreateSiteRuleFromProdResult) String() string {
+func (p *RuleFactoryRollbackSiteRuleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryCreateSiteRuleFromProdResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryRollbackSiteRuleResult(%+v)", *p)
 
 }
 
-type RuleFactoryPublishSiteRuleArgs struct {
-	Req *PublishSiteRuleReq `thrift:"req,1"`
+type RuleFactoryValidateSiteRuleArgs struct {
+	Req *ValidateSiteRuleReq `thrift:"req,1"`
 }
 
-func NewRuleFactoryPublishSiteRuleArgs() *RuleFactoryPublishSiteRuleArgs {
-	return &RuleFactoryPublishSiteRuleArgs{}
+func NewRuleFactoryValidateSiteRuleArgs() *RuleFactoryValidateSiteRuleArgs {
+	return &RuleFactoryValidateSiteRuleArgs{}
 }
 
-var RuleFactoryPublishSiteRuleArgs_Req_DEFAULT *PublishSiteRuleReq
+var RuleFactoryValidateSiteRuleArgs_Req_DEFAULT *ValidateSiteRuleReq
 
-func (p *RuleFactoryPublishSiteRuleArgs) GetReq() (v *PublishSiteRuleReq) {
+func (p *RuleFactoryValidateSiteRuleArgs) GetReq() (v *ValidateSiteRuleReq) {
 	if !p.IsSetReq() {
-		return RuleFactoryPublishSiteRuleArgs_Req_DEFAULT
+		return RuleFactoryValidateSiteRuleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactoryPublishSiteRuleArgs = map[int16]string{
+var fieldIDToName_RuleFactoryValidateSiteRuleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactoryPublishSiteRuleArgs) IsSetReq() bool {
+func (p *RuleFactoryValidateSiteRuleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactoryPublishSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryValidateSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6181,7 +16869,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryPublishSiteRuleArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryValidateSiteRuleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6191,8 +16879,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryPublishSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPublishSiteRuleReq()
+func (p *RuleFactoryValidateSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewValidateSiteRuleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6200,9 +16888,9 @@ func (p *RuleFactoryPublishSiteRuleArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *RuleFactoryPublishSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryValidateSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PublishSiteRule_args"); err != nil {
+	if err = oprot.WriteStructBegin("ValidateSiteRule_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6228,7 +16916,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryPublishSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryValidateSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6245,40 +16933,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactoryPublishSiteRuleArgs) String() string {
+func (p *RuleFactoryValidateSiteRuleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryPublishSiteRuleArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryValidateSiteRuleArgs(%+v)", *p)
 
 }
 
-type RuleFactoryPublishSiteRuleResult struct {
-	Success *PublishSiteRuleResp `thrift:"success,0,optional"`
+type RuleFactoryValidateSiteRuleResult struct {
+	Success *ValidateSiteRuleResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactoryPublishSiteRuleResult() *RuleFactoryPublishSiteRuleResult {
-	return &RuleFactoryPublishSiteRuleResult{}
+func NewRuleFactoryValidateSiteRuleResult() *RuleFactoryValidateSiteRuleResult {
+	return &RuleFactoryValidateSiteRuleResult{}
 }
 
-var RuleFactoryPublishSiteRuleResult_Success_DEFAULT *PublishSiteRuleResp
+var RuleFactoryValidateSiteRuleResult_Success_DEFAULT *ValidateSiteRuleResp
 
-func (p *RuleFactoryPublishSiteRuleResult) GetSuccess() (v *PublishSiteRuleResp) {
+func (p *RuleFactoryValidateSiteRuleResult) GetSuccess() (v *ValidateSiteRuleResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactoryPublishSiteRuleResult_Success_DEFAULT
+		return RuleFactoryValidateSiteRuleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactoryPublishSiteRuleResult = map[int16]string{
+var fieldIDToName_RuleFactoryValidateSiteRuleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactoryPublishSiteRuleResult) IsSetSuccess() bool {
+func (p *RuleFactoryValidateSiteRuleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactoryPublishSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryValidateSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6324,7 +17012,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryPublishSiteRuleResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryValidateSiteRuleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6334,8 +17022,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryPublishSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPublishSiteRuleResp()
+func (p *RuleFactoryValidateSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewValidateSiteRuleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6343,9 +17031,9 @@ func (p *RuleFactoryPublishSiteRuleResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *RuleFactoryPublishSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryValidateSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PublishSiteRule_result"); err != nil {
+	if err = oprot.WriteStructBegin("ValidateSiteRule_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6371,7 +17059,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryPublishSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryValidateSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6390,40 +17078,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactoryPublishSiteRuleResult) String() string {
+func (p *RuleFactoryValidateSiteRuleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryPublishSiteRuleResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryValidateSiteRuleResult(%+v)", *p)
 
 }
 
-type RuleFactoryDeleteSiteRuleArgs struct {
-	Req *DeleteSiteRuleReq `thrift:"req,1"`
+type RuleFactorySiteRuleDryRunArgs struct {
+	Req *SiteRuleDryRunReq `thrift:"req,1"`
 }
 
-func NewRuleFactoryDeleteSiteRuleArgs() *RuleFactoryDeleteSiteRuleArgs {
-	return &RuleFactoryDeleteSiteRuleArgs{}
+func NewRuleFactorySiteRuleDryRunArgs() *RuleFactorySiteRuleDryRunArgs {
+	return &RuleFactorySiteRuleDryRunArgs{}
 }
 
-var RuleFactoryDeleteSiteRuleArgs_Req_DEFAULT *DeleteSiteRuleReq
+var RuleFactorySiteRuleDryRunArgs_Req_DEFAULT *SiteRuleDryRunReq
 
-func (p *RuleFactoryDeleteSiteRuleArgs) GetReq() (v *DeleteSiteRuleReq) {
+func (p *RuleFactorySiteRuleDryRunArgs) GetReq() (v *SiteRuleDryRunReq) {
 	if !p.IsSetReq() {
-		return RuleFactoryDeleteSiteRuleArgs_Req_DEFAULT
+		return RuleFactorySiteRuleDryRunArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactoryDeleteSiteRuleArgs = map[int16]string{
+var fieldIDToName_RuleFactorySiteRuleDryRunArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactoryDeleteSiteRuleArgs) IsSetReq() bool {
+func (p *RuleFactorySiteRuleDryRunArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactoryDeleteSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleDryRunArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6469,7 +17157,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryDeleteSiteRuleArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleDryRunArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6479,8 +17167,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryDeleteSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteSiteRuleReq()
+func (p *RuleFactorySiteRuleDryRunArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleDryRunReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6488,9 +17176,9 @@ func (p *RuleFactoryDeleteSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *RuleFactoryDeleteSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleDryRunArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteSiteRule_args"); err != nil {
+	if err = oprot.WriteStructBegin("SiteRuleDryRun_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6516,7 +17204,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryDeleteSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleDryRunArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6533,40 +17221,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactoryDeleteSiteRuleArgs) String() string {
+func (p *RuleFactorySiteRuleDryRunArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryDeleteSiteRuleArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactorySiteRuleDryRunArgs(%+v)", *p)
 
 }
 
-type RuleFactoryDeleteSiteRuleResult struct {
-	Success *DeleteSiteRuleResp `thrift:"success,0,optional"`
+type RuleFactorySiteRuleDryRunResult struct {
+	Success *SiteRuleDryRunResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactoryDeleteSiteRuleResult() *RuleFactoryDeleteSiteRuleResult {
-	return &RuleFactoryDeleteSiteRuleResult{}
+func NewRuleFactorySiteRuleDryRunResult() *RuleFactorySiteRuleDryRunResult {
+	return &RuleFactorySiteRuleDryRunResult{}
 }
 
-var RuleFactoryDeleteSiteRuleResult_Success_DEFAULT *DeleteSiteRuleResp
+var RuleFactorySiteRuleDryRunResult_Success_DEFAULT *SiteRuleDryRunResp
 
-func (p *RuleFactoryDeleteSiteRuleResult) GetSuccess() (v *DeleteSiteRuleResp) {
+func (p *RuleFactorySiteRuleDryRunResult) GetSuccess() (v *SiteRuleDryRunResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactoryDeleteSiteRuleResult_Success_DEFAULT
+		return RuleFactorySiteRuleDryRunResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactoryDeleteSiteRuleResult = map[int16]string{
+var fieldIDToName_RuleFactorySiteRuleDryRunResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactoryDeleteSiteRuleResult) IsSetSuccess() bool {
+func (p *RuleFactorySiteRuleDryRunResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactoryDeleteSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleDryRunResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6612,7 +17300,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryDeleteSiteRuleResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySiteRuleDryRunResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6622,8 +17310,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryDeleteSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteSiteRuleResp()
+func (p *RuleFactorySiteRuleDryRunResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSiteRuleDryRunResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6631,9 +17319,9 @@ func (p *RuleFactoryDeleteSiteRuleResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *RuleFactoryDeleteSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleDryRunResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteSiteRule_result"); err != nil {
+	if err = oprot.WriteStructBegin("SiteRuleDryRun_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6659,7 +17347,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryDeleteSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySiteRuleDryRunResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6678,40 +17366,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactoryDeleteSiteRuleResult) String() string {
+func (p *RuleFactorySiteRuleDryRunResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryDeleteSiteRuleResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactorySiteRuleDryRunResult(%+v)", *p)
 
 }
 
-type RuleFactoryExportSiteRulesArgs struct {
-	Req *EmptyReq `thrift:"req,1"`
+type RuleFactoryExplainSiteRuleMatchArgs struct {
+	Req *ExplainSiteRuleMatchReq `thrift:"req,1"`
 }
 
-func NewRuleFactoryExportSiteRulesArgs() *RuleFactoryExportSiteRulesArgs {
-	return &RuleFactoryExportSiteRulesArgs{}
+func NewRuleFactoryExplainSiteRuleMatchArgs() *RuleFactoryExplainSiteRuleMatchArgs {
+	return &RuleFactoryExplainSiteRuleMatchArgs{}
 }
 
-var RuleFactoryExportSiteRulesArgs_Req_DEFAULT *EmptyReq
+var RuleFactoryExplainSiteRuleMatchArgs_Req_DEFAULT *ExplainSiteRuleMatchReq
 
-func (p *RuleFactoryExportSiteRulesArgs) GetReq() (v *EmptyReq) {
+func (p *RuleFactoryExplainSiteRuleMatchArgs) GetReq() (v *ExplainSiteRuleMatchReq) {
 	if !p.IsSetReq() {
-		return RuleFactoryExportSiteRulesArgs_Req_DEFAULT
+		return RuleFactoryExplainSiteRuleMatchArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactoryExportSiteRulesArgs = map[int16]string{
+var fieldIDToName_RuleFactoryExplainSiteRuleMatchArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactoryExportSiteRulesArgs) IsSetReq() bool {
+func (p *RuleFactoryExplainSiteRuleMatchArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactoryExportSiteRulesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExplainSiteRuleMatchArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6757,7 +17445,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryExportSiteRulesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryExplainSiteRuleMatchArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6767,8 +17455,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryExportSiteRulesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewEmptyReq()
+func (p *RuleFactoryExplainSiteRuleMatchArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExplainSiteRuleMatchReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6776,9 +17464,9 @@ func (p *RuleFactoryExportSiteRulesArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *RuleFactoryExportSiteRulesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExplainSiteRuleMatchArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExportSiteRules_args"); err != nil {
+	if err = oprot.WriteStructBegin("ExplainSiteRuleMatch_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6804,7 +17492,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryExportSiteRulesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExplainSiteRuleMatchArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6821,40 +17509,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactoryExportSiteRulesArgs) String() string {
+func (p *RuleFactoryExplainSiteRuleMatchArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryExportSiteRulesArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryExplainSiteRuleMatchArgs(%+v)", *p)
 
 }
 
-type RuleFactoryExportSiteRulesResult struct {
-	Success *ExportSiteRulesResp `thrift:"success,0,optional"`
+type RuleFactoryExplainSiteRuleMatchResult struct {
+	Success *ExplainSiteRuleMatchResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactoryExportSiteRulesResult() *RuleFactoryExportSiteRulesResult {
-	return &RuleFactoryExportSiteRulesResult{}
+func NewRuleFactoryExplainSiteRuleMatchResult() *RuleFactoryExplainSiteRuleMatchResult {
+	return &RuleFactoryExplainSiteRuleMatchResult{}
 }
 
-var RuleFactoryExportSiteRulesResult_Success_DEFAULT *ExportSiteRulesResp
+var RuleFactoryExplainSiteRuleMatchResult_Success_DEFAULT *ExplainSiteRuleMatchResp
 
-func (p *RuleFactoryExportSiteRulesResult) GetSuccess() (v *ExportSiteRulesResp) {
+func (p *RuleFactoryExplainSiteRuleMatchResult) GetSuccess() (v *ExplainSiteRuleMatchResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactoryExportSiteRulesResult_Success_DEFAULT
+		return RuleFactoryExplainSiteRuleMatchResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactoryExportSiteRulesResult = map[int16]string{
+var fieldIDToName_RuleFactoryExplainSiteRuleMatchResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactoryExportSiteRulesResult) IsSetSuccess() bool {
+func (p *RuleFactoryExplainSiteRuleMatchResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactoryExportSiteRulesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExplainSiteRuleMatchResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6900,7 +17588,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryExportSiteRulesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryExplainSiteRuleMatchResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6910,8 +17598,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryExportSiteRulesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewExportSiteRulesResp()
+func (p *RuleFactoryExplainSiteRuleMatchResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExplainSiteRuleMatchResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6919,9 +17607,9 @@ func (p *RuleFactoryExportSiteRulesResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *RuleFactoryExportSiteRulesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExplainSiteRuleMatchResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExportSiteRules_result"); err != nil {
+	if err = oprot.WriteStructBegin("ExplainSiteRuleMatch_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6947,7 +17635,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryExportSiteRulesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactoryExplainSiteRuleMatchResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6966,40 +17654,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactoryExportSiteRulesResult) String() string {
+func (p *RuleFactoryExplainSiteRuleMatchResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryExportSiteRulesResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactoryExplainSiteRuleMatchResult(%+v)", *p)
 
 }
 
-type RuleFactoryImportSiteRulesArgs struct {
-	Req *EmptyReq `thrift:"req,1"`
+type RuleFactorySuggestSiteRuleArgs struct {
+	Req *SuggestSiteRuleReq `thrift:"req,1"`
 }
 
-func NewRuleFactoryImportSiteRulesArgs() *RuleFactoryImportSiteRulesArgs {
-	return &RuleFactoryImportSiteRulesArgs{}
+func NewRuleFactorySuggestSiteRuleArgs() *RuleFactorySuggestSiteRuleArgs {
+	return &RuleFactorySuggestSiteRuleArgs{}
 }
 
-var RuleFactoryImportSiteRulesArgs_Req_DEFAULT *EmptyReq
+var RuleFactorySuggestSiteRuleArgs_Req_DEFAULT *SuggestSiteRuleReq
 
-func (p *RuleFactoryImportSiteRulesArgs) GetReq() (v *EmptyReq) {
+func (p *RuleFactorySuggestSiteRuleArgs) GetReq() (v *SuggestSiteRuleReq) {
 	if !p.IsSetReq() {
-		return RuleFactoryImportSiteRulesArgs_Req_DEFAULT
+		return RuleFactorySuggestSiteRuleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RuleFactoryImportSiteRulesArgs = map[int16]string{
+var fieldIDToName_RuleFactorySuggestSiteRuleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RuleFactoryImportSiteRulesArgs) IsSetReq() bool {
+func (p *RuleFactorySuggestSiteRuleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RuleFactoryImportSiteRulesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySuggestSiteRuleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7045,7 +17733,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryImportSiteRulesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySuggestSiteRuleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7055,8 +17743,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryImportSiteRulesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewEmptyReq()
+func (p *RuleFactorySuggestSiteRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSuggestSiteRuleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7064,9 +17752,9 @@ func (p *RuleFactoryImportSiteRulesArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *RuleFactoryImportSiteRulesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySuggestSiteRuleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImportSiteRules_args"); err != nil {
+	if err = oprot.WriteStructBegin("SuggestSiteRule_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7092,7 +17780,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryImportSiteRulesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySuggestSiteRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7109,40 +17797,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RuleFactoryImportSiteRulesArgs) String() string {
+func (p *RuleFactorySuggestSiteRuleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryImportSiteRulesArgs(%+v)", *p)
+	return fmt.Sprintf("RuleFactorySuggestSiteRuleArgs(%+v)", *p)
 
 }
 
-type RuleFactoryImportSiteRulesResult struct {
-	Success *ImportSiteRulesResp `thrift:"success,0,optional"`
+type RuleFactorySuggestSiteRuleResult struct {
+	Success *SuggestSiteRuleResp `thrift:"success,0,optional"`
 }
 
-func NewRuleFactoryImportSiteRulesResult() *RuleFactoryImportSiteRulesResult {
-	return &RuleFactoryImportSiteRulesResult{}
+func NewRuleFactorySuggestSiteRuleResult() *RuleFactorySuggestSiteRuleResult {
+	return &RuleFactorySuggestSiteRuleResult{}
 }
 
-var RuleFactoryImportSiteRulesResult_Success_DEFAULT *ImportSiteRulesResp
+var RuleFactorySuggestSiteRuleResult_Success_DEFAULT *SuggestSiteRuleResp
 
-func (p *RuleFactoryImportSiteRulesResult) GetSuccess() (v *ImportSiteRulesResp) {
+func (p *RuleFactorySuggestSiteRuleResult) GetSuccess() (v *SuggestSiteRuleResp) {
 	if !p.IsSetSuccess() {
-		return RuleFactoryImportSiteRulesResult_Success_DEFAULT
+		return RuleFactorySuggestSiteRuleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RuleFactoryImportSiteRulesResult = map[int16]string{
+var fieldIDToName_RuleFactorySuggestSiteRuleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RuleFactoryImportSiteRulesResult) IsSetSuccess() bool {
+func (p *RuleFactorySuggestSiteRuleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RuleFactoryImportSiteRulesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySuggestSiteRuleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7188,7 +17876,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactoryImportSiteRulesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RuleFactorySuggestSiteRuleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7198,8 +17886,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RuleFactoryImportSiteRulesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewImportSiteRulesResp()
+func (p *RuleFactorySuggestSiteRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSuggestSiteRuleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7207,9 +17895,9 @@ func (p *RuleFactoryImportSiteRulesResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *RuleFactoryImportSiteRulesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySuggestSiteRuleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImportSiteRules_result"); err != nil {
+	if err = oprot.WriteStructBegin("SuggestSiteRule_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7235,7 +17923,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RuleFactoryImportSiteRulesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RuleFactorySuggestSiteRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7254,10 +17942,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RuleFactoryImportSiteRulesResult) String() string {
+func (p *RuleFactorySuggestSiteRuleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RuleFactoryImportSiteRulesResult(%+v)", *p)
+	return fmt.Sprintf("RuleFactorySuggestSiteRuleResult(%+v)", *p)
 
 }
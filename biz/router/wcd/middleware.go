@@ -42,3 +42,23 @@ func _segmentMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _base_parseMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _baseparsebatchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getparsejobMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _submitparsejobMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -18,6 +18,12 @@ func Register(r *server.Hertz) {
 
 	root := r.Group("/", rootMw()...)
 	root.POST("/base-parse", append(_baseparseMw(), wcd.BaseParse)...)
+	{
+		_base_parse := root.Group("/base-parse", _base_parseMw()...)
+		_base_parse.POST("/batch", append(_baseparsebatchMw(), wcd.BaseParseBatch)...)
+		_base_parse.GET("/job", append(_getparsejobMw(), wcd.GetParseJob)...)
+		_base_parse.POST("/job", append(_submitparsejobMw(), wcd.SubmitParseJob)...)
+	}
 	{
 		_wcd := root.Group("/wcd", _wcdMw()...)
 		_wcd.POST("/distill", append(_distillMw(), wcd.Distill)...)
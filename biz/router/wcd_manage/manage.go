@@ -28,12 +28,25 @@ func Register(r *server.Hertz) {
 				_site_rule.GET("/export", append(_exportsiterulesMw(), wcd_manage.ExportSiteRules)...)
 				_site_rule.POST("/import", append(_importsiterulesMw(), wcd_manage.ImportSiteRules)...)
 				_site_rule.POST("/list", append(_siterulelistMw(), wcd_manage.SiteRuleList)...)
+				_site_rule.POST("/suggest", append(_suggestsiteruleMw(), wcd_manage.SuggestSiteRule)...)
+				_site_rule.POST("/validate", append(_validatesiteruleMw(), wcd_manage.ValidateSiteRule)...)
+				{
+					_match := _site_rule.Group("/match", _matchMw()...)
+					_match.POST("/explain", append(_explainsiterulematchMw(), wcd_manage.ExplainSiteRuleMatch)...)
+				}
 				{
 					_prod := _site_rule.Group("/prod", _prodMw()...)
+					_prod.POST("/rollback", append(_rollbacksiteruleMw(), wcd_manage.RollbackSiteRule)...)
 					_prod.POST("/to_test", append(_createsiterulefromprodMw(), wcd_manage.CreateSiteRuleFromProd)...)
 				}
+				{
+					_revision := _site_rule.Group("/revision", _revisionMw()...)
+					_revision.POST("/diff", append(_siterulerevisiondiffMw(), wcd_manage.SiteRuleRevisionDiff)...)
+					_revision.POST("/list", append(_siterulerevisionlistMw(), wcd_manage.SiteRuleRevisionList)...)
+				}
 				{
 					_testing := _site_rule.Group("/testing", _testingMw()...)
+					_testing.POST("/dry_run", append(_siteruledryrunMw(), wcd_manage.SiteRuleDryRun)...)
 					_testing.POST("/new", append(_createsiteruleMw(), wcd_manage.CreateSiteRule)...)
 					_testing.POST("/publish", append(_publishsiteruleMw(), wcd_manage.PublishSiteRule)...)
 					_testing.POST("/update", append(_updatesiteruleMw(), wcd_manage.UpdateSiteRule)...)
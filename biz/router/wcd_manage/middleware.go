@@ -96,3 +96,48 @@ func _updatesiteruleMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _rollbacksiteruleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revisionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _siterulerevisiondiffMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _siterulerevisionlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _siteruledryrunMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _validatesiteruleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _matchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _explainsiterulematchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _suggestsiteruleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -17,19 +17,157 @@ type Config struct {
 	Server          Server         `yaml:"server"`
 	ApiDomain       ApiDomain      `yaml:"api_domain"`
 	Parse           Parse          `yaml:"parse"`
+	Store           Store          `yaml:"store"`
+}
+
+// Store 规则和网页缓存的存储方式，不配置时都使用mongo
+type Store struct {
+	Rule        RuleStore   `yaml:"rule"`
+	HtmlCache   HtmlCache   `yaml:"html_cache"`
+	ResultCache HtmlCache   `yaml:"result_cache"` // 解析结果缓存，配置项与网页缓存相同
+	CrawlImage  HtmlCache   `yaml:"crawl_image"`  // 图片原地址到镜像地址的映射，配置项与网页缓存相同
+	Object      ObjectStore `yaml:"object"`       // 镜像图片等文件的存储
+}
+
+type ObjectStore struct {
+	Type    string `yaml:"type"`     // local，为空时为local
+	Dir     string `yaml:"dir"`      // local：保存的目录，相对路径基于项目目录，为空时为static/mirror
+	BaseUrl string `yaml:"base_url"` // local：访问地址的前缀，为空时为/public/mirror
+}
+
+type RuleStore struct {
+	Type     string `yaml:"type"`      // mongo / memory / file
+	Files    string `yaml:"files"`     // file：启动时加载的规则文件，支持通配符，为空时使用data/rules_*.json
+	SaveFile string `yaml:"save_file"` // file：规则修改后整体写入该文件，文件存在时启动只加载它；为空则修改只保存在内存中
+	Fallback string `yaml:"fallback"`  // mongo：连接失败时使用的存储，只支持memory；为空时启动失败
+}
+
+type HtmlCache struct {
+	Type       string `yaml:"type"`        // mongo / memory / none
+	MaxEntries int    `yaml:"max_entries"` // memory：最多缓存的网页数，超过后淘汰最久未使用的
+	Fallback   string `yaml:"fallback"`    // mongo：连接失败时使用的存储，只支持memory；为空时启动失败
 }
 
 type Parse struct {
 	Label Label `yaml:"label"`
 	Crawl Crawl `yaml:"crawl"`
+	Rule  Rule  `yaml:"rule"`
+	Batch Batch `yaml:"batch"`
+	Job   Job   `yaml:"job"`
+	Cache Cache `yaml:"cache"`
+
+	ImageMirror ImageMirror `yaml:"image_mirror"`
+	ImageFilter ImageFilter `yaml:"image_filter"`
+}
+
+// ImageFilter 去掉正文中的跟踪像素、占位图和表情等装饰性图片
+type ImageFilter struct {
+	MinWidth            int  `yaml:"min_width"`             // 已知的宽高都小于下限时去掉，为0时为32
+	MinHeight           int  `yaml:"min_height"`            // 为0时为32
+	Probe               bool `yaml:"probe"`                 // 标签中没有宽高时，下载图片头部获取
+	ProbeWorkerNum      int  `yaml:"probe_worker_num"`      // 同时探测的图片数
+	ProbeTimeoutSeconds int  `yaml:"probe_timeout_seconds"` // 单张图片的探测超时
+}
+
+// ImageMirror 解析后下载正文图片保存到对象存储，结果中的图片地址替换为镜像地址
+type ImageMirror struct {
+	Enabled        bool  `yaml:"enabled"`         // 请求中的mirror_images可覆盖
+	WorkerNum      int   `yaml:"worker_num"`      // 同时下载的图片数
+	TimeoutSeconds int   `yaml:"timeout_seconds"` // 单张图片的下载超时
+	MaxBytes       int64 `yaml:"max_bytes"`       // 单张图片的大小上限
 }
 
 type Label struct {
-	UseMock bool `yaml:"use_mock"`
+	UseMock  bool            `yaml:"use_mock"` // 未配置default时，为true则默认使用mock标注器，否则使用remote
+	Default  string          `yaml:"default"`  // 默认标注器名称
+	Fallback string          `yaml:"fallback"` // 远程标注超时后降级使用的标注器名称，为空则不降级
+	Labelers []LabelerConfig `yaml:"labelers"` // 额外注册的标注器，可覆盖内置的同名标注器
+}
+
+type LabelerConfig struct {
+	Name           string `yaml:"name"`
+	Type           string `yaml:"type"`            // mock / remote / heuristic / replay
+	Host           string `yaml:"host"`            // remote：为空时使用api_domain.ai_api
+	Path           string `yaml:"path"`            // remote：为空时使用parser-v2/text
+	TimeoutSeconds int    `yaml:"timeout_seconds"` // remote：为空时使用默认超时
+	ReplayFile     string `yaml:"replay_file"`     // replay：jsonl文件，每行包含url和model_result_str
+	Version        string `yaml:"version"`         // remote：模型的版本，模型更新后修改，使缓存的解析结果失效
 }
 
 type Crawl struct {
-	HtmlCacheHours int `yaml:"html_cache_hours"`
+	HtmlCacheHours      int             `yaml:"html_cache_hours"`
+	HtmlRevalidateHours int             `yaml:"html_revalidate_hours"` // 缓存过期后仍在该时间内的网页发送条件请求，未变化时继续使用。为空时不发送
+	Backend             string          `yaml:"backend"`               // remote：外部抓取服务；native：进程内直接请求。为空时使用remote
+	Native              NativeCrawl     `yaml:"native"`
+	Backends            []CrawlerConfig `yaml:"backends"` // 额外注册的抓取后端，可覆盖内置的remote/browser/native
+	Chain               []string        `yaml:"chain"`    // 未命中缓存时依次尝试的抓取后端，为空时为[backend, browser]
+	Retry               CrawlRetry      `yaml:"retry"`
+	RateLimit           CrawlRateLimit  `yaml:"rate_limit"`
+	Robots              RobotsPolicy    `yaml:"robots"`
+}
+
+// RobotsPolicy 遵守robots.txt和meta robots，请求中的respect_robots可覆盖enabled
+type RobotsPolicy struct {
+	Enabled        bool   `yaml:"enabled"`
+	UserAgent      string `yaml:"user_agent"`      // 匹配robots.txt和meta标签的名称，为空时为wcd
+	CacheMinutes   int    `yaml:"cache_minutes"`   // robots.txt的缓存时间，为空时为24小时
+	TimeoutSeconds int    `yaml:"timeout_seconds"` // 获取robots.txt的超时，为空时为10秒
+}
+
+// CrawlRateLimit 按host限制抓取频率和并发，站点规则中可单独配置qps和并发数
+type CrawlRateLimit struct {
+	Qps         float64 `yaml:"qps"`           // 每个host每秒的抓取次数，为空时不限制
+	Burst       int     `yaml:"burst"`         // 允许的突发次数，为空时为1
+	MaxInFlight int     `yaml:"max_in_flight"` // 每个host同时进行的抓取数，为空时不限制
+	MaxWaitMs   int     `yaml:"max_wait_ms"`   // 超过限制时排队等待的上限，为空时立即失败
+}
+
+type CrawlerConfig struct {
+	Name           string      `yaml:"name"`
+	Type           string      `yaml:"type"`            // remote / native
+	Host           string      `yaml:"host"`            // remote：为空时使用api_domain.crawler_api
+	Browser        bool        `yaml:"browser"`         // remote：使用浏览器渲染
+	TimeoutSeconds int         `yaml:"timeout_seconds"` // remote：为空时使用默认超时
+	Native         NativeCrawl `yaml:"native"`          // native：抓取配置
+}
+
+// CrawlRetry 单个抓取后端遇到超时、连接失败、5xx等临时错误时的重试
+type CrawlRetry struct {
+	MaxAttempts  int `yaml:"max_attempts"`   // 包括第一次在内的最大尝试次数，为空时不重试
+	BackoffMs    int `yaml:"backoff_ms"`     // 第一次重试前的等待时间，之后每次翻倍
+	MaxBackoffMs int `yaml:"max_backoff_ms"` // 单次等待时间上限
+}
+
+type NativeCrawl struct {
+	UserAgent      string `yaml:"user_agent"`      // 为空时使用内置的浏览器UA
+	MaxBodyBytes   int64  `yaml:"max_body_bytes"`  // 网页大小上限，超过时抓取失败
+	TimeoutSeconds int    `yaml:"timeout_seconds"` // 包括重定向在内的总超时
+	MaxRedirects   int    `yaml:"max_redirects"`
+}
+
+type Batch struct {
+	MaxItems  int `yaml:"max_items"`  // 单次批量解析的最大条数
+	WorkerNum int `yaml:"worker_num"` // 批量解析的并发数
+}
+
+type Job struct {
+	WorkerNum              int `yaml:"worker_num"`               // 异步解析任务的并发数，小于0时本实例不执行任务
+	PollSeconds            int `yaml:"poll_seconds"`             // 没有新提交的任务时，轮询任务表的间隔
+	LeaseSeconds           int `yaml:"lease_seconds"`            // 单个任务的执行时限，超时未结束的任务会被重新执行
+	MaxAttempts            int `yaml:"max_attempts"`             // 单个任务的最大执行次数
+	CallbackRetries        int `yaml:"callback_retries"`         // 回调失败后的重试次数
+	CallbackTimeoutSeconds int `yaml:"callback_timeout_seconds"` // 单次回调的超时时间
+}
+
+// Cache 网页缓存和解析结果缓存的读写方式。请求中的skip_cache、reparse只跳过读取
+type Cache struct {
+	Mode        string `yaml:"mode"`         // read_write / read_only / bypass / refresh，为空时为read_write
+	ResultHours int    `yaml:"result_hours"` // 解析结果的缓存时间，为0时不缓存解析结果
+	Version     string `yaml:"version"`      // 解析逻辑变化后修改，使缓存的解析结果失效
+}
+
+type Rule struct {
+	IndexPollSeconds int `yaml:"index_poll_seconds"` // 规则表无法监听变更时，轮询刷新规则索引的间隔
 }
 
 type ApiDomain struct {
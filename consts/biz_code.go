@@ -1,5 +1,7 @@
 package consts
 
+import "fmt"
+
 type BizCode struct {
 	// 错误码
 	Code int32
@@ -13,9 +15,21 @@ var (
 	CrawlFailed     = BizCode{0, "抓取失败"}
 	TextParseFailed = BizCode{10204, "text-parse解析失败"}
 
-	ReqParamError = BizCode{10400, "参数错误"}
+	ReqParamError              = BizCode{10400, "参数错误"}
+	SentencePositionIdNotFound = BizCode{10401, "句子的position_id在html中不存在"}
+	RobotsDisallowed           = BizCode{10403, "网页禁止抓取"}
+	ParseJobNotFound           = BizCode{10404, "解析任务不存在"}
+	CrawlRateLimited           = BizCode{10429, "站点抓取过于频繁，请稍后重试"}
 
 	SystemErr      = BizCode{10500, "服务繁忙，请稍后重试"}
 	QueryDataError = BizCode{10501, "数据查询异常"}
 	WriteDbError   = BizCode{10502, "数据写入异常"}
 )
+
+// WithDetail 在错误描述后追加具体信息，返回新的错误码，不修改原值
+func (b BizCode) WithDetail(format string, args ...any) *BizCode {
+	return &BizCode{
+		Code: b.Code,
+		Msg:  fmt.Sprintf("%s: %s", b.Msg, fmt.Sprintf(format, args...)),
+	}
+}
@@ -15,6 +15,27 @@ const (
 	RuleStageTesting RuleStage = 0
 	RuleStageProd    RuleStage = 1
 )
+
+// 规则版本记录的变更类型
+const (
+	RuleAction_Update   = "update"
+	RuleAction_Publish  = "publish"
+	RuleAction_Delete   = "delete"
+	RuleAction_Rollback = "rollback"
+	RuleAction_Import   = "import"
+)
+
+// 站点规则的host匹配方式
+const (
+	HostMatch_Legacy = ""       // 旧规则：host中带*、+时按正则匹配，否则按前缀匹配
+	HostMatch_Exact  = "exact"  // host完全相同
+	HostMatch_Suffix = "suffix" // host相同或是其子域名
+	HostMatch_Glob   = "glob"   // *匹配一级域名中的任意字符，?匹配一个字符
+	HostMatch_Regex  = "regex"  // 整个host匹配正则
+)
+
+var HostMatchTypes = []string{HostMatch_Legacy, HostMatch_Exact, HostMatch_Suffix, HostMatch_Glob, HostMatch_Regex}
+
 const (
 	WorthType_Valueable = 1
 	WorthType_404       = 2
@@ -26,6 +47,35 @@ const (
 	TextParseReadTimeOut = 120 * time.Second
 )
 
+// 解析结果除text和readable_html之外可选的输出格式
+const (
+	OutputFormat_Markdown = "markdown"
+	OutputFormat_Blocks   = "blocks"
+)
+
+var OUTPUT_FORMATS = []string{OutputFormat_Markdown, OutputFormat_Blocks}
+
+// 网页缓存和解析结果缓存的读写方式
+const (
+	CacheMode_ReadWrite = "read_write" // 读取并写入
+	CacheMode_ReadOnly  = "read_only"  // 只读取，不写入
+	CacheMode_Bypass    = "bypass"     // 不读取也不写入
+	CacheMode_Refresh   = "refresh"    // 不读取，用新的结果覆盖
+)
+
+// 结构化正文的块类型
+const (
+	BlockType_Heading   = "heading"
+	BlockType_Paragraph = "paragraph"
+	BlockType_Image     = "image"
+	BlockType_Table     = "table"
+	BlockType_List      = "list"
+	BlockType_Code      = "code"
+	BlockType_Quote     = "quote"
+	BlockType_Reference = "reference"
+	BlockType_Caption   = "caption"
+)
+
 const (
 	ActionType_Request  = "req"
 	ActionType_Response = "resp"
@@ -33,3 +83,10 @@ const (
 	ActionCrawlHtml = "crawl-html"
 	ActionTextParse = "text-parse"
 )
+
+// 规范化url时去掉的跟踪参数，以TRACKING_QUERY_PREFIXES开头的参数也去掉
+var TRACKING_QUERY_PARAMS = []string{
+	"gclid", "gclsrc", "dclid", "fbclid", "msclkid", "yclid", "twclid", "igshid",
+	"mc_cid", "mc_eid", "_hsenc", "_hsmi", "mkt_tok", "spm", "scm",
+}
+var TRACKING_QUERY_PREFIXES = []string{"utm_"}
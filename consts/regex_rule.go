@@ -11,6 +11,8 @@ var (
 	RegexRule_NoiseAttr         = regexp.MustCompile("data-ad")
 	RegexRule_NegativeImg       = regexp.MustCompile("avatar|logo|author|title|标题|weibo|wechat|weixin|icon|公众号|更多|关注|landing|loading")
 	RegexRule_NegativeAvatarImg = regexp.MustCompile("avatar|author")
+	// 跟踪像素、占位图和表情，按图片链接匹配
+	RegexRule_DecorativeImg = regexp.MustCompile(`(?i)emoji|emoticon|spacer|blank\.gif|transparent\.(gif|png)|pixel\.(gif|png)|1x1|beacon|/track(ing)?/|/pixel\?`)
 	//RegexRule_NegativeLink      = regexp.MustCompile("更多|more|详细|关注|aboutus|公众号|wechat|weibo")
 	RegexRule_NegativeLink = regexp.MustCompile("更多|详细|关注|aboutus|公众号|wechat|weibo")
 
@@ -18,6 +20,11 @@ var (
 	RegexRule_AuthroDescription = regexp.MustCompile(`var profile_signature = "(.*?)"`)
 	RegexRule_AuthorId          = regexp.MustCompile(`var biz = "(.*?)"`)
 	RegexRule_AuthroName        = regexp.MustCompile(`window.name = "(.*?)"`)
+
+	// 本地启发式标注使用
+	RegexRule_ReferenceHeading = regexp.MustCompile(`(?i)^\s*(参考文献|参考资料|参考链接|引用|references?|bibliography|works cited)\s*[:：]?\s*$`)
+	RegexRule_SourcePrefix     = regexp.MustCompile(`(?i)^\s*(来源|出处|转自|source)\s*[:：]`)
+	RegexRule_LegendPrefix     = regexp.MustCompile(`(?i)^\s*(图|表|fig\.?|figure|table)\s*\d+`)
 )
 
 var CANT_DEL_TAGS = []string{
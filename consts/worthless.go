@@ -42,3 +42,11 @@ var WORTHLESS_PAGE_KEYWORDS = [][]string{
 	{"输入", "错误", "重新"},
 	// {"暂", "无"},
 }
+
+// WorthTypeOfStatus 按网页的http状态码判断，4xx和5xx视为404；状态码为0（未知）和2xx、3xx时不判断
+func WorthTypeOfStatus(statusCode int) int {
+	if statusCode >= 400 {
+		return WorthType_404
+	}
+	return WorthType_Valueable
+}
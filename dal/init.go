@@ -2,11 +2,24 @@ package dal
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/object"
+	"github.com/DeepLangAI/wcd/dal/rule_index"
+	"github.com/DeepLangAI/wcd/dal/store"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
 func Init() {
 	ctx := context.Background()
-	mongo.Init(ctx)
+	if err := mongo.Init(ctx); err != nil {
+		// 规则和网页缓存配置为不依赖mongo或允许退化为内存时仍可启动，异步解析任务等功能不可用
+		hlog.CtxWarnf(ctx, "mongo unavailable: %v", err)
+	}
+	if err := store.Init(ctx); err != nil {
+		panic(fmt.Sprintf("初始化存储失败：%s", err))
+	}
+	object.Init(ctx)
+	rule_index.Init(ctx)
 }
@@ -15,11 +15,27 @@ import (
 
 const TableNameCrawlHtml = "crawl_html"
 
+// 已有同名或同key的索引但选项不同
+const (
+	indexOptionsConflict  = 85
+	indexKeySpecsConflict = 86
+)
+
 type CrawlHtmlModel struct {
-	Url  string `bson:"url"`
-	Html string `bson:"html"`
+	Url     string   `bson:"url"`               // 规范化后的url，有rel=canonical时为canonical地址
+	Aliases []string `bson:"aliases,omitempty"` // 指向同一网页的其他规范化url，如请求的url、跳转后的url
+	Html    string   `bson:"html"`
+
+	FinalUrl     string            `bson:"final_url,omitempty"`   // 重定向后的最终url
+	StatusCode   int               `bson:"status_code,omitempty"` // 网页的http状态码
+	Headers      map[string]string `bson:"headers,omitempty"`     // 网页的响应头
+	ContentType  string            `bson:"content_type,omitempty"`
+	FetchTime    time.Time         `bson:"fetch_time,omitempty"` // 抓取时间，旧数据为空
+	ETag         string            `bson:"etag,omitempty"`
+	LastModified string            `bson:"last_modified,omitempty"`
+	ContentHash  string            `bson:"content_hash,omitempty"` // html的md5
 
-	CreateTime time.Time       `bson:"create_time"`
+	CreateTime time.Time       `bson:"create_time"` // 缓存时间，每次覆盖时更新，超过ttl后由mongo删除
 	UpdateTime time.Time       `bson:"update_time"`
 	Status     consts.DbStatus `bson:"status"`
 }
@@ -38,21 +54,70 @@ func (c *crawlHtmlModelDal) checkLegal(model CrawlHtmlModel) (legal bool, msg st
 	return true, ""
 }
 
+// CreateIndexes 按url、别名和缓存时间查询；缓存时间超过ttl的网页由mongo自动删除，ttl为0时不创建ttl索引
+func (c *crawlHtmlModelDal) CreateIndexes(ctx context.Context, ttl time.Duration) error {
+	indexes := wcdDb.Collection(TableNameCrawlHtml).Indexes()
+	_, err := indexes.CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "url", Value: 1}, {Key: "create_time", Value: -1}}},
+		{Keys: bson.D{{Key: "aliases", Value: 1}}},
+	})
+	if err != nil || ttl <= 0 {
+		return err
+	}
+	ttlKeys := bson.D{{Key: "create_time", Value: 1}}
+	seconds := int32(ttl.Seconds())
+	_, err = indexes.CreateOne(ctx, mongo.IndexModel{
+		Keys:    ttlKeys,
+		Options: options.Index().SetExpireAfterSeconds(seconds),
+	})
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && (cmdErr.Code == indexOptionsConflict || cmdErr.Code == indexKeySpecsConflict) {
+		// 缓存时间的配置变化后，修改已有的ttl
+		hlog.CtxInfof(ctx, "crawl html ttl changed, update index, ttl: %v", ttl)
+		return wcdDb.RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: TableNameCrawlHtml},
+			{Key: "index", Value: bson.D{
+				{Key: "keyPattern", Value: ttlKeys},
+				{Key: "expireAfterSeconds", Value: seconds},
+			}},
+		}).Err()
+	}
+	return err
+}
+
+// SaveOne 按url覆盖，别名合并到已有的别名中
 func (c *crawlHtmlModelDal) SaveOne(ctx context.Context, model CrawlHtmlModel) error {
 	if legal, msg := c.checkLegal(model); !legal {
 		hlog.CtxErrorf(ctx, "before save one, check model illegal: %s", msg)
 		return errors.New(msg)
 	}
-	one, err := wcdDb.Collection(TableNameCrawlHtml).InsertOne(ctx, model)
-	if err != nil {
-		return err
+	aliases := model.Aliases
+	if aliases == nil {
+		aliases = []string{}
 	}
-	if one.InsertedID == nil {
-		return err
+	filter := bson.D{{Key: "url", Value: model.Url}}
+	update := bson.M{
+		"$set": bson.M{
+			"html":          model.Html,
+			"final_url":     model.FinalUrl,
+			"status_code":   model.StatusCode,
+			"headers":       model.Headers,
+			"content_type":  model.ContentType,
+			"fetch_time":    model.FetchTime,
+			"etag":          model.ETag,
+			"last_modified": model.LastModified,
+			"content_hash":  model.ContentHash,
+			"create_time":   model.CreateTime,
+			"update_time":   model.UpdateTime,
+			"status":        model.Status,
+		},
+		"$addToSet": bson.M{"aliases": bson.M{"$each": aliases}},
 	}
+	_, err := wcdDb.Collection(TableNameCrawlHtml).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
 	return err
 }
 
+// FindByUrl url为规范化后的url，按url或别名查询
 func (c *crawlHtmlModelDal) FindByUrl(ctx context.Context, url string, expireDuration time.Duration) (*CrawlHtmlModel, error) {
 	hlog.CtxInfof(ctx, "trying to find cached html by url: %s", url)
 	findOptions := options.FindOne()
@@ -60,9 +125,12 @@ func (c *crawlHtmlModelDal) FindByUrl(ctx context.Context, url string, expireDur
 
 	filter := bson.D{
 		{Key: "status", Value: consts.StatusValid},
-		{Key: "url", Value: url},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "url", Value: url}},
+			bson.D{{Key: "aliases", Value: url}},
+		}},
 		{Key: "create_time", Value: bson.M{
-			"$gte": time.Now().Add(-1 * expireDuration), // 有时效性，只查询最近24小时的数据
+			"$gte": time.Now().Add(-1 * expireDuration), // 有时效性，只查询最近的数据
 		}},
 	}
 	one := wcdDb.Collection(TableNameCrawlHtml).FindOne(ctx, filter, findOptions)
@@ -81,3 +149,26 @@ func (c *crawlHtmlModelDal) FindByUrl(ctx context.Context, url string, expireDur
 	hlog.CtxInfof(ctx, "find cached html by url: %s", url)
 	return model, nil
 }
+
+// ListByUrlRegex 按缓存时间从新到旧，返回url匹配正则的网页
+func (c *crawlHtmlModelDal) ListByUrlRegex(ctx context.Context, pattern string, limit int) ([]*CrawlHtmlModel, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	filter := bson.D{
+		{Key: "status", Value: consts.StatusValid},
+		{Key: "url", Value: bson.M{"$regex": pattern}},
+	}
+	cursor, err := wcdDb.Collection(TableNameCrawlHtml).Find(ctx, filter, opts)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "find cached html by url regex error: %v", err)
+		return nil, err
+	}
+	models := []*CrawlHtmlModel{}
+	if err := cursor.All(ctx, &models); err != nil {
+		hlog.CtxErrorf(ctx, "decode cached html error: %v", err)
+		return nil, err
+	}
+	return models, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const TableNameCrawlImage = "crawl_image"
@@ -17,6 +18,9 @@ const TableNameCrawlImage = "crawl_image"
 type CrawlImageModel struct {
 	SrcImgUrl string `bson:"src_img_url"`
 	OssImgUrl string `bson:"oss_img_url"`
+	Width     int32  `bson:"width,omitempty"` // 从图片内容读取，未知格式时为0
+	Height    int32  `bson:"height,omitempty"`
+	MimeType  string `bson:"mime_type,omitempty"`
 
 	CreateTime time.Time       `bson:"create_time"`
 	UpdateTime time.Time       `bson:"update_time"`
@@ -37,6 +41,15 @@ func (c *crawlImageModelDal) checkLegal(model CrawlImageModel) (legal bool, msg
 	return true, ""
 }
 
+// CreateIndexes 按src_img_url查询和更新
+func (c *crawlImageModelDal) CreateIndexes(ctx context.Context) error {
+	_, err := wcdDb.Collection(TableNameCrawlImage).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "src_img_url", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
 func (c *crawlImageModelDal) SaveOne(ctx context.Context, model CrawlImageModel) error {
 	if legal, msg := c.checkLegal(model); !legal {
 		hlog.CtxErrorf(ctx, "before save one, check model illegal: %s", msg)
@@ -75,6 +88,9 @@ func (c *crawlImageModelDal) UpsertMany(ctx context.Context, models []CrawlImage
 		update := bson.M{
 			"$set": bson.M{
 				"oss_img_url": model.OssImgUrl,
+				"width":       model.Width,
+				"height":      model.Height,
+				"mime_type":   model.MimeType,
 				"update_time": now,
 				"status":      model.Status,
 			},
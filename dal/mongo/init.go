@@ -2,33 +2,49 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/DeepLangAI/wcd/conf"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+const connectTimeout = 10 * time.Second
+
 var (
 	wcdDb *mongo.Database
 )
 
-func initParseDb(ctx context.Context) {
+func initParseDb(ctx context.Context) error {
 	mongoConfig := conf.GetConfig().Mongo
+	if mongoConfig == nil || mongoConfig.Addr == "" {
+		return errors.New("mongo addr is empty")
+	}
 	clientOptions := options.Client().ApplyURI(mongoConfig.Addr)
 
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
-		panic(fmt.Sprintf("initialize mongodb Connect failed, err: %v", err))
+		return fmt.Errorf("initialize mongodb Connect failed, err: %w", err)
 	}
 
 	err = client.Ping(ctx, nil)
 	if err != nil {
-		panic(fmt.Sprintf("initialize mongodb Ping failed, err: %v", err))
+		return fmt.Errorf("initialize mongodb Ping failed, err: %w", err)
 	}
 	wcdDb = client.Database(mongoConfig.DbName)
+	return nil
+}
+
+// Init 连接mongo，失败时返回错误，依赖mongo的功能不可用
+func Init(ctx context.Context) error {
+	return initParseDb(ctx)
 }
 
-func Init(ctx context.Context) {
-	initParseDb(ctx)
+// Ready mongo是否已连接
+func Ready() bool {
+	return wcdDb != nil
 }
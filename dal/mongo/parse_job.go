@@ -0,0 +1,213 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/consts"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const TableNameParseJob = "parse_job"
+
+type ParseJobModel struct {
+	JobId       string `bson:"job_id"`
+	Url         string `bson:"url"`
+	Req         string `bson:"req"`          // BaseParseReq的json
+	CallbackUrl string `bson:"callback_url"` // 为空则不回调
+
+	JobStatus  wcd.ParseJobStatus `bson:"job_status"`
+	Attempts   int                `bson:"attempts"`    // 已执行次数
+	LeaseUntil time.Time          `bson:"lease_until"` // 执行中的任务超过该时间仍未结束，视为执行实例已退出
+	Result     string             `bson:"result"`      // WcdParseResp的json
+	FinishTime time.Time          `bson:"finish_time"`
+
+	CallbackStatus   wcd.ParseJobCallbackStatus `bson:"callback_status"`
+	CallbackAttempts int                        `bson:"callback_attempts"`
+	CallbackError    string                     `bson:"callback_error"`     // 最后一次回调失败的原因
+	NextCallbackTime time.Time                  `bson:"next_callback_time"` // 待回调的任务到该时间后才会被领取
+
+	CreateTime time.Time       `bson:"create_time"`
+	UpdateTime time.Time       `bson:"update_time"`
+	Status     consts.DbStatus `bson:"status"`
+}
+
+func (p *ParseJobModel) ToThrift() *wcd.ParseJobResp {
+	resp := &wcd.ParseJobResp{
+		Code:             consts.ResSuccess.Code,
+		Msg:              consts.ResSuccess.Msg,
+		JobID:            p.JobId,
+		URL:              p.Url,
+		Status:           p.JobStatus,
+		CallbackStatus:   p.CallbackStatus,
+		CallbackAttempts: int32(p.CallbackAttempts),
+		CreateTime:       p.CreateTime.Local().Format(time.DateTime),
+		UpdateTime:       p.UpdateTime.Local().Format(time.DateTime),
+	}
+	if !p.FinishTime.IsZero() {
+		resp.FinishTime = p.FinishTime.Local().Format(time.DateTime)
+	}
+	return resp
+}
+
+var ParseJobModelDal *parseJobModelDal
+
+type parseJobModelDal struct{}
+
+func (p *parseJobModelDal) checkLegal(model ParseJobModel) (legal bool, msg string) {
+	if model.JobId == "" {
+		return false, "job id is empty"
+	}
+	if model.Req == "" {
+		return false, "req is empty"
+	}
+	return true, ""
+}
+
+// CreateIndexes 按job_id查询任务，按状态和创建时间领取任务，按回调状态和时间领取回调
+func (p *parseJobModelDal) CreateIndexes(ctx context.Context) error {
+	_, err := wcdDb.Collection(TableNameParseJob).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "job_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "job_status", Value: 1}, {Key: "create_time", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "callback_status", Value: 1}, {Key: "next_callback_time", Value: 1}},
+		},
+	})
+	return err
+}
+
+func (p *parseJobModelDal) SaveOne(ctx context.Context, model ParseJobModel) error {
+	if legal, msg := p.checkLegal(model); !legal {
+		hlog.CtxErrorf(ctx, "before save one, check model illegal: %s", msg)
+		return errors.New(msg)
+	}
+	_, err := wcdDb.Collection(TableNameParseJob).InsertOne(ctx, model)
+	return err
+}
+
+func (p *parseJobModelDal) FindByJobId(ctx context.Context, jobId string) (*ParseJobModel, error) {
+	filter := bson.D{
+		{Key: "status", Value: consts.StatusValid},
+		{Key: "job_id", Value: jobId},
+	}
+	var model *ParseJobModel
+	if err := wcdDb.Collection(TableNameParseJob).FindOne(ctx, filter).Decode(&model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// ClaimOne 领取最早提交的待执行任务，或租约已过期的执行中任务；没有任务时返回nil
+func (p *parseJobModelDal) ClaimOne(ctx context.Context, lease time.Duration) (*ParseJobModel, error) {
+	now := time.Now()
+	filter := bson.M{
+		"status": consts.StatusValid,
+		"$or": bson.A{
+			bson.M{"job_status": wcd.ParseJobStatus_Pending},
+			bson.M{"job_status": wcd.ParseJobStatus_Running, "lease_until": bson.M{"$lt": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"job_status":  wcd.ParseJobStatus_Running,
+			"lease_until": now.Add(lease),
+			"update_time": now,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "create_time", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var model *ParseJobModel
+	err := wcdDb.Collection(TableNameParseJob).FindOneAndUpdate(ctx, filter, update, opts).Decode(&model)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// Finish 记录任务结果，只更新本次领取的任务：租约过期后任务可能已被其他实例重新领取，此时返回false，结果以其他实例为准。
+// 待回调的任务从现在开始可以被领取回调
+func (p *parseJobModelDal) Finish(ctx context.Context, model *ParseJobModel, jobStatus wcd.ParseJobStatus, result string) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"job_id":     model.JobId,
+		"attempts":   model.Attempts,
+		"job_status": wcd.ParseJobStatus_Running,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"job_status":         jobStatus,
+			"result":             result,
+			"finish_time":        now,
+			"next_callback_time": now,
+			"update_time":        now,
+		},
+	}
+	res, err := wcdDb.Collection(TableNameParseJob).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+// ClaimCallback 领取最早到期的待回调任务，并把下次回调时间推迟lease，回调过程中实例退出时租约过期后会被重新领取；没有任务时返回nil
+func (p *parseJobModelDal) ClaimCallback(ctx context.Context, lease time.Duration) (*ParseJobModel, error) {
+	now := time.Now()
+	filter := bson.M{
+		"status":             consts.StatusValid,
+		"callback_status":    wcd.ParseJobCallbackStatus_Pending,
+		"job_status":         bson.M{"$in": bson.A{wcd.ParseJobStatus_Succeeded, wcd.ParseJobStatus_Failed}},
+		"next_callback_time": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"next_callback_time": now.Add(lease),
+			"update_time":        now,
+		},
+		"$inc": bson.M{"callback_attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_callback_time", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var model *ParseJobModel
+	err := wcdDb.Collection(TableNameParseJob).FindOneAndUpdate(ctx, filter, update, opts).Decode(&model)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// UpdateCallback 记录本次领取的回调结果，仍待重试时nextCallbackTime为下次回调时间
+func (p *parseJobModelDal) UpdateCallback(ctx context.Context, jobId string, attempts int, callbackStatus wcd.ParseJobCallbackStatus, callbackErr string, nextCallbackTime time.Time) error {
+	filter := bson.M{"job_id": jobId, "callback_attempts": attempts}
+	update := bson.M{
+		"$set": bson.M{
+			"callback_status":    callbackStatus,
+			"callback_error":     callbackErr,
+			"next_callback_time": nextCallbackTime,
+			"update_time":        time.Now(),
+		},
+	}
+	_, err := wcdDb.Collection(TableNameParseJob).UpdateOne(ctx, filter, update)
+	return err
+}
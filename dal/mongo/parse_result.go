@@ -0,0 +1,81 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DeepLangAI/wcd/consts"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const TableNameParseResult = "parse_result"
+
+// ParseResultModel 解析结果缓存，key由url、html、规则和标注器的版本计算
+type ParseResultModel struct {
+	Key    string `bson:"key"`
+	Url    string `bson:"url"`
+	Result string `bson:"result"` // WcdParseResp的json
+
+	CreateTime time.Time       `bson:"create_time"`
+	UpdateTime time.Time       `bson:"update_time"`
+	Status     consts.DbStatus `bson:"status"`
+}
+
+var ParseResultModelDal *parseResultModelDal
+
+type parseResultModelDal struct{}
+
+func (p *parseResultModelDal) checkLegal(model ParseResultModel) (legal bool, msg string) {
+	if model.Key == "" {
+		return false, "key is empty"
+	}
+	if model.Result == "" {
+		return false, "result is empty"
+	}
+	return true, ""
+}
+
+// CreateIndexes 按key查询和覆盖
+func (p *parseResultModelDal) CreateIndexes(ctx context.Context) error {
+	_, err := wcdDb.Collection(TableNameParseResult).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "key", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
+// SaveOne 按key覆盖
+func (p *parseResultModelDal) SaveOne(ctx context.Context, model ParseResultModel) error {
+	if legal, msg := p.checkLegal(model); !legal {
+		hlog.CtxErrorf(ctx, "before save one, check model illegal: %s", msg)
+		return errors.New(msg)
+	}
+	filter := bson.D{{Key: "key", Value: model.Key}}
+	_, err := wcdDb.Collection(TableNameParseResult).ReplaceOne(ctx, filter, model, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (p *parseResultModelDal) FindByKey(ctx context.Context, key string, expireDuration time.Duration) (*ParseResultModel, error) {
+	filter := bson.D{
+		{Key: "status", Value: consts.StatusValid},
+		{Key: "key", Value: key},
+		{Key: "create_time", Value: bson.M{
+			"$gte": time.Now().Add(-1 * expireDuration),
+		}},
+	}
+	one := wcdDb.Collection(TableNameParseResult).FindOne(ctx, filter)
+	if err := one.Err(); err != nil {
+		return nil, err
+	}
+	var model *ParseResultModel
+	if err := one.Decode(&model); err != nil {
+		hlog.CtxErrorf(ctx, "decode error: %v", err)
+		return nil, err
+	}
+	return model, nil
+}
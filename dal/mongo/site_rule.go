@@ -2,7 +2,6 @@ package mongo
 
 import (
 	"context"
-	"regexp"
 	"strings"
 	"time"
 
@@ -23,6 +22,11 @@ type SiteRuleModel struct {
 	Host     string `bson:"host"`
 	HostName string `bson:"host_name"`
 
+	MatchType   string `bson:"match_type"`   // host的匹配方式，为空时按旧的前缀和正则匹配
+	HostPattern string `bson:"host_pattern"` // 按match_type匹配的host，为空时使用host
+	PathPattern string `bson:"path_pattern"` // url路径的匹配规则，为空时匹配所有路径
+	Priority    int    `bson:"priority"`     // 同时命中多条规则时，优先级高的生效
+
 	Bodies            []string `bson:"bodies"`
 	Noises            []string `bson:"noises"`
 	Author            string   `bson:"author"`
@@ -32,6 +36,14 @@ type SiteRuleModel struct {
 	NoSemanticDenoise bool     `bson:"no_semantic_denoise"` // 无须按语义去噪
 	NeedBrowserCrawl  bool     `bson:"need_browser_crawl"`  // 需要浏览器爬取
 	BodyUseRuleOnly   bool     `bson:"body_use_rule_only"`  // 仅使用规则提取正文
+	Labeler           string   `bson:"labeler"`             // 指定标注器，为空时使用全局配置
+
+	CrawlBackends    []string `bson:"crawl_backends"` // 依次尝试的抓取后端，为空时使用全局配置
+	CrawlHeaders     []string `bson:"crawl_headers"`  // 抓取时附加的请求头，格式为"Name: value"
+	CrawlCookie      string   `bson:"crawl_cookie"`
+	CrawlTimeout     string   `bson:"crawl_timeout"`       // 单次抓取的超时，如"30s"
+	CrawlQps         float64  `bson:"crawl_qps"`           // 每秒抓取次数上限，为0时使用全局配置
+	CrawlMaxInFlight int      `bson:"crawl_max_in_flight"` // 同时抓取数上限，为0时使用全局配置
 
 	CreateTime time.Time        `bson:"create_time"`
 	UpdateTime time.Time        `bson:"update_time"`
@@ -39,39 +51,28 @@ type SiteRuleModel struct {
 	Stage      consts.RuleStage `bson:"stage"`
 }
 
-func (s *SiteRuleModel) cleanUrlHost(url string) string {
+// CleanUrlHost 去掉url的协议头和www前缀，用于和规则host做匹配
+func CleanUrlHost(url string) string {
 	prefixs := []string{"https://", "http://", "www."}
 	for _, prefix := range prefixs {
 		if strings.HasPrefix(url, prefix) {
 			url = strings.TrimPrefix(url, prefix)
-			return s.cleanUrlHost(url)
+			return CleanUrlHost(url)
 		}
 	}
 	return url
 }
 
-func (s *SiteRuleModel) Match(url string) bool {
-	if url == "" {
-		return false
-	}
-	hostName := s.cleanUrlHost(url)
-	matched := false
-	var err error
-	if utils.Any([]string{"*", "+"}, func(token string) bool {
+// IsRegexHost 旧规则的host中包含正则符号时按正则匹配
+func (s *SiteRuleModel) IsRegexHost() bool {
+	return s.MatchType == consts.HostMatch_Legacy && utils.Any([]string{"*", "+"}, func(token string) bool {
 		return strings.Contains(s.Host, token)
-	}) {
-		matched, err = regexp.MatchString(s.Host, hostName)
-		if err != nil {
-			return false
-		}
-		if matched {
-			return true
-		}
-	}
-	if matched = strings.HasPrefix(hostName, s.Host); matched {
-		return true
-	}
-	return matched
+	})
+}
+
+// Match 规则较多时使用rule_index，避免每次编译正则
+func (s *SiteRuleModel) Match(url string) bool {
+	return NewRuleMatcher(s).Match(url)
 }
 
 func (s *SiteRuleModel) ToThrift() *wcd_manage.SiteRuleData {
@@ -79,6 +80,10 @@ func (s *SiteRuleModel) ToThrift() *wcd_manage.SiteRuleData {
 		ID:                "",
 		Host:              s.Host,
 		Name:              s.HostName,
+		MatchType:         s.MatchType,
+		HostPattern:       s.HostPattern,
+		PathPattern:       s.PathPattern,
+		Priority:          int32(s.Priority),
 		Bodies:            s.Bodies,
 		Noises:            s.Noises,
 		Title:             s.Title,
@@ -89,6 +94,13 @@ func (s *SiteRuleModel) ToThrift() *wcd_manage.SiteRuleData {
 		NoSemanticDenoise: s.NoSemanticDenoise,
 		NeedBrowserCrawl:  s.NeedBrowserCrawl,
 		BodyUseRuleOnly:   s.BodyUseRuleOnly,
+		Labeler:           s.Labeler,
+		CrawlBackends:     s.CrawlBackends,
+		CrawlHeaders:      s.CrawlHeaders,
+		CrawlCookie:       s.CrawlCookie,
+		CrawlTimeout:      s.CrawlTimeout,
+		CrawlQPS:          s.CrawlQps,
+		CrawlMaxInFlight:  int32(s.CrawlMaxInFlight),
 		CreateTime:        s.CreateTime.Format(time.DateTime),
 		UpdateTime:        s.UpdateTime.Format(time.DateTime),
 	}
@@ -98,6 +110,10 @@ func (s *SiteRuleModel) FromThrift(data *wcd_manage.SiteRuleData) *SiteRuleModel
 	model := &SiteRuleModel{
 		Host:              data.Host,
 		HostName:          data.Name,
+		MatchType:         data.MatchType,
+		HostPattern:       data.HostPattern,
+		PathPattern:       data.PathPattern,
+		Priority:          int(data.Priority),
 		Bodies:            data.Bodies,
 		Noises:            data.Noises,
 		Title:             data.Title,
@@ -107,6 +123,13 @@ func (s *SiteRuleModel) FromThrift(data *wcd_manage.SiteRuleData) *SiteRuleModel
 		NoSemanticDenoise: data.NoSemanticDenoise,
 		NeedBrowserCrawl:  data.NeedBrowserCrawl,
 		BodyUseRuleOnly:   data.BodyUseRuleOnly,
+		Labeler:           data.Labeler,
+		CrawlBackends:     data.CrawlBackends,
+		CrawlHeaders:      data.CrawlHeaders,
+		CrawlCookie:       data.CrawlCookie,
+		CrawlTimeout:      data.CrawlTimeout,
+		CrawlQps:          data.CrawlQPS,
+		CrawlMaxInFlight:  int(data.CrawlMaxInFlight),
 		Stage:             consts.RuleStage(data.Stage),
 	}
 	if createTime, err := time.Parse(time.DateTime, data.CreateTime); err == nil {
@@ -142,6 +165,11 @@ func (s *siteRuleModelDal) FindManyByStageGroup(ctx context.Context, stageGroup
 		return nil, err
 	}
 
+	return FilterByStageGroup(rules, stageGroup), nil
+}
+
+// FilterByStageGroup 按规则阶段组合筛选规则，同一host下按组合决定取测试规则还是线上规则
+func FilterByStageGroup(rules []*SiteRuleModel, stageGroup wcd.RuleStageGroupEnum) []*SiteRuleModel {
 	finalRules := []*SiteRuleModel{}
 	testingRules := map[string]*SiteRuleModel{}
 	prodRules := map[string]*SiteRuleModel{}
@@ -183,7 +211,22 @@ func (s *siteRuleModelDal) FindManyByStageGroup(ctx context.Context, stageGroup
 		}
 	}
 
-	return finalRules, nil
+	return finalRules
+}
+
+// Watch 监听规则表的变更，每次变更都会回调onChange。阻塞直到ctx结束或监听出错
+// 注意：change stream 仅在副本集/分片集群上可用，单机mongo会直接返回错误
+func (s *siteRuleModelDal) Watch(ctx context.Context, onChange func()) error {
+	stream, err := wcdDb.Collection(TableNameSiteRule).Watch(ctx, mongo.Pipeline{})
+	if err != nil {
+		hlog.CtxWarnf(ctx, "watch site rule error: %v", err)
+		return err
+	}
+	defer stream.Close(ctx)
+	for stream.Next(ctx) {
+		onChange()
+	}
+	return stream.Err()
 }
 func (s *siteRuleModelDal) FindMany(ctx context.Context, ruleStage consts.RuleStage) ([]SiteRuleModel, error) {
 	filter := bson.D{
@@ -314,6 +357,10 @@ func (s *siteRuleModelDal) UpsertMany(ctx context.Context, models []*SiteRuleMod
 			{Key: "$set", Value: bson.D{
 				//只需要更新的字段
 				{Key: "host_name", Value: model.HostName},
+				{Key: "match_type", Value: model.MatchType},
+				{Key: "host_pattern", Value: model.HostPattern},
+				{Key: "path_pattern", Value: model.PathPattern},
+				{Key: "priority", Value: model.Priority},
 				{Key: "bodies", Value: model.Bodies},
 				{Key: "noises", Value: model.Noises},
 				{Key: "author", Value: model.Author},
@@ -323,6 +370,13 @@ func (s *siteRuleModelDal) UpsertMany(ctx context.Context, models []*SiteRuleMod
 				{Key: "no_semantic_denoise", Value: model.NoSemanticDenoise},
 				{Key: "need_browser_crawl", Value: model.NeedBrowserCrawl},
 				{Key: "body_use_rule_only", Value: model.BodyUseRuleOnly},
+				{Key: "labeler", Value: model.Labeler},
+				{Key: "crawl_backends", Value: model.CrawlBackends},
+				{Key: "crawl_headers", Value: model.CrawlHeaders},
+				{Key: "crawl_cookie", Value: model.CrawlCookie},
+				{Key: "crawl_timeout", Value: model.CrawlTimeout},
+				{Key: "crawl_qps", Value: model.CrawlQps},
+				{Key: "crawl_max_in_flight", Value: model.CrawlMaxInFlight},
 
 				{Key: "update_time", Value: time.Now()}, // 始终更新：更新时间
 			}},
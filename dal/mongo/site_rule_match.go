@@ -0,0 +1,275 @@
+package mongo
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/consts"
+)
+
+// 同优先级下各匹配方式的先后，范围越小越优先
+var hostMatchRanks = map[string]int{
+	consts.HostMatch_Exact:  4,
+	consts.HostMatch_Legacy: 3,
+	consts.HostMatch_Suffix: 2,
+	consts.HostMatch_Glob:   1,
+	consts.HostMatch_Regex:  0,
+}
+
+// RuleUrl 用于匹配规则的url，同一个url匹配多条规则时只解析一次
+type RuleUrl struct {
+	Cleaned string // 去掉协议头和www前缀的原始url，旧规则按此匹配
+	Host    string // 小写、去掉端口和www前缀
+	Path    string
+}
+
+func ParseRuleUrl(rawUrl string) *RuleUrl {
+	ruleUrl := &RuleUrl{Cleaned: CleanUrlHost(rawUrl), Path: "/"}
+	if !strings.Contains(rawUrl, "://") {
+		rawUrl = "http://" + rawUrl
+	}
+	parsed, err := url.Parse(rawUrl)
+	if err != nil {
+		return ruleUrl
+	}
+	ruleUrl.Host = strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	if path := parsed.EscapedPath(); path != "" {
+		ruleUrl.Path = path
+	}
+	return ruleUrl
+}
+
+// RuleMatcher 编译好的规则匹配条件
+type RuleMatcher struct {
+	Rule        *SiteRuleModel
+	hostPattern string
+	hostRegex   *regexp.Regexp // glob、regex和旧的正则规则
+	pathRegex   *regexp.Regexp // path_pattern为正则或通配符时
+	err         error          // 匹配条件非法时不命中任何url
+	hostErr     bool           // err来自host还是path
+}
+
+func NewRuleMatcher(rule *SiteRuleModel) *RuleMatcher {
+	m := &RuleMatcher{Rule: rule, hostPattern: rule.GetHostPattern()}
+	switch rule.MatchType {
+	case consts.HostMatch_Legacy:
+		if rule.IsRegexHost() {
+			// 旧规则的正则不加锚点，在url中任意位置命中即可，与原有规则的行为保持一致
+			m.hostRegex, m.err = regexp.Compile(rule.Host)
+		}
+	case consts.HostMatch_Exact:
+		m.hostPattern = strings.ToLower(m.hostPattern)
+	case consts.HostMatch_Suffix:
+		// 兼容*.example.com和.example.com的写法
+		m.hostPattern = strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(m.hostPattern), "*"), ".")
+	case consts.HostMatch_Glob:
+		m.hostPattern = strings.ToLower(m.hostPattern)
+		m.hostRegex, m.err = regexp.Compile("^" + globToRegex(m.hostPattern, "[^.]") + "$")
+	case consts.HostMatch_Regex:
+		m.hostRegex, m.err = regexp.Compile("^(?:" + m.hostPattern + ")$")
+	default:
+		m.err = fmt.Errorf("unknown match type: %v", rule.MatchType)
+	}
+	if m.err != nil {
+		m.hostErr = true
+		return m
+	}
+
+	switch {
+	case rule.PathPattern == "":
+	case rule.MatchType == consts.HostMatch_Regex:
+		m.pathRegex, m.err = regexp.Compile("^(?:" + rule.PathPattern + ")$")
+	case strings.ContainsAny(rule.PathPattern, "*?"):
+		m.pathRegex, m.err = regexp.Compile("^" + globToRegex(rule.PathPattern, ".") + "$")
+	}
+	return m
+}
+
+// globToRegex *匹配任意个字符，?匹配一个字符，char为可以匹配的字符
+func globToRegex(glob string, char string) string {
+	var builder strings.Builder
+	for _, r := range glob {
+		switch r {
+		case '*':
+			builder.WriteString(char + "*")
+		case '?':
+			builder.WriteString(char)
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return builder.String()
+}
+
+// GetHostPattern host_pattern为空时使用host
+func (s *SiteRuleModel) GetHostPattern() string {
+	return cmp.Or(s.HostPattern, s.Host)
+}
+
+func (m *RuleMatcher) Err() error {
+	return m.err
+}
+
+// HostErr host的匹配条件非法
+func (m *RuleMatcher) HostErr() bool {
+	return m.hostErr
+}
+
+// Rank 同优先级下匹配方式的先后
+func (m *RuleMatcher) Rank() int {
+	return hostMatchRanks[m.Rule.MatchType]
+}
+
+// IsPattern 按正则或通配符匹配host
+func (m *RuleMatcher) IsPattern() bool {
+	return m.hostRegex != nil
+}
+
+// IndexHost 可以直接按host查找的规则返回其host，exact和旧的普通规则用完整host，suffix用后缀
+func (m *RuleMatcher) IndexHost() string {
+	if m.err != nil || m.hostRegex != nil {
+		return ""
+	}
+	if m.Rule.MatchType == consts.HostMatch_Legacy {
+		host, _, _ := strings.Cut(m.Rule.Host, "/")
+		host, _, _ = strings.Cut(host, ":")
+		return strings.ToLower(host)
+	}
+	return m.hostPattern
+}
+
+// SampleUrl host和路径都按字面匹配时，返回一个能命中该规则的url，用于检查规则之间的重叠
+func (m *RuleMatcher) SampleUrl() (string, bool) {
+	if m.err != nil || m.hostRegex != nil {
+		return "", false
+	}
+	if m.Rule.MatchType == consts.HostMatch_Legacy {
+		return "http://" + m.Rule.Host + m.Rule.PathPattern, m.pathRegex == nil
+	}
+	return "http://" + m.hostPattern + m.Rule.PathPattern, m.pathRegex == nil
+}
+
+func (m *RuleMatcher) Match(rawUrl string) bool {
+	return m.MatchUrl(ParseRuleUrl(rawUrl))
+}
+
+func (m *RuleMatcher) MatchUrl(u *RuleUrl) bool {
+	matched, _ := m.Explain(u)
+	return matched
+}
+
+// Explain 返回是否命中以及原因
+func (m *RuleMatcher) Explain(u *RuleUrl) (bool, string) {
+	if m.err != nil {
+		return false, fmt.Sprintf("invalid rule: %v", m.err)
+	}
+	matchType := cmp.Or(m.Rule.MatchType, "legacy")
+	if m.Rule.MatchType == consts.HostMatch_Legacy {
+		if m.hostRegex != nil {
+			// 正则未命中时仍按前缀匹配，与原有规则的行为保持一致
+			if !m.hostRegex.MatchString(u.Cleaned) && !matchHostPrefix(u.Cleaned, m.Rule.Host) {
+				return false, fmt.Sprintf("url %v does not match legacy regex host %v", u.Cleaned, m.Rule.Host)
+			}
+		} else if !matchHostPrefix(u.Cleaned, m.Rule.Host) {
+			return false, fmt.Sprintf("url %v does not start with legacy host %v", u.Cleaned, m.Rule.Host)
+		}
+	} else if !m.matchHost(u.Host) {
+		return false, fmt.Sprintf("host %v does not match %v host %v", u.Host, matchType, m.hostPattern)
+	}
+	if !m.matchPath(u.Path) {
+		return false, fmt.Sprintf("path %v does not match path pattern %v", u.Path, m.Rule.PathPattern)
+	}
+	reason := fmt.Sprintf("matched %v host %v", matchType, m.hostPattern)
+	if m.Rule.PathPattern != "" {
+		reason += fmt.Sprintf(" and path pattern %v", m.Rule.PathPattern)
+	}
+	return true, reason
+}
+
+// matchHostPrefix 旧规则按前缀匹配，host不含路径时要求前缀之后是host的结尾，避免example.com命中example.com.evil.org
+func matchHostPrefix(cleaned string, host string) bool {
+	if !strings.HasPrefix(cleaned, host) {
+		return false
+	}
+	if strings.Contains(host, "/") || len(cleaned) == len(host) {
+		return true
+	}
+	return strings.ContainsRune("/:?#", rune(cleaned[len(host)]))
+}
+
+func (m *RuleMatcher) matchHost(host string) bool {
+	switch m.Rule.MatchType {
+	case consts.HostMatch_Exact:
+		return host == m.hostPattern
+	case consts.HostMatch_Suffix:
+		return host == m.hostPattern || strings.HasSuffix(host, "."+m.hostPattern)
+	default:
+		return m.hostRegex.MatchString(host)
+	}
+}
+
+// matchPath 正则和通配符需要匹配整个路径，否则按路径前缀匹配，前缀需要在/处断开
+func (m *RuleMatcher) matchPath(path string) bool {
+	pattern := m.Rule.PathPattern
+	switch {
+	case pattern == "":
+		return true
+	case m.pathRegex != nil:
+		return m.pathRegex.MatchString(path)
+	default:
+		prefix := strings.TrimSuffix(pattern, "/")
+		return path == prefix || strings.HasPrefix(path, prefix+"/")
+	}
+}
+
+// CompareMatchers 同时命中时a优先返回负数：优先级高的在前，其次按匹配方式，再按路径和host的长度。
+// 返回0表示两条规则无法区分先后
+func CompareMatchers(a, b *RuleMatcher) int {
+	if a.Rule.Priority != b.Rule.Priority {
+		return cmp.Compare(b.Rule.Priority, a.Rule.Priority)
+	}
+	if a.Rank() != b.Rank() {
+		return cmp.Compare(b.Rank(), a.Rank())
+	}
+	if a.specificity() != b.specificity() {
+		return cmp.Compare(b.specificity(), a.specificity())
+	}
+	return cmp.Compare(len(b.hostPattern), len(a.hostPattern))
+}
+
+// OrderMatchers 在CompareMatchers的基础上按host字典序，保证结果稳定
+func OrderMatchers(a, b *RuleMatcher) int {
+	return cmp.Or(CompareMatchers(a, b), strings.Compare(a.Rule.Host, b.Rule.Host))
+}
+
+// specificity 旧规则沿用host越长越优先，其余按路径规则的长度
+func (m *RuleMatcher) specificity() int {
+	if m.Rule.MatchType == consts.HostMatch_Legacy {
+		return len(m.Rule.Host) + len(m.Rule.PathPattern)
+	}
+	return len(m.Rule.PathPattern)
+}
+
+// UrlRegex 用于按url粗筛网页缓存的正则，命中后还需要用Match确认
+func (m *RuleMatcher) UrlRegex() string {
+	prefix := `^https?://(www\.)?`
+	switch m.Rule.MatchType {
+	case consts.HostMatch_Legacy:
+		if m.hostRegex != nil {
+			// 旧规则的正则不加锚点，在完整url中查找的结果包含在url中查找的结果
+			return m.Rule.Host
+		}
+		return prefix + regexp.QuoteMeta(m.Rule.Host)
+	case consts.HostMatch_Exact:
+		return "(?i)" + prefix + regexp.QuoteMeta(m.hostPattern)
+	case consts.HostMatch_Suffix:
+		return "(?i)" + prefix + `([^/?#]*\.)?` + regexp.QuoteMeta(m.hostPattern)
+	case consts.HostMatch_Glob:
+		return "(?i)" + prefix + globToRegex(m.hostPattern, "[^./]")
+	default:
+		return "(?i)" + prefix + "(?:" + m.hostPattern + ")"
+	}
+}
@@ -0,0 +1,196 @@
+package mongo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/bytedance/sonic"
+)
+
+func TestRuleMatcherMatch(t *testing.T) {
+	cases := []struct {
+		name  string
+		rule  SiteRuleModel
+		url   string
+		match bool
+	}{
+		// 旧规则：按去掉协议头和www的url前缀匹配
+		{"legacy host", SiteRuleModel{Host: "example.com"}, "https://example.com/a", true},
+		{"legacy www removed", SiteRuleModel{Host: "example.com"}, "https://www.example.com/a", true},
+		{"legacy host only", SiteRuleModel{Host: "example.com"}, "example.com", true},
+		{"legacy with port", SiteRuleModel{Host: "example.com"}, "http://example.com:8080/a", true},
+		{"legacy with query", SiteRuleModel{Host: "example.com"}, "http://example.com?a=1", true},
+		{"legacy evil suffix", SiteRuleModel{Host: "example.com"}, "https://example.com.evil.org/a", false},
+		{"legacy longer label", SiteRuleModel{Host: "example.com"}, "https://example.community/a", false},
+		{"legacy subdomain", SiteRuleModel{Host: "example.com"}, "https://news.example.com/a", false},
+		{"legacy with path", SiteRuleModel{Host: "example.com/news/"}, "https://example.com/news/1", true},
+		{"legacy with path not matched", SiteRuleModel{Host: "example.com/news/"}, "https://example.com/blog/1", false},
+		{"legacy regex", SiteRuleModel{Host: "(.*)example.com"}, "https://news.example.com/a", true},
+		{"legacy regex unanchored", SiteRuleModel{Host: `example\.com/\d+`}, "https://m.example.com/12", true},
+		{"legacy regex not matched", SiteRuleModel{Host: "(.*)example.com"}, "https://example.org/a", false},
+		{"legacy regex with path", SiteRuleModel{Host: `example.com/\d+.html`}, "https://example.com/123.html", true},
+		{"legacy regex with path not matched", SiteRuleModel{Host: `example.com/\d+.html`}, "https://example.com/abc.html", false},
+		{"legacy path pattern", SiteRuleModel{Host: "example.com", PathPattern: "/news"}, "https://example.com/news/1", true},
+		{"legacy path pattern not matched", SiteRuleModel{Host: "example.com", PathPattern: "/news"}, "https://example.com/newsletter", false},
+
+		// exact：host完全相同，忽略大小写、端口和www
+		{"exact", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Exact}, "https://example.com/a", true},
+		{"exact www", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Exact}, "https://www.example.com/a", true},
+		{"exact case", SiteRuleModel{Host: "Example.COM", MatchType: consts.HostMatch_Exact}, "https://EXAMPLE.com/a", true},
+		{"exact port", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Exact}, "https://example.com:8443/a", true},
+		{"exact subdomain", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Exact}, "https://news.example.com/a", false},
+		{"exact evil suffix", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Exact}, "https://example.com.evil.org/a", false},
+		{"exact host pattern", SiteRuleModel{Host: "example", HostPattern: "example.com", MatchType: consts.HostMatch_Exact}, "https://example.com/a", true},
+
+		// suffix：host本身或其子域名
+		{"suffix self", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix}, "https://example.com/a", true},
+		{"suffix subdomain", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix}, "https://a.b.example.com/a", true},
+		{"suffix star prefix", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Suffix}, "https://news.example.com/a", true},
+		{"suffix dot prefix", SiteRuleModel{Host: ".example.com", MatchType: consts.HostMatch_Suffix}, "https://news.example.com/a", true},
+		{"suffix case and port", SiteRuleModel{Host: "Example.com", MatchType: consts.HostMatch_Suffix}, "https://NEWS.example.com:8080/a", true},
+		{"suffix label boundary", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix}, "https://badexample.com/a", false},
+		{"suffix evil suffix", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix}, "https://example.com.evil.org/a", false},
+
+		// glob：*匹配一级域名中的任意字符
+		{"glob", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob}, "https://news.example.com/a", true},
+		{"glob one label", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob}, "https://a.b.example.com/a", false},
+		{"glob no label", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob}, "https://example.com/a", false},
+		{"glob question mark", SiteRuleModel{Host: "m?.example.com", MatchType: consts.HostMatch_Glob}, "https://m1.example.com/a", true},
+		{"glob case", SiteRuleModel{Host: "*.Example.com", MatchType: consts.HostMatch_Glob}, "https://NEWS.example.com/a", true},
+		{"glob evil suffix", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob}, "https://a.example.com.evil.org/a", false},
+		{"glob path prefix", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob, PathPattern: "/news/"}, "https://a.example.com/news/1", true},
+		{"glob path glob", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob, PathPattern: "/news/*.html"}, "https://a.example.com/news/2025/1.html", true},
+		{"glob path glob not matched", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob, PathPattern: "/news/*.html"}, "https://a.example.com/news/1.htm", false},
+
+		// regex：host和path_pattern都需要完整匹配
+		{"regex", SiteRuleModel{Host: `news\d*\.example\.com`, MatchType: consts.HostMatch_Regex}, "https://news12.example.com/a", true},
+		{"regex anchored", SiteRuleModel{Host: `news\d*\.example\.com`, MatchType: consts.HostMatch_Regex}, "https://news1.example.com.evil.org/a", false},
+		{"regex port", SiteRuleModel{Host: `news\d*\.example\.com`, MatchType: consts.HostMatch_Regex}, "https://news.example.com:8080/a", true},
+		{"regex path", SiteRuleModel{Host: `example\.com`, MatchType: consts.HostMatch_Regex, PathPattern: `/\d+\.html`}, "https://example.com/12.html", true},
+		{"regex path anchored", SiteRuleModel{Host: `example\.com`, MatchType: consts.HostMatch_Regex, PathPattern: `/\d+\.html`}, "https://example.com/a/12.html", false},
+
+		// 非法的规则不命中任何url
+		{"invalid regex", SiteRuleModel{Host: `(example.com`, MatchType: consts.HostMatch_Regex}, "https://example.com/a", false},
+		{"unknown match type", SiteRuleModel{Host: "example.com", MatchType: "prefix"}, "https://example.com/a", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if match := c.rule.Match(c.url); match != c.match {
+				t.Errorf("rule %+v Match(%v) = %v, want %v", c.rule, c.url, match, c.match)
+			}
+		})
+	}
+}
+
+// 规则文件中的旧正则规则，正则不加锚点，在去掉协议头和www的url中查找
+func TestLegacyRegexHosts(t *testing.T) {
+	cases := []struct {
+		host     string
+		matches  []string
+		excludes []string
+	}{
+		{"blueloved.pixnet.net/blog/post/*", []string{"https://blueloved.pixnet.net/blog/post/123"}, []string{"https://blueloved.pixnet.net/blog/"}},
+		{"(.*)huanqiu.com/article/(.*)", []string{"https://world.huanqiu.com/article/4abc"}, []string{"https://world.huanqiu.com/gallery/4abc"}},
+		{"gmc.gmw.cn(.*)archives", []string{"https://gmc.gmw.cn/2025/archives/1"}, []string{"https://gmc.gmw.cn/2025/1"}},
+		{".*blog.csdn.net", []string{"https://blog.csdn.net/a/article/details/1", "https://xx.blog.csdn.net/article/1"}, []string{"https://csdn.net/a"}},
+		{"(.*)gmw.cn", []string{"https://news.gmw.cn/2025-01/01/content_1.htm"}, []string{"https://gmw.com/a"}},
+		{"chinanews.com.cn/.*?.shtml", []string{"https://www.chinanews.com.cn/gn/2025/01-01/1.shtml"}, []string{"https://chinanews.com.cn/gn/2025/01-01/1.html"}},
+		{`tmtpost.com/\d+.html`, []string{"https://www.tmtpost.com/7123456.html"}, []string{"https://www.tmtpost.com/tag/1.html"}},
+		{`thepaper.cn/newsDetail_forward_\d+`, []string{"https://www.thepaper.cn/newsDetail_forward_123", "https://m.thepaper.cn/newsDetail_forward_123"}, []string{"https://www.thepaper.cn/list_25462"}},
+		{`jxase.com/page\d+.*?article_id=\d+`, []string{"http://jxase.com/page12.html?article_id=3"}, []string{"http://jxase.com/page12.html"}},
+		{`html.rhhz.net/.*?/html/\d+.htm`, []string{"https://html.rhhz.net/ZGKX/html/20250101.htm"}, []string{"https://html.rhhz.net/ZGKX/index.htm"}},
+		{"m.chinanews.com/.*.shtml", []string{"https://m.chinanews.com/wap/detail/1.shtml"}, []string{"https://chinanews.com/wap/detail/1.shtml"}},
+		{`qbitai.com/\d+/\d+/\d+.html`, []string{"https://www.qbitai.com/2025/01/123.html"}, []string{"https://www.qbitai.com/about.html"}},
+		{`chinadigitaltimes.net/\w+/\d+.html`, []string{"https://chinadigitaltimes.net/chinese/712345.html"}, []string{"https://chinadigitaltimes.net/chinese/"}},
+		{".*.people.com.cn/", []string{"http://world.people.com.cn/n1/2025/0101/c1002-1.html"}, []string{"http://people.com.cn.evil.org/a", "http://people.com.cn/a"}},
+		{"zaobao.com/.*?/story.*", []string{"https://www.zaobao.com/news/china/story20250101-1"}, []string{"https://www.zaobao.com/news/china/"}},
+		{`ifanr.com/\d+`, []string{"https://www.ifanr.com/1612345", "https://m.ifanr.com/1612345"}, []string{"https://www.ifanr.com/app"}},
+	}
+	for _, c := range cases {
+		t.Run(c.host, func(t *testing.T) {
+			rule := &SiteRuleModel{Host: c.host}
+			if !rule.IsRegexHost() {
+				t.Fatalf("IsRegexHost() = false")
+			}
+			matcher := NewRuleMatcher(rule)
+			if matcher.Err() != nil {
+				t.Fatalf("NewRuleMatcher() error: %v", matcher.Err())
+			}
+			for _, u := range c.matches {
+				if !matcher.Match(u) {
+					t.Errorf("Match(%v) = false, want true", u)
+				}
+			}
+			for _, u := range c.excludes {
+				if matcher.Match(u) {
+					t.Errorf("Match(%v) = true, want false", u)
+				}
+			}
+		})
+	}
+}
+
+// 规则文件中的规则都能编译，按host字面匹配的规则能命中自己的host
+func TestRuleFiles(t *testing.T) {
+	files, err := filepath.Glob("../../data/rules_*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read %v error: %v", file, err)
+		}
+		rules := []*wcd_manage.SiteRuleData{}
+		if err := sonic.Unmarshal(data, &rules); err != nil {
+			t.Fatalf("unmarshal %v error: %v", file, err)
+		}
+		for _, data := range rules {
+			rule := (&SiteRuleModel{}).FromThrift(data)
+			matcher := NewRuleMatcher(rule)
+			if matcher.Err() != nil {
+				t.Errorf("%v: host %v compile error: %v", file, rule.Host, matcher.Err())
+				continue
+			}
+			if sample, ok := matcher.SampleUrl(); ok && !matcher.Match(sample) {
+				t.Errorf("%v: host %v does not match its sample url %v", file, rule.Host, sample)
+			}
+		}
+	}
+}
+
+func TestCompareMatchers(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b SiteRuleModel
+		want int
+	}{
+		{"priority first", SiteRuleModel{Host: `.*\.example\.com`, MatchType: consts.HostMatch_Regex, Priority: 1}, SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Exact}, -1},
+		{"exact before legacy", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Exact}, SiteRuleModel{Host: "example.com/news/"}, -1},
+		{"legacy before suffix", SiteRuleModel{Host: "example.com"}, SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix}, -1},
+		{"suffix before glob", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix}, SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob}, -1},
+		{"glob before regex", SiteRuleModel{Host: "*.example.com", MatchType: consts.HostMatch_Glob}, SiteRuleModel{Host: `.*\.example\.com`, MatchType: consts.HostMatch_Regex}, -1},
+		{"longer legacy host", SiteRuleModel{Host: "world.people.com.cn/"}, SiteRuleModel{Host: ".*.people.com.cn/"}, -1},
+		{"longer path pattern", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix, PathPattern: "/news/"}, SiteRuleModel{Host: "a.example.com", MatchType: consts.HostMatch_Suffix}, -1},
+		{"longer host pattern", SiteRuleModel{Host: "a.example.com", MatchType: consts.HostMatch_Suffix}, SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix}, -1},
+		{"reversed", SiteRuleModel{Host: "example.com", MatchType: consts.HostMatch_Suffix}, SiteRuleModel{Host: "a.example.com", MatchType: consts.HostMatch_Suffix}, 1},
+		{"tie", SiteRuleModel{Host: "a?.example.com", MatchType: consts.HostMatch_Glob}, SiteRuleModel{Host: "*b.example.com", MatchType: consts.HostMatch_Glob}, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := CompareMatchers(NewRuleMatcher(&c.a), NewRuleMatcher(&c.b)); got != c.want {
+				t.Errorf("CompareMatchers(%v, %v) = %v, want %v", c.a.Host, c.b.Host, got, c.want)
+			}
+		})
+	}
+
+	// 无法区分先后时按host字典序
+	a := NewRuleMatcher(&SiteRuleModel{Host: "*b.example.com", MatchType: consts.HostMatch_Glob})
+	b := NewRuleMatcher(&SiteRuleModel{Host: "a?.example.com", MatchType: consts.HostMatch_Glob})
+	if got := OrderMatchers(a, b); got != -1 {
+		t.Errorf("OrderMatchers(%v, %v) = %v, want -1", a.Rule.Host, b.Rule.Host, got)
+	}
+}
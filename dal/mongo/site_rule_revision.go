@@ -0,0 +1,129 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	TableNameSiteRuleRevision        = "site_rule_revision"
+	TableNameSiteRuleRevisionCounter = "site_rule_revision_counter" // 各host已分配的最大版本号
+
+	// 版本号与已有版本冲突时重试的次数，只在计数表之外写入过版本时发生
+	revisionInsertRetries = 3
+)
+
+// SiteRuleRevisionModel 规则每次变更后的完整快照，写入后不再修改
+type SiteRuleRevisionModel struct {
+	Host     string        `bson:"host"`
+	Revision int64         `bson:"revision"` // 同一host内从1开始递增
+	Action   string        `bson:"action"`   // consts.RuleAction_*
+	Rule     SiteRuleModel `bson:"rule"`     // 变更后的规则，删除时为删除前的规则
+	Author   string        `bson:"author"`
+	Comment  string        `bson:"comment"`
+
+	CreateTime time.Time `bson:"create_time"`
+}
+
+var SiteRuleRevisionModelDal *siteRuleRevisionModelDal
+
+type siteRuleRevisionModelDal struct{}
+
+// CreateIndexes 同一host的版本号唯一
+func (s *siteRuleRevisionModelDal) CreateIndexes(ctx context.Context) error {
+	_, err := wcdDb.Collection(TableNameSiteRuleRevision).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "host", Value: 1}, {Key: "revision", Value: -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
+// Insert 分配版本号后写入，写入成功后model.Revision为分配的版本号
+func (s *siteRuleRevisionModelDal) Insert(ctx context.Context, model *SiteRuleRevisionModel) error {
+	if model.Host == "" {
+		return errors.New("host is empty")
+	}
+	var err error
+	for i := 0; i < revisionInsertRetries; i++ {
+		revision, allocErr := s.nextRevision(ctx, model.Host)
+		if allocErr != nil {
+			return allocErr
+		}
+		model.Revision = revision
+		_, err = wcdDb.Collection(TableNameSiteRuleRevision).InsertOne(ctx, model)
+		if !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+		hlog.CtxWarnf(ctx, "site rule revision conflict, host: %v, revision: %v", model.Host, model.Revision)
+	}
+	return err
+}
+
+// nextRevision 在计数表中原子地分配版本号，并发写入同一host时不会分到相同的版本号。
+// 计数从计数表和已有版本中较大的一个开始，兼容没有计数时写入的版本
+func (s *siteRuleRevisionModelDal) nextRevision(ctx context.Context, host string) (int64, error) {
+	latest, err := s.latestRevision(ctx, host)
+	if err != nil {
+		return 0, err
+	}
+	update := bson.A{bson.M{"$set": bson.M{
+		"revision": bson.M{"$add": bson.A{bson.M{"$max": bson.A{"$revision", latest}}, 1}},
+	}}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	counter := struct {
+		Revision int64 `bson:"revision"`
+	}{}
+	err = wcdDb.Collection(TableNameSiteRuleRevisionCounter).FindOneAndUpdate(ctx, bson.M{"_id": host}, update, opts).Decode(&counter)
+	if err != nil {
+		return 0, err
+	}
+	return counter.Revision, nil
+}
+
+func (s *siteRuleRevisionModelDal) latestRevision(ctx context.Context, host string) (int64, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "revision", Value: -1}})
+	model := &SiteRuleRevisionModel{}
+	err := wcdDb.Collection(TableNameSiteRuleRevision).FindOne(ctx, bson.D{{Key: "host", Value: host}}, opts).Decode(model)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return model.Revision, nil
+}
+
+// ListByHost 按版本号从新到旧，limit<=0时不限制
+func (s *siteRuleRevisionModelDal) ListByHost(ctx context.Context, host string, limit int) ([]SiteRuleRevisionModel, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "revision", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, err := wcdDb.Collection(TableNameSiteRuleRevision).Find(ctx, bson.D{{Key: "host", Value: host}}, opts)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "find site rule revision error: %v", err)
+		return nil, err
+	}
+	revisions := []SiteRuleRevisionModel{}
+	if err := cursor.All(ctx, &revisions); err != nil {
+		hlog.CtxErrorf(ctx, "decode site rule revision error: %v", err)
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// FindOne 未找到时返回mongo.ErrNoDocuments
+func (s *siteRuleRevisionModelDal) FindOne(ctx context.Context, host string, revision int64) (*SiteRuleRevisionModel, error) {
+	filter := bson.D{{Key: "host", Value: host}, {Key: "revision", Value: revision}}
+	model := &SiteRuleRevisionModel{}
+	if err := wcdDb.Collection(TableNameSiteRuleRevision).FindOne(ctx, filter).Decode(model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
@@ -0,0 +1,63 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/conf"
+)
+
+const defaultLocalBaseUrl = "/public/mirror"
+
+// LocalStore 保存到本地目录，默认目录在static下，通过/public访问。相对目录按项目目录解析，和main.go中/public的目录一致
+type LocalStore struct {
+	dir     string
+	baseUrl string
+}
+
+func NewLocalStore(dir string, baseUrl string) *LocalStore {
+	if dir == "" {
+		dir = filepath.Join(conf.GetProjectPath(), "static", "mirror")
+	} else if !filepath.IsAbs(dir) {
+		dir = filepath.Join(conf.GetProjectPath(), dir)
+	}
+	if baseUrl == "" {
+		baseUrl = defaultLocalBaseUrl
+	}
+	return &LocalStore{dir: dir, baseUrl: strings.TrimSuffix(baseUrl, "/")}
+}
+
+func (l *LocalStore) Put(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	key = path.Clean("/" + key)[1:]
+	if key == "" {
+		return "", fmt.Errorf("illegal object key")
+	}
+	filePath := filepath.Join(l.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return "", err
+	}
+	// 先写临时文件再重命名，避免读到写了一半的文件
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".tmp-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return "", err
+	}
+	return l.baseUrl + "/" + key, nil
+}
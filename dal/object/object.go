@@ -0,0 +1,30 @@
+package object
+
+import (
+	"context"
+
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const TypeLocal = "local"
+
+// Store 保存镜像图片等文件，返回可访问的地址
+type Store interface {
+	// Put 按key覆盖保存，key由调用方生成，如"images/ab/abcd.jpg"
+	Put(ctx context.Context, key string, contentType string, data []byte) (string, error)
+}
+
+var Default Store = NewLocalStore("", "")
+
+// Init 按配置选择对象存储，配置错误时使用本地目录
+func Init(ctx context.Context) {
+	c := conf.GetConfig().Store.Object
+	switch c.Type {
+	case TypeLocal, "":
+	default:
+		hlog.CtxErrorf(ctx, "unknown object store type: %v, use local", c.Type)
+	}
+	Default = NewLocalStore(c.Dir, c.BaseUrl)
+	hlog.CtxInfof(ctx, "object store: local, dir: %v", c.Dir)
+}
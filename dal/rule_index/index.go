@@ -0,0 +1,179 @@
+package rule_index
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/store"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const defaultPollSeconds = 60
+
+var stageGroups = []wcd.RuleStageGroupEnum{
+	wcd.RuleStageGroupEnum_ProdOnly,
+	wcd.RuleStageGroupEnum_TestingOnly,
+	wcd.RuleStageGroupEnum_ProdPrior,
+	wcd.RuleStageGroupEnum_TestingPrior,
+}
+
+// SiteRuleIndex 站点规则的内存索引，避免每次解析都去mongo全量查询规则
+var SiteRuleIndex = newSiteRuleIndex()
+
+type siteRuleIndex struct {
+	mu     sync.RWMutex
+	groups map[wcd.RuleStageGroupEnum]*stageIndex
+	loaded bool
+
+	loadMu sync.Mutex
+	notify chan struct{}
+}
+
+func newSiteRuleIndex() *siteRuleIndex {
+	return &siteRuleIndex{
+		groups: map[wcd.RuleStageGroupEnum]*stageIndex{},
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// Init 加载规则并在后台保持索引最新：优先监听change stream，不可用时退化为轮询
+func Init(ctx context.Context) {
+	if err := SiteRuleIndex.Load(ctx); err != nil {
+		hlog.CtxErrorf(ctx, "load site rule index error: %v", err)
+	}
+	go SiteRuleIndex.reloadLoop(ctx)
+	go SiteRuleIndex.keepFresh(ctx)
+}
+
+// Load 从规则存储全量加载规则并重建索引
+func (s *siteRuleIndex) Load(ctx context.Context) error {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+
+	models, err := store.SiteRules.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	rules := make([]*mongo.SiteRuleModel, 0, len(models))
+	for i := range models {
+		rules = append(rules, &models[i])
+	}
+	s.build(ctx, rules)
+	return nil
+}
+
+// SetRules 用给定的规则重建索引，不访问数据库，用于测试和离线回放
+func (s *siteRuleIndex) SetRules(ctx context.Context, rules []*mongo.SiteRuleModel) {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+	s.build(ctx, rules)
+}
+
+func (s *siteRuleIndex) build(ctx context.Context, rules []*mongo.SiteRuleModel) {
+	matcherCache := map[*mongo.SiteRuleModel]*mongo.RuleMatcher{}
+	groups := map[wcd.RuleStageGroupEnum]*stageIndex{}
+	for _, group := range stageGroups {
+		groups[group] = newStageIndex(ctx, mongo.FilterByStageGroup(rules, group), matcherCache)
+	}
+
+	s.mu.Lock()
+	s.groups = groups
+	s.loaded = true
+	s.mu.Unlock()
+	hlog.CtxInfof(ctx, "site rule index loaded, num rules: %v", len(rules))
+}
+
+// Invalidate 规则有写入时调用，立即重建索引；重建失败则在下次匹配时重新加载
+func (s *siteRuleIndex) Invalidate(ctx context.Context) {
+	s.mu.Lock()
+	s.loaded = false
+	s.mu.Unlock()
+	if err := s.Load(ctx); err != nil {
+		hlog.CtxErrorf(ctx, "reload site rule index error: %v", err)
+	}
+}
+
+// Match 返回url命中的规则，多条命中时按优先级、匹配方式、路径和host的长度取最优的一条
+func (s *siteRuleIndex) Match(ctx context.Context, url string, stageGroup wcd.RuleStageGroupEnum) (*mongo.SiteRuleModel, error) {
+	index, err := s.stageIndex(ctx, stageGroup)
+	if err != nil || index == nil || url == "" {
+		return nil, err
+	}
+	return index.match(mongo.ParseRuleUrl(url)), nil
+}
+
+// Explain 返回url命中的各条规则及原因，第一条为生效的规则；未命中时返回host相近的规则及未命中的原因
+func (s *siteRuleIndex) Explain(ctx context.Context, url string, stageGroup wcd.RuleStageGroupEnum) ([]*RuleExplanation, error) {
+	index, err := s.stageIndex(ctx, stageGroup)
+	if err != nil || index == nil || url == "" {
+		return []*RuleExplanation{}, err
+	}
+	return index.explain(mongo.ParseRuleUrl(url)), nil
+}
+
+func (s *siteRuleIndex) stageIndex(ctx context.Context, stageGroup wcd.RuleStageGroupEnum) (*stageIndex, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	s.mu.RLock()
+	loaded := s.loaded
+	s.mu.RUnlock()
+	if !loaded {
+		if err := s.Load(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.groups[stageGroup], nil
+}
+
+// keepFresh 监听规则表变更；change stream不可用（如单机mongo）时按配置间隔轮询
+func (s *siteRuleIndex) keepFresh(ctx context.Context) {
+	err := store.SiteRules.Watch(ctx, s.markDirty)
+	if ctx.Err() != nil {
+		return
+	}
+	hlog.CtxWarnf(ctx, "site rule change stream unavailable, fallback to polling, err: %v", err)
+
+	pollSeconds := conf.GetConfig().Parse.Rule.IndexPollSeconds
+	if pollSeconds <= 0 {
+		pollSeconds = defaultPollSeconds
+	}
+	ticker := time.NewTicker(time.Duration(pollSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.markDirty()
+		}
+	}
+}
+
+// markDirty 合并短时间内的多次变更，只触发一次重建
+func (s *siteRuleIndex) markDirty() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *siteRuleIndex) reloadLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.notify:
+			if err := s.Load(ctx); err != nil {
+				hlog.CtxErrorf(ctx, "reload site rule index error: %v", err)
+			}
+		}
+	}
+}
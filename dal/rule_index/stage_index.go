@@ -0,0 +1,118 @@
+package rule_index
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// stageIndex 某一规则阶段组合下的索引：exact和旧的普通host按host查找，suffix按host的各级后缀查找，
+// glob、regex和旧的正则host预编译后逐条匹配。候选规则命中后按mongo.OrderMatchers取最优的一条
+type stageIndex struct {
+	hosts    map[string][]*mongo.RuleMatcher
+	suffixes map[string][]*mongo.RuleMatcher
+	patterns []*mongo.RuleMatcher
+	all      []*mongo.RuleMatcher
+}
+
+// RuleExplanation 规则对某个url的匹配结果
+type RuleExplanation struct {
+	Rule    *mongo.SiteRuleModel
+	Matched bool
+	Reason  string
+}
+
+func newStageIndex(ctx context.Context, rules []*mongo.SiteRuleModel, matcherCache map[*mongo.SiteRuleModel]*mongo.RuleMatcher) *stageIndex {
+	index := &stageIndex{
+		hosts:    map[string][]*mongo.RuleMatcher{},
+		suffixes: map[string][]*mongo.RuleMatcher{},
+	}
+	for _, rule := range rules {
+		matcher, ok := matcherCache[rule]
+		if !ok {
+			matcher = mongo.NewRuleMatcher(rule)
+			if matcher.Err() != nil {
+				// 匹配条件非法的规则不会命中任何url，和SiteRuleModel.Match保持一致
+				hlog.CtxWarnf(ctx, "compile site rule matcher error, host: %v, err: %v", rule.Host, matcher.Err())
+			}
+			matcherCache[rule] = matcher
+		}
+		index.all = append(index.all, matcher)
+		if matcher.Err() != nil {
+			continue
+		}
+		if host := matcher.IndexHost(); host == "" {
+			index.patterns = append(index.patterns, matcher)
+		} else if rule.MatchType == consts.HostMatch_Suffix {
+			index.suffixes[host] = append(index.suffixes[host], matcher)
+		} else {
+			index.hosts[host] = append(index.hosts[host], matcher)
+		}
+	}
+	return index
+}
+
+// candidates 可能命中url的规则，还需要逐条确认
+func (i *stageIndex) candidates(u *mongo.RuleUrl) []*mongo.RuleMatcher {
+	candidates := slices.Clone(i.hosts[u.Host])
+	for host := u.Host; host != ""; {
+		candidates = append(candidates, i.suffixes[host]...)
+		_, parent, ok := strings.Cut(host, ".")
+		if !ok {
+			break
+		}
+		host = parent
+	}
+	return append(candidates, i.patterns...)
+}
+
+func (i *stageIndex) match(u *mongo.RuleUrl) *mongo.SiteRuleModel {
+	var best *mongo.RuleMatcher
+	for _, matcher := range i.candidates(u) {
+		if best != nil && mongo.OrderMatchers(matcher, best) >= 0 {
+			continue
+		}
+		if matcher.MatchUrl(u) {
+			best = matcher
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.Rule
+}
+
+// explain 命中的规则按优先顺序在前，其后为host相近但未命中的规则
+func (i *stageIndex) explain(u *mongo.RuleUrl) []*RuleExplanation {
+	matched := []*mongo.RuleMatcher{}
+	explanations := []*RuleExplanation{}
+	reasons := map[*mongo.RuleMatcher]string{}
+	// 取url的最后两级域名，用于找出host相近的规则
+	labels := strings.Split(u.Host, ".")
+	domain := strings.Join(labels[max(len(labels)-2, 0):], ".")
+	for _, matcher := range i.all {
+		ok, reason := matcher.Explain(u)
+		if ok {
+			matched = append(matched, matcher)
+			reasons[matcher] = reason
+			continue
+		}
+		hostPattern := strings.ToLower(strings.ReplaceAll(matcher.Rule.GetHostPattern(), `\`, ""))
+		if domain != "" && strings.Contains(hostPattern, domain) {
+			explanations = append(explanations, &RuleExplanation{Rule: matcher.Rule, Reason: reason})
+		}
+	}
+	slices.SortFunc(matched, mongo.OrderMatchers)
+	matchedExplanations := []*RuleExplanation{}
+	for _, matcher := range matched {
+		matchedExplanations = append(matchedExplanations, &RuleExplanation{Rule: matcher.Rule, Matched: true, Reason: reasons[matcher]})
+	}
+	slices.SortFunc(explanations, func(a, b *RuleExplanation) int {
+		return strings.Compare(a.Rule.Host, b.Rule.Host)
+	})
+	return append(matchedExplanations, explanations...)
+}
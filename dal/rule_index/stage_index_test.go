@@ -0,0 +1,112 @@
+package rule_index
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/bytedance/sonic"
+)
+
+// linearMatch 逐条匹配全部规则，作为索引结果的对照
+func linearMatch(rules []*mongo.SiteRuleModel, u *mongo.RuleUrl) *mongo.SiteRuleModel {
+	var best *mongo.RuleMatcher
+	for _, rule := range rules {
+		matcher := mongo.NewRuleMatcher(rule)
+		if !matcher.MatchUrl(u) {
+			continue
+		}
+		if best == nil || mongo.OrderMatchers(matcher, best) < 0 {
+			best = matcher
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.Rule
+}
+
+func TestStageIndexMatch(t *testing.T) {
+	rules := []*mongo.SiteRuleModel{
+		{Host: "example.com"},
+		{Host: "example.com/news/"},
+		{Host: "(.*)example.com"},
+		{Host: "news.example.com", MatchType: consts.HostMatch_Exact},
+		{Host: "example.com", MatchType: consts.HostMatch_Suffix},
+		{Host: "blog.example.com", MatchType: consts.HostMatch_Suffix, PathPattern: "/posts/"},
+		{Host: "*.example.com", MatchType: consts.HostMatch_Glob},
+		{Host: "*.example.com", HostPattern: "m?.example.com", MatchType: consts.HostMatch_Glob, PathPattern: "/*.html"},
+		{Host: `.*\.example\.(com|org)`, MatchType: consts.HostMatch_Regex},
+		{Host: `example\.org`, MatchType: consts.HostMatch_Regex, PathPattern: `/\d+`, Priority: 1},
+		{Host: "example.org", MatchType: consts.HostMatch_Exact, Priority: -1},
+		{Host: `(bad`, MatchType: consts.HostMatch_Regex, Priority: 10},
+	}
+	files, err := filepath.Glob("../../data/rules_*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read %v error: %v", file, err)
+		}
+		fileRules := []*wcd_manage.SiteRuleData{}
+		if err := sonic.Unmarshal(data, &fileRules); err != nil {
+			t.Fatalf("unmarshal %v error: %v", file, err)
+		}
+		for _, rule := range fileRules {
+			rules = append(rules, (&mongo.SiteRuleModel{}).FromThrift(rule))
+		}
+	}
+
+	urls := []string{
+		"https://example.com/",
+		"https://www.example.com/news/1",
+		"https://EXAMPLE.com:8080/a",
+		"https://news.example.com/a",
+		"https://a.news.example.com/a",
+		"https://blog.example.com/posts/1",
+		"https://blog.example.com/about",
+		"https://m1.example.com/a/b.html",
+		"https://m1.example.com/a/b",
+		"https://x.example.org/a",
+		"https://example.org/12",
+		"https://example.org/about",
+		"https://example.com.evil.org/a",
+		"https://evil.org/example.com",
+		"http://world.people.com.cn/n1/2025/0101/c1002-1.html",
+		"http://politics.people.com.cn/n1/2025/0101/c1001-1.html",
+		"https://www.thepaper.cn/newsDetail_forward_123",
+		"https://www.zaobao.com/news/china/story20250101-1",
+		"https://blog.csdn.net/a/article/details/1",
+		"https://news.gmw.cn/2025-01/01/content_1.htm",
+		"https://gmc.gmw.cn/2025/archives/1",
+		"https://unknown.test/a",
+	}
+	// 规则自身的host也作为url，覆盖按host查找的规则
+	for _, rule := range rules {
+		if sample, ok := mongo.NewRuleMatcher(rule).SampleUrl(); ok {
+			urls = append(urls, sample, sample+"x/y", "https://www."+sample[len("http://"):])
+		}
+	}
+
+	index := newStageIndex(context.Background(), rules, map[*mongo.SiteRuleModel]*mongo.RuleMatcher{})
+	for _, rawUrl := range urls {
+		u := mongo.ParseRuleUrl(rawUrl)
+		got, want := index.match(u), linearMatch(rules, u)
+		if got != want {
+			t.Errorf("match(%v) = %v, want %v", rawUrl, ruleHost(got), ruleHost(want))
+		}
+	}
+}
+
+func ruleHost(rule *mongo.SiteRuleModel) string {
+	if rule == nil {
+		return "<nil>"
+	}
+	return rule.Host
+}
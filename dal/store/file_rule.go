@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/utils"
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const defaultRuleFiles = "./data/rules_*.json"
+
+// NewFileRuleStore 从json文件加载规则到内存，文件格式与规则导出接口一致。
+// saveFile存在时只加载saveFile，否则按pattern加载，同一host和stage的规则以后加载的文件为准；
+// saveFile不为空时，每次修改规则后都把全部规则写入saveFile
+func NewFileRuleStore(ctx context.Context, pattern string, saveFile string) (*MemoryRuleStore, error) {
+	if pattern == "" {
+		pattern = defaultRuleFiles
+	}
+	pattern = projectFilePath(pattern)
+	if saveFile != "" {
+		saveFile = projectFilePath(saveFile)
+	}
+
+	files := []string{}
+	if _, err := os.Stat(saveFile); saveFile != "" && err == nil {
+		files = append(files, saveFile)
+	} else {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(matches)
+		files = append(files, matches...)
+	}
+
+	rules := []*mongo.SiteRuleModel{}
+	for _, file := range files {
+		fileRules, err := readRuleFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read rule file %v error: %w", file, err)
+		}
+		hlog.CtxInfof(ctx, "load site rule file: %v, num rules: %v", file, len(fileRules))
+		rules = append(rules, fileRules...)
+	}
+
+	m := NewMemoryRuleStore(rules)
+	if saveFile != "" {
+		m.onChange = func(rules []*mongo.SiteRuleModel) error {
+			return writeRuleFile(saveFile, rules)
+		}
+	}
+	return m, nil
+}
+
+func projectFilePath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(conf.GetProjectPath(), path)
+}
+
+func readRuleFile(file string) ([]*mongo.SiteRuleModel, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	rules := []*wcd_manage.SiteRuleData{}
+	if err := sonic.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	return utils.Map(rules, func(rule *wcd_manage.SiteRuleData) *mongo.SiteRuleModel {
+		return (&mongo.SiteRuleModel{}).FromThrift(rule)
+	}), nil
+}
+
+// writeRuleFile 先写临时文件再重命名，避免写入中途退出导致文件损坏
+func writeRuleFile(file string, rules []*mongo.SiteRuleModel) error {
+	data, err := sonic.Marshal(utils.Map(rules, func(rule *mongo.SiteRuleModel) *wcd_manage.SiteRuleData {
+		return rule.ToThrift()
+	}))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	tmpFile := file + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, file)
+}
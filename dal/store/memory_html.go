@@ -0,0 +1,132 @@
+package store
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+const defaultHtmlCacheEntries = 1000
+
+// MemoryHtmlCache 每个url只保留最新抓取的网页，超过容量时淘汰最久未使用的
+type MemoryHtmlCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	items      map[string]*list.Element
+	aliases    map[string]string // 别名到url
+	lru        *list.List        // 元素为*mongo.CrawlHtmlModel，最近使用的在前
+}
+
+func NewMemoryHtmlCache(maxEntries int) *MemoryHtmlCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultHtmlCacheEntries
+	}
+	return &MemoryHtmlCache{
+		maxEntries: maxEntries,
+		items:      map[string]*list.Element{},
+		aliases:    map[string]string{},
+		lru:        list.New(),
+	}
+}
+
+func (m *MemoryHtmlCache) FindByUrl(ctx context.Context, url string, expireDuration time.Duration) (*mongo.CrawlHtmlModel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	elem, ok := m.items[url]
+	if !ok {
+		elem, ok = m.items[m.aliases[url]]
+	}
+	if !ok {
+		return nil, mongodriver.ErrNoDocuments
+	}
+	model := *elem.Value.(*mongo.CrawlHtmlModel)
+	if model.CreateTime.Before(time.Now().Add(-1 * expireDuration)) {
+		return nil, mongodriver.ErrNoDocuments
+	}
+	m.lru.MoveToFront(elem)
+	hlog.CtxInfof(ctx, "find cached html by url: %s", url)
+	return &model, nil
+}
+
+func (m *MemoryHtmlCache) SaveOne(ctx context.Context, model mongo.CrawlHtmlModel) error {
+	if model.Url == "" || model.Html == "" {
+		return errors.New("url or html is empty")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, alias := range model.Aliases {
+		m.aliases[alias] = model.Url
+	}
+	if elem, ok := m.items[model.Url]; ok {
+		// 与mongo一致，别名合并到已有的别名中
+		model.Aliases = slices.Clone(model.Aliases)
+		for _, alias := range elem.Value.(*mongo.CrawlHtmlModel).Aliases {
+			if !utils.Contains(model.Aliases, alias) {
+				model.Aliases = append(model.Aliases, alias)
+			}
+		}
+		elem.Value = &model
+		m.lru.MoveToFront(elem)
+		return nil
+	}
+	m.items[model.Url] = m.lru.PushFront(&model)
+	for m.lru.Len() > m.maxEntries {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		evicted := oldest.Value.(*mongo.CrawlHtmlModel)
+		delete(m.items, evicted.Url)
+		for _, alias := range evicted.Aliases {
+			if m.aliases[alias] == evicted.Url {
+				delete(m.aliases, alias)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *MemoryHtmlCache) ListByUrlRegex(ctx context.Context, pattern string, limit int) ([]*mongo.CrawlHtmlModel, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	models := []*mongo.CrawlHtmlModel{}
+	for elem := m.lru.Front(); elem != nil; elem = elem.Next() {
+		model := *elem.Value.(*mongo.CrawlHtmlModel)
+		if re.MatchString(model.Url) {
+			models = append(models, &model)
+		}
+	}
+	slices.SortStableFunc(models, func(a, b *mongo.CrawlHtmlModel) int {
+		return b.CreateTime.Compare(a.CreateTime)
+	})
+	if limit > 0 && len(models) > limit {
+		models = models[:limit]
+	}
+	return models, nil
+}
+
+// noneHtmlCache 不缓存网页，每次都重新抓取
+type noneHtmlCache struct{}
+
+func (noneHtmlCache) FindByUrl(ctx context.Context, url string, expireDuration time.Duration) (*mongo.CrawlHtmlModel, error) {
+	return nil, mongodriver.ErrNoDocuments
+}
+
+func (noneHtmlCache) SaveOne(ctx context.Context, model mongo.CrawlHtmlModel) error {
+	return nil
+}
+
+func (noneHtmlCache) ListByUrlRegex(ctx context.Context, pattern string, limit int) ([]*mongo.CrawlHtmlModel, error) {
+	return []*mongo.CrawlHtmlModel{}, nil
+}
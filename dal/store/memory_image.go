@@ -0,0 +1,91 @@
+package store
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+)
+
+const defaultImageMappingEntries = 10000
+
+// MemoryImageMapping 按原地址保存图片的镜像地址，超过容量时淘汰最久未使用的
+type MemoryImageMapping struct {
+	mu         sync.Mutex
+	maxEntries int
+	items      map[string]*list.Element
+	lru        *list.List // 元素为*mongo.CrawlImageModel，最近使用的在前
+}
+
+func NewMemoryImageMapping(maxEntries int) *MemoryImageMapping {
+	if maxEntries <= 0 {
+		maxEntries = defaultImageMappingEntries
+	}
+	return &MemoryImageMapping{
+		maxEntries: maxEntries,
+		items:      map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+func (m *MemoryImageMapping) FindBySrcImgUrls(ctx context.Context, imgUrls []string) ([]*mongo.CrawlImageModel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var models []*mongo.CrawlImageModel
+	for _, imgUrl := range imgUrls {
+		elem, ok := m.items[imgUrl]
+		if !ok {
+			continue
+		}
+		model := *elem.Value.(*mongo.CrawlImageModel)
+		if model.Status != consts.StatusValid {
+			continue
+		}
+		m.lru.MoveToFront(elem)
+		models = append(models, &model)
+	}
+	return models, nil
+}
+
+func (m *MemoryImageMapping) UpsertMany(ctx context.Context, models []mongo.CrawlImageModel) error {
+	for _, model := range models {
+		if model.SrcImgUrl == "" || model.OssImgUrl == "" {
+			return errors.New("src img url or oss img url is empty")
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for _, model := range models {
+		model.UpdateTime = now
+		if elem, ok := m.items[model.SrcImgUrl]; ok {
+			model.CreateTime = elem.Value.(*mongo.CrawlImageModel).CreateTime
+			elem.Value = &model
+			m.lru.MoveToFront(elem)
+			continue
+		}
+		model.CreateTime = now
+		m.items[model.SrcImgUrl] = m.lru.PushFront(&model)
+	}
+	for m.lru.Len() > m.maxEntries {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		delete(m.items, oldest.Value.(*mongo.CrawlImageModel).SrcImgUrl)
+	}
+	return nil
+}
+
+// noneImageMapping 不保存映射，每次都重新下载图片
+type noneImageMapping struct{}
+
+func (noneImageMapping) FindBySrcImgUrls(ctx context.Context, imgUrls []string) ([]*mongo.CrawlImageModel, error) {
+	return nil, nil
+}
+
+func (noneImageMapping) UpsertMany(ctx context.Context, models []mongo.CrawlImageModel) error {
+	return nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+const defaultResultCacheEntries = 1000
+
+// MemoryResultCache 按key缓存解析结果，超过容量时淘汰最久未使用的
+type MemoryResultCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	items      map[string]*list.Element
+	lru        *list.List // 元素为*mongo.ParseResultModel，最近使用的在前
+}
+
+func NewMemoryResultCache(maxEntries int) *MemoryResultCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultResultCacheEntries
+	}
+	return &MemoryResultCache{
+		maxEntries: maxEntries,
+		items:      map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+func (m *MemoryResultCache) FindByKey(ctx context.Context, key string, expireDuration time.Duration) (*mongo.ParseResultModel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	elem, ok := m.items[key]
+	if !ok {
+		return nil, mongodriver.ErrNoDocuments
+	}
+	model := *elem.Value.(*mongo.ParseResultModel)
+	if model.CreateTime.Before(time.Now().Add(-1 * expireDuration)) {
+		return nil, mongodriver.ErrNoDocuments
+	}
+	m.lru.MoveToFront(elem)
+	return &model, nil
+}
+
+func (m *MemoryResultCache) SaveOne(ctx context.Context, model mongo.ParseResultModel) error {
+	if model.Key == "" || model.Result == "" {
+		return errors.New("key or result is empty")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if elem, ok := m.items[model.Key]; ok {
+		elem.Value = &model
+		m.lru.MoveToFront(elem)
+		return nil
+	}
+	m.items[model.Key] = m.lru.PushFront(&model)
+	for m.lru.Len() > m.maxEntries {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		delete(m.items, oldest.Value.(*mongo.ParseResultModel).Key)
+	}
+	return nil
+}
+
+// noneResultCache 不缓存解析结果
+type noneResultCache struct{}
+
+func (noneResultCache) FindByKey(ctx context.Context, key string, expireDuration time.Duration) (*mongo.ParseResultModel, error) {
+	return nil, mongodriver.ErrNoDocuments
+}
+
+func (noneResultCache) SaveOne(ctx context.Context, model mongo.ParseResultModel) error {
+	return nil
+}
@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+// MemoryRevisionStore 规则版本保存在内存中，重启后丢失
+type MemoryRevisionStore struct {
+	mu        sync.RWMutex
+	revisions map[string][]mongo.SiteRuleRevisionModel // host -> 按版本号从旧到新
+}
+
+func NewMemoryRevisionStore() *MemoryRevisionStore {
+	return &MemoryRevisionStore{revisions: map[string][]mongo.SiteRuleRevisionModel{}}
+}
+
+func (m *MemoryRevisionStore) Insert(ctx context.Context, model *mongo.SiteRuleRevisionModel) error {
+	if model.Host == "" {
+		return errors.New("host is empty")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	model.Revision = int64(len(m.revisions[model.Host]) + 1)
+	m.revisions[model.Host] = append(m.revisions[model.Host], *model)
+	return nil
+}
+
+func (m *MemoryRevisionStore) ListByHost(ctx context.Context, host string, limit int) ([]mongo.SiteRuleRevisionModel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	revisions := slices.Clone(m.revisions[host])
+	slices.Reverse(revisions)
+	if limit > 0 && len(revisions) > limit {
+		revisions = revisions[:limit]
+	}
+	return revisions, nil
+}
+
+func (m *MemoryRevisionStore) FindOne(ctx context.Context, host string, revision int64) (*mongo.SiteRuleRevisionModel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	revisions := m.revisions[host]
+	if revision <= 0 || revision > int64(len(revisions)) {
+		return nil, mongodriver.ErrNoDocuments
+	}
+	model := revisions[revision-1]
+	return &model, nil
+}
@@ -0,0 +1,180 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/utils"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+// MemoryRuleStore 规则保存在内存中，只保存有效的规则，删除即移除
+type MemoryRuleStore struct {
+	mu    sync.RWMutex
+	rules []*mongo.SiteRuleModel
+
+	// onChange 规则修改后调用，用于持久化
+	onChange func(rules []*mongo.SiteRuleModel) error
+}
+
+func NewMemoryRuleStore(rules []*mongo.SiteRuleModel) *MemoryRuleStore {
+	m := &MemoryRuleStore{rules: []*mongo.SiteRuleModel{}}
+	for _, rule := range rules {
+		m.put(rule)
+	}
+	return m
+}
+
+func (m *MemoryRuleStore) indexOf(host string, ruleStage consts.RuleStage) int {
+	for i, rule := range m.rules {
+		if rule.Host == host && rule.Stage == ruleStage {
+			return i
+		}
+	}
+	return -1
+}
+
+// put 按host和stage覆盖
+func (m *MemoryRuleStore) put(model *mongo.SiteRuleModel) {
+	rule := *model
+	rule.Status = consts.StatusValid
+	if i := m.indexOf(rule.Host, rule.Stage); i >= 0 {
+		m.rules[i] = &rule
+	} else {
+		m.rules = append(m.rules, &rule)
+	}
+}
+
+func (m *MemoryRuleStore) filter(keep func(rule *mongo.SiteRuleModel) bool) []mongo.SiteRuleModel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	rules := []mongo.SiteRuleModel{}
+	for _, rule := range m.rules {
+		if keep(rule) {
+			rules = append(rules, *rule)
+		}
+	}
+	return rules
+}
+
+// changed 调用时需持有写锁
+func (m *MemoryRuleStore) changed() error {
+	if m.onChange == nil {
+		return nil
+	}
+	return m.onChange(m.rules)
+}
+
+func (m *MemoryRuleStore) ListAll(ctx context.Context) ([]mongo.SiteRuleModel, error) {
+	return m.filter(func(rule *mongo.SiteRuleModel) bool {
+		return true
+	}), nil
+}
+
+func (m *MemoryRuleStore) FindMany(ctx context.Context, ruleStage consts.RuleStage) ([]mongo.SiteRuleModel, error) {
+	return m.filter(func(rule *mongo.SiteRuleModel) bool {
+		return rule.Stage == ruleStage
+	}), nil
+}
+
+func (m *MemoryRuleStore) FindManyTestingRules(ctx context.Context, hosts []string) ([]mongo.SiteRuleModel, error) {
+	return m.filter(func(rule *mongo.SiteRuleModel) bool {
+		return rule.Stage == consts.RuleStageTesting && (len(hosts) == 0 || utils.Contains(hosts, rule.Host))
+	}), nil
+}
+
+func (m *MemoryRuleStore) FindOne(ctx context.Context, host string, ruleStage consts.RuleStage) (*mongo.SiteRuleModel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	i := m.indexOf(host, ruleStage)
+	if i < 0 {
+		return nil, mongodriver.ErrNoDocuments
+	}
+	rule := *m.rules[i]
+	return &rule, nil
+}
+
+func (m *MemoryRuleStore) Exists(ctx context.Context, host string, ruleStage consts.RuleStage) bool {
+	rule, err := m.FindOne(ctx, host, ruleStage)
+	return rule != nil && err == nil
+}
+
+func (m *MemoryRuleStore) SaveMany(ctx context.Context, models []*mongo.SiteRuleModel) error {
+	if len(models) == 0 {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, model := range models {
+		m.put(model)
+	}
+	return m.changed()
+}
+
+func (m *MemoryRuleStore) UpsertMany(ctx context.Context, models []*mongo.SiteRuleModel) error {
+	if len(models) == 0 {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for _, model := range models {
+		i := m.indexOf(model.Host, model.Stage)
+		if i < 0 {
+			rule := *model
+			rule.CreateTime = now
+			rule.UpdateTime = now
+			m.put(&rule)
+			continue
+		}
+		// 和mongo实现一样，只更新规则内容，保留创建时间
+		rule := *m.rules[i]
+		rule.HostName = model.HostName
+		rule.MatchType = model.MatchType
+		rule.HostPattern = model.HostPattern
+		rule.PathPattern = model.PathPattern
+		rule.Priority = model.Priority
+		rule.Bodies = model.Bodies
+		rule.Noises = model.Noises
+		rule.Author = model.Author
+		rule.PubTime = model.PubTime
+		rule.Title = model.Title
+		rule.ReservedNodes = model.ReservedNodes
+		rule.NoSemanticDenoise = model.NoSemanticDenoise
+		rule.NeedBrowserCrawl = model.NeedBrowserCrawl
+		rule.BodyUseRuleOnly = model.BodyUseRuleOnly
+		rule.Labeler = model.Labeler
+		rule.CrawlBackends = model.CrawlBackends
+		rule.CrawlHeaders = model.CrawlHeaders
+		rule.CrawlCookie = model.CrawlCookie
+		rule.CrawlTimeout = model.CrawlTimeout
+		rule.CrawlQps = model.CrawlQps
+		rule.CrawlMaxInFlight = model.CrawlMaxInFlight
+		rule.UpdateTime = now
+		m.rules[i] = &rule
+	}
+	return m.changed()
+}
+
+func (m *MemoryRuleStore) DeleteMany(ctx context.Context, models []mongo.SiteRuleModel) error {
+	if len(models) == 0 {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, model := range models {
+		if i := m.indexOf(model.Host, model.Stage); i >= 0 {
+			m.rules = append(m.rules[:i], m.rules[i+1:]...)
+		}
+	}
+	return m.changed()
+}
+
+// Watch 规则只会通过本实例修改，修改后规则索引会主动刷新，无需监听
+func (m *MemoryRuleStore) Watch(ctx context.Context, onChange func()) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
@@ -0,0 +1,217 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	TypeMongo  = "mongo"
+	TypeMemory = "memory"
+	TypeFile   = "file"
+	TypeNone   = "none"
+)
+
+// RuleStore 站点规则的存储。查询不到规则时返回mongo.ErrNoDocuments，与mongo实现保持一致
+type RuleStore interface {
+	ListAll(ctx context.Context) ([]mongo.SiteRuleModel, error)
+	FindMany(ctx context.Context, ruleStage consts.RuleStage) ([]mongo.SiteRuleModel, error)
+	FindManyTestingRules(ctx context.Context, hosts []string) ([]mongo.SiteRuleModel, error)
+	FindOne(ctx context.Context, host string, ruleStage consts.RuleStage) (*mongo.SiteRuleModel, error)
+	Exists(ctx context.Context, host string, ruleStage consts.RuleStage) bool
+	// SaveMany 按host和stage整条覆盖，用于导入
+	SaveMany(ctx context.Context, models []*mongo.SiteRuleModel) error
+	// UpsertMany 按host和stage更新规则内容，不存在时创建
+	UpsertMany(ctx context.Context, models []*mongo.SiteRuleModel) error
+	DeleteMany(ctx context.Context, models []mongo.SiteRuleModel) error
+	// Watch 监听规则变更，阻塞直到ctx结束或监听出错
+	Watch(ctx context.Context, onChange func()) error
+}
+
+// RevisionStore 规则的版本记录，只追加不修改。查询不到时返回mongo.ErrNoDocuments
+type RevisionStore interface {
+	// Insert 分配版本号后写入
+	Insert(ctx context.Context, model *mongo.SiteRuleRevisionModel) error
+	// ListByHost 按版本号从新到旧，limit<=0时不限制
+	ListByHost(ctx context.Context, host string, limit int) ([]mongo.SiteRuleRevisionModel, error)
+	FindOne(ctx context.Context, host string, revision int64) (*mongo.SiteRuleRevisionModel, error)
+}
+
+// HtmlCache 抓取到的网页缓存。未命中时返回mongo.ErrNoDocuments
+type HtmlCache interface {
+	FindByUrl(ctx context.Context, url string, expireDuration time.Duration) (*mongo.CrawlHtmlModel, error)
+	SaveOne(ctx context.Context, model mongo.CrawlHtmlModel) error
+	// ListByUrlRegex 按缓存时间从新到旧，limit<=0时不限制
+	ListByUrlRegex(ctx context.Context, pattern string, limit int) ([]*mongo.CrawlHtmlModel, error)
+}
+
+// ResultCache 解析结果缓存。未命中时返回mongo.ErrNoDocuments
+type ResultCache interface {
+	FindByKey(ctx context.Context, key string, expireDuration time.Duration) (*mongo.ParseResultModel, error)
+	// SaveOne 按key覆盖
+	SaveOne(ctx context.Context, model mongo.ParseResultModel) error
+}
+
+// ImageMapping 图片原地址到镜像地址的映射
+type ImageMapping interface {
+	FindBySrcImgUrls(ctx context.Context, imgUrls []string) ([]*mongo.CrawlImageModel, error)
+	// UpsertMany 按src_img_url更新，不存在时创建
+	UpsertMany(ctx context.Context, models []mongo.CrawlImageModel) error
+}
+
+var (
+	SiteRules     RuleStore     = mongo.SiteRuleModelDal
+	RuleRevisions RevisionStore = mongo.SiteRuleRevisionModelDal
+	CrawlHtml     HtmlCache     = mongo.CrawlHtmlModelDal
+	ParseResult   ResultCache   = mongo.ParseResultModelDal
+	CrawlImage    ImageMapping  = mongo.CrawlImageModelDal
+)
+
+// Init 按配置选择存储。配置为mongo但mongo不可用时返回错误，只有配置了fallback为memory时才退化为内存存储，
+// 避免规则为空时服务照常启动、管理端的修改只写入内存
+func Init(ctx context.Context) error {
+	c := conf.GetConfig().Store
+	var err error
+	if SiteRules, err = newRuleStore(ctx, c.Rule); err != nil {
+		return err
+	}
+	RuleRevisions = newRevisionStore(ctx)
+	if CrawlHtml, err = newHtmlCache(ctx, c.HtmlCache); err != nil {
+		return err
+	}
+	if ParseResult, err = newResultCache(ctx, c.ResultCache); err != nil {
+		return err
+	}
+	if CrawlImage, err = newImageMapping(ctx, c.CrawlImage); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkMongoFallback mongo不可用时，fallback为memory返回nil，由调用方使用内存存储，否则返回错误
+func checkMongoFallback(ctx context.Context, name string, fallback string) error {
+	if fallback != TypeMemory {
+		return fmt.Errorf("mongo is not ready, %v requires mongo, set fallback to memory to start without it", name)
+	}
+	hlog.CtxErrorf(ctx, "mongo is not ready, %v falls back to memory", name)
+	return nil
+}
+
+func newRuleStore(ctx context.Context, c conf.RuleStore) (RuleStore, error) {
+	switch c.Type {
+	case TypeMemory:
+		hlog.CtxInfof(ctx, "site rule store: memory")
+		return NewMemoryRuleStore(nil), nil
+	case TypeFile:
+		s, err := NewFileRuleStore(ctx, c.Files, c.SaveFile)
+		if err != nil {
+			hlog.CtxErrorf(ctx, "load site rule files error, use empty memory store: %v", err)
+			return NewMemoryRuleStore(nil), nil
+		}
+		hlog.CtxInfof(ctx, "site rule store: file")
+		return s, nil
+	case TypeMongo, "":
+	default:
+		hlog.CtxErrorf(ctx, "unknown site rule store type: %v, use mongo", c.Type)
+	}
+	if !mongo.Ready() {
+		if err := checkMongoFallback(ctx, "site rule store", c.Fallback); err != nil {
+			return nil, err
+		}
+		return NewMemoryRuleStore(nil), nil
+	}
+	return mongo.SiteRuleModelDal, nil
+}
+
+func newHtmlCache(ctx context.Context, c conf.HtmlCache) (HtmlCache, error) {
+	switch c.Type {
+	case TypeMemory:
+		hlog.CtxInfof(ctx, "html cache: memory")
+		return NewMemoryHtmlCache(c.MaxEntries), nil
+	case TypeNone:
+		hlog.CtxInfof(ctx, "html cache: none")
+		return noneHtmlCache{}, nil
+	case TypeMongo, "":
+	default:
+		hlog.CtxErrorf(ctx, "unknown html cache type: %v, use mongo", c.Type)
+	}
+	if !mongo.Ready() {
+		if err := checkMongoFallback(ctx, "html cache", c.Fallback); err != nil {
+			return nil, err
+		}
+		return NewMemoryHtmlCache(c.MaxEntries), nil
+	}
+	// 超过缓存时间和重新验证时间的网页不会再使用
+	crawlCfg := conf.GetConfig().Parse.Crawl
+	ttl := time.Hour * time.Duration(crawlCfg.HtmlCacheHours+max(crawlCfg.HtmlRevalidateHours, 0))
+	if err := mongo.CrawlHtmlModelDal.CreateIndexes(ctx, ttl); err != nil {
+		hlog.CtxErrorf(ctx, "create crawl html indexes error: %v", err)
+	}
+	return mongo.CrawlHtmlModelDal, nil
+}
+
+func newResultCache(ctx context.Context, c conf.HtmlCache) (ResultCache, error) {
+	switch c.Type {
+	case TypeMemory:
+		hlog.CtxInfof(ctx, "result cache: memory")
+		return NewMemoryResultCache(c.MaxEntries), nil
+	case TypeNone:
+		hlog.CtxInfof(ctx, "result cache: none")
+		return noneResultCache{}, nil
+	case TypeMongo, "":
+	default:
+		hlog.CtxErrorf(ctx, "unknown result cache type: %v, use mongo", c.Type)
+	}
+	if !mongo.Ready() {
+		if err := checkMongoFallback(ctx, "result cache", c.Fallback); err != nil {
+			return nil, err
+		}
+		return NewMemoryResultCache(c.MaxEntries), nil
+	}
+	if err := mongo.ParseResultModelDal.CreateIndexes(ctx); err != nil {
+		hlog.CtxErrorf(ctx, "create parse result indexes error: %v", err)
+	}
+	return mongo.ParseResultModelDal, nil
+}
+
+func newImageMapping(ctx context.Context, c conf.HtmlCache) (ImageMapping, error) {
+	switch c.Type {
+	case TypeMemory:
+		hlog.CtxInfof(ctx, "image mapping: memory")
+		return NewMemoryImageMapping(c.MaxEntries), nil
+	case TypeNone:
+		hlog.CtxInfof(ctx, "image mapping: none")
+		return noneImageMapping{}, nil
+	case TypeMongo, "":
+	default:
+		hlog.CtxErrorf(ctx, "unknown image mapping type: %v, use mongo", c.Type)
+	}
+	if !mongo.Ready() {
+		if err := checkMongoFallback(ctx, "image mapping", c.Fallback); err != nil {
+			return nil, err
+		}
+		return NewMemoryImageMapping(c.MaxEntries), nil
+	}
+	if err := mongo.CrawlImageModelDal.CreateIndexes(ctx); err != nil {
+		hlog.CtxErrorf(ctx, "create crawl image indexes error: %v", err)
+	}
+	return mongo.CrawlImageModelDal, nil
+}
+
+// newRevisionStore 规则存储为mongo时版本也保存在mongo中，否则保存在内存中
+func newRevisionStore(ctx context.Context) RevisionStore {
+	if SiteRules != RuleStore(mongo.SiteRuleModelDal) {
+		hlog.CtxInfof(ctx, "site rule revision store: memory")
+		return NewMemoryRevisionStore()
+	}
+	if err := mongo.SiteRuleRevisionModelDal.CreateIndexes(ctx); err != nil {
+		hlog.CtxErrorf(ctx, "create site rule revision indexes error: %v", err)
+	}
+	return mongo.SiteRuleRevisionModelDal
+}
@@ -3,7 +3,7 @@ from pydantic import BaseModel
 import requests
 from fastapi import FastAPI
 from utils import R, simple_crawl
-from typing import Optional
+from typing import Dict, Optional
 
 import argparse
 
@@ -12,15 +12,24 @@ app = FastAPI()
 class CrawlRequest(BaseModel):
     url: str
     force_browser: Optional[bool] = False
+    headers: Optional[Dict[str, str]] = None  # 站点规则中配置的请求头
+    cookie: Optional[str] = None
+    timeout: Optional[int] = None  # 秒
 
 @app.post("/crawl")
 async def crawler(req: CrawlRequest):
     url = req.url
-    html = simple_crawl(url)
-    if html:
+    response = simple_crawl(url, headers=req.headers, cookie=req.cookie, timeout=req.timeout)
+    if response is not None:
+        # 非200的网页也返回，由调用方按status_code处理
+        headers = {k: v for k, v in response.headers.items() if k.lower() != 'set-cookie'}
         data = {
             "url": url,
-            "html": html,
+            "html": response.text,
+            "final_url": response.url,
+            "status_code": response.status_code,
+            "headers": headers,
+            "content_type": response.headers.get('Content-Type', ''),
         }
         return R.success(data)
     else:
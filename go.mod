@@ -11,7 +11,9 @@ replace github.com/DeepLangAI/go_lib => ./go_lib
 require (
 	github.com/ChrisTrenkamp/xsel v0.9.16
 	github.com/DeepLangAI/go_lib v0.0.0-00010101000000-000000000000
+	github.com/andybalholm/brotli v1.1.1
 	github.com/antchfx/xmlquery v1.4.3
+	github.com/antchfx/xpath v1.3.3
 	github.com/apache/thrift v0.13.0
 	github.com/beevik/etree v1.5.0
 	github.com/bytedance/sonic v1.12.0
@@ -26,7 +28,6 @@ require (
 )
 
 require (
-	github.com/antchfx/xpath v1.3.3 // indirect
 	github.com/bytedance/gopkg v0.1.0 // indirect
 	github.com/bytedance/sonic/loader v0.2.0 // indirect
 	github.com/cespare/xxhash/v2 v2.2.0 // indirect
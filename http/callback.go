@@ -0,0 +1,52 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DeepLangAI/go_lib/middleware"
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/app/client"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+var callbackClient *client.Client
+
+func InitCallbackClient() {
+	cli, err := client.NewClient()
+	if err != nil {
+		panic(err)
+	}
+	// 回调地址由调用方提供，2xx均视为成功，不使用ResponseCheckClientMiddleware
+	cli.Use(middleware.TraceClientMiddleware)
+	callbackClient = cli
+}
+
+// PostCallback 以json格式推送body到调用方提供的回调地址
+func PostCallback(ctx context.Context, url string, body any, timeout time.Duration) error {
+	bodyStr, err := sonic.MarshalString(body)
+	if err != nil {
+		return err
+	}
+
+	req := protocol.AcquireRequest()
+	resp := protocol.AcquireResponse()
+	defer func() {
+		protocol.ReleaseRequest(req)
+		protocol.ReleaseResponse(resp)
+	}()
+	req.SetBody([]byte(bodyStr))
+	req.SetMethod(consts.MethodPost)
+	req.SetRequestURI(url)
+	req.SetHeader(consts.HeaderContentType, consts.MIMEApplicationJSON)
+
+	if err = callbackClient.DoTimeout(ctx, req, resp, timeout); err != nil {
+		return err
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return fmt.Errorf("callback failed, status code: %d", resp.StatusCode())
+	}
+	return nil
+}
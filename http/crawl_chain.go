@@ -0,0 +1,186 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/DeepLangAI/wcd/conf"
+	errs "github.com/cloudwego/hertz/pkg/common/errors"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	defaultRetryBackoff    = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 5 * time.Second
+)
+
+// StatusError 网页或抓取服务返回了非2xx的状态码
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("crawl failed, status code: %d", e.StatusCode)
+}
+
+// CrawlAttempt 一次抓取尝试的记录
+type CrawlAttempt struct {
+	Backend    string
+	Attempt    int // 同一后端的第几次尝试，从1开始
+	Err        error
+	Cost       time.Duration
+	FinalUrl   string
+	StatusCode int // 网页的http状态码，抓取失败时为0
+}
+
+// ResolveChain 按名称获取抓取链，names为空时使用全局配置。不存在的后端会被忽略
+func ResolveChain(ctx context.Context, names []string) []Crawler {
+	if len(names) == 0 {
+		names = conf.GetConfig().Parse.Crawl.Chain
+	}
+	if len(names) == 0 {
+		names = []string{defaultCrawler().Name(), CrawlerBackend_Browser}
+	}
+	chain := []Crawler{}
+	for _, name := range names {
+		crawler := GetCrawler(name)
+		if crawler == nil {
+			hlog.CtxWarnf(ctx, "crawler not found, skip, name: %v", name)
+			continue
+		}
+		chain = append(chain, crawler)
+	}
+	return chain
+}
+
+// NewCrawlExtraParams 解析站点规则中的抓取配置，headers每项格式为"Name: value"，timeout如"30s"
+func NewCrawlExtraParams(headers []string, cookie string, timeout string) (*CrawlExtraParams, error) {
+	ext := &CrawlExtraParams{Cookie: strings.TrimSpace(cookie)}
+	for _, line := range headers {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		key, value, found := strings.Cut(line, ":")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid header: %v", line)
+		}
+		if ext.Headers == nil {
+			ext.Headers = map[string]string{}
+		}
+		ext.Headers[key] = strings.TrimSpace(value)
+	}
+	if timeout = strings.TrimSpace(timeout); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid timeout: %v", timeout)
+		}
+		ext.Timeout = d
+	}
+	return ext, nil
+}
+
+// CrawlWithRetry 使用指定后端抓取，遇到临时错误时按指数退避重试，返回每次尝试的记录
+func CrawlWithRetry(ctx context.Context, crawler Crawler, url string, ext *CrawlExtraParams) (*CrawlHtmlResp, []CrawlAttempt, error) {
+	retry := conf.GetConfig().Parse.Crawl.Retry
+	maxAttempts := max(retry.MaxAttempts, 1)
+
+	attempts := []CrawlAttempt{}
+	for i := 1; ; i++ {
+		timeBegin := time.Now()
+		resp, err := crawlOnce(ctx, crawler, url, ext)
+		attempt := CrawlAttempt{
+			Backend: crawler.Name(),
+			Attempt: i,
+			Err:     err,
+			Cost:    time.Since(timeBegin),
+		}
+		var statusErr *StatusError
+		if err == nil {
+			attempt.FinalUrl = resp.Data.FinalUrl
+			attempt.StatusCode = resp.Data.StatusCode
+		} else if errors.As(err, &statusErr) {
+			attempt.StatusCode = statusErr.StatusCode
+		}
+		attempts = append(attempts, attempt)
+		if err == nil || i >= maxAttempts || !IsTransientErr(ctx, err) {
+			return resp, attempts, err
+		}
+
+		backoff := retryBackoff(retry, i)
+		hlog.CtxInfof(ctx, "CrawlHtml retry after %v, crawler: %v, attempt: %v, url: %v", backoff, crawler.Name(), i, url)
+		select {
+		case <-ctx.Done():
+			return nil, attempts, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// retryBackoff 第n次失败后的等待时间，每次翻倍，在[d/2, d)之间随机
+func retryBackoff(retry conf.CrawlRetry, n int) time.Duration {
+	base := time.Duration(retry.BackoffMs) * time.Millisecond
+	if base <= 0 {
+		base = defaultRetryBackoff
+	}
+	maxBackoff := time.Duration(retry.MaxBackoffMs) * time.Millisecond
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+	d := base << (n - 1)
+	if d > maxBackoff || d <= 0 {
+		d = maxBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// isTransientStatus 408、429和5xx，重试可能成功
+func isTransientStatus(statusCode int) bool {
+	return statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+// IsTransientErr 超时、连接失败、429和5xx等重试可能成功的错误。ctx已结束时不再重试
+func IsTransientErr(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return isTransientStatus(statusErr.StatusCode)
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	// 建立连接失败
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	for _, target := range []error{
+		context.DeadlineExceeded,
+		syscall.ECONNREFUSED,
+		syscall.ECONNRESET,
+		io.EOF,
+		io.ErrUnexpectedEOF,
+		errs.ErrTimeout,
+		errs.ErrConnectionClosed,
+		errs.ErrBadPoolConn,
+		errs.ErrNoFreeConns,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/DeepLangAI/go_lib/middleware"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/DeepLangAI/wcd/conf"
@@ -18,7 +19,25 @@ import (
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 )
 
-var crawlerClient *client.Client
+const (
+	CrawlerBackend_Remote  = "remote"
+	CrawlerBackend_Browser = "browser"
+	CrawlerBackend_Native  = "native"
+)
+
+// Crawler 抓取网页的后端
+type Crawler interface {
+	// Name 后端名称，与配置中的name一致
+	Name() string
+	// Browser 是否使用浏览器渲染
+	Browser() bool
+	Crawl(ctx context.Context, url string, ext *CrawlExtraParams) (*CrawlHtmlResp, error)
+}
+
+var (
+	crawlerClient *client.Client
+	crawlers      map[string]Crawler
+)
 
 func InitCrawlerClient() {
 	cli, err := client.NewClient()
@@ -30,32 +49,150 @@ func InitCrawlerClient() {
 		middleware.ResponseCheckClientMiddleware,
 	}...)
 	crawlerClient = cli
+
+	crawlCfg := conf.GetConfig().Parse.Crawl
+	crawlers = map[string]Crawler{
+		CrawlerBackend_Remote:  &RemoteCrawler{name: CrawlerBackend_Remote},
+		CrawlerBackend_Browser: &RemoteCrawler{name: CrawlerBackend_Browser, browser: true},
+		CrawlerBackend_Native:  NewNativeFetcher(CrawlerBackend_Native, crawlCfg.Native),
+	}
+	for _, c := range crawlCfg.Backends {
+		crawler, err := newCrawler(c)
+		if err != nil {
+			hlog.Errorf("register crawler failed, name: %v, err: %v", c.Name, err)
+			continue
+		}
+		crawlers[c.Name] = crawler
+	}
+}
+
+func newCrawler(c conf.CrawlerConfig) (Crawler, error) {
+	if c.Name == "" {
+		return nil, fmt.Errorf("crawler name is empty")
+	}
+	switch c.Type {
+	case CrawlerBackend_Remote:
+		return &RemoteCrawler{
+			name:    c.Name,
+			host:    c.Host,
+			browser: c.Browser,
+			timeout: time.Duration(c.TimeoutSeconds) * time.Second,
+		}, nil
+	case CrawlerBackend_Native:
+		return NewNativeFetcher(c.Name, c.Native), nil
+	}
+	return nil, fmt.Errorf("unknown crawler type: %v", c.Type)
+}
+
+// GetCrawler 按名称获取抓取后端，不存在时返回nil
+func GetCrawler(name string) Crawler {
+	return crawlers[name]
+}
+
+// defaultCrawler 配置的backend，不存在时使用remote
+func defaultCrawler() Crawler {
+	if crawler := GetCrawler(conf.GetConfig().Parse.Crawl.Backend); crawler != nil {
+		return crawler
+	}
+	return GetCrawler(CrawlerBackend_Remote)
 }
 
 type crawlHtmlReq struct {
-	Url          string `json:"url"`
-	ForceBrowser *bool  `json:"force_browser,omitempty"`
+	Url          string            `json:"url"`
+	ForceBrowser *bool             `json:"force_browser,omitempty"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Cookie       string            `json:"cookie,omitempty"`
+	Timeout      int               `json:"timeout,omitempty"` // 秒
 }
 
 type CrawlHtmlResp struct {
-	Code int    `json:"code"`
-	Msg  string `json:"msg"`
-	Data struct {
-		Html string `json:"html"`
-	} `json:"data"`
+	Code int           `json:"code"`
+	Msg  string        `json:"msg"`
+	Data CrawlHtmlData `json:"data"`
+}
+
+// CrawlHtmlData 抓取到的网页和响应信息
+type CrawlHtmlData struct {
+	Html        string            `json:"html"`
+	FinalUrl    string            `json:"final_url"`    // 重定向后的最终url
+	StatusCode  int               `json:"status_code"`  // 网页的http状态码，抓取服务未返回时按200处理
+	Headers     map[string]string `json:"headers"`      // 网页的响应头，不含Set-Cookie
+	ContentType string            `json:"content_type"` // 为空时从headers中读取
+	FetchTime   time.Time         `json:"-"`            // 抓取完成的时间
+}
+
+// OK 网页返回了2xx的状态码
+func (d *CrawlHtmlData) OK() bool {
+	return d.StatusCode >= 200 && d.StatusCode < 300
+}
+
+// NotModified 条件请求的网页未变化
+func (d *CrawlHtmlData) NotModified() bool {
+	return d.StatusCode == http.StatusNotModified
+}
+
+// HeaderValue 不区分大小写读取响应头
+func HeaderValue(headers map[string]string, key string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
 }
 
 type CrawlExtraParams struct {
-	ForceBroswer bool `json:"force_browser"`
+	ForceBroswer bool              `json:"force_browser"`
+	Headers      map[string]string `json:"headers"` // 附加的请求头
+	Cookie       string            `json:"cookie"`
+	Timeout      time.Duration     `json:"timeout"` // 单次抓取的超时，为空时使用后端的默认超时
+	Limit        HostLimit         `json:"limit"`   // 站点的抓取限制，为空的项使用全局配置
+}
+
+// WithHeaders 复制一份并附加请求头，ext为nil时新建
+func (ext *CrawlExtraParams) WithHeaders(headers map[string]string) *CrawlExtraParams {
+	result := &CrawlExtraParams{}
+	if ext != nil {
+		*result = *ext
+	}
+	result.Headers = make(map[string]string, len(headers))
+	if ext != nil {
+		for key, value := range ext.Headers {
+			result.Headers[key] = value
+		}
+	}
+	for key, value := range headers {
+		result.Headers[key] = value
+	}
+	return result
+}
+
+// CrawlHtml 使用配置的默认抓取后端抓取网页，遇到临时错误时重试
+func CrawlHtml(ctx context.Context, url string, ext *CrawlExtraParams) (*CrawlHtmlResp, error) {
+	resp, _, err := CrawlWithRetry(ctx, defaultCrawler(), url, ext)
+	return resp, err
 }
 
-func CrawlHtml(ctx context.Context, url string, ext *CrawlExtraParams) (retData *CrawlHtmlResp, retErr error) {
+// crawlOnce 按host限流后使用指定后端抓取一次
+func crawlOnce(ctx context.Context, crawler Crawler, url string, ext *CrawlExtraParams) (retData *CrawlHtmlResp, retErr error) {
+	host := utils.ExtractUrlHost(url)
+	limit := HostLimit{}
+	if ext != nil {
+		limit = ext.Limit
+	}
+	release, err := acquireHost(ctx, host, limit)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "CrawlHtml limited, crawler: %v, url: %v, err: %v", crawler.Name(), url, err)
+		return nil, err
+	}
+	defer release()
+
 	timeBegin := time.Now()
 	defer func() {
-		host := utils.ExtractUrlHost(url)
 		if retErr != nil {
 			hlog.CtxErrorf(ctx,
-				"CrawlHtml failed, host: %v, url: %v, cost: %.2fs, err: %v",
+				"CrawlHtml failed, crawler: %v, host: %v, url: %v, cost: %.2fs, err: %v",
+				crawler.Name(),
 				host,
 				url,
 				time.Since(timeBegin).Seconds(),
@@ -63,22 +200,82 @@ func CrawlHtml(ctx context.Context, url string, ext *CrawlExtraParams) (retData
 			)
 		} else {
 			hlog.CtxInfof(ctx,
-				"CrawlHtml success, host: %v, url: %v, cost: %.2fs",
+				"CrawlHtml success, crawler: %v, host: %v, url: %v, final_url: %v, status: %v, cost: %.2fs",
+				crawler.Name(),
 				host,
 				url,
+				retData.Data.FinalUrl,
+				retData.Data.StatusCode,
 				time.Since(timeBegin).Seconds(),
 			)
 		}
 	}()
+	retData, retErr = crawler.Crawl(ctx, url, ext)
+	if retErr == nil {
+		fillCrawlHtmlData(&retData.Data, url)
+	}
+	return retData, retErr
+}
+
+// fillCrawlHtmlData 补全后端未返回的信息，去掉Set-Cookie
+func fillCrawlHtmlData(data *CrawlHtmlData, url string) {
+	if data.FinalUrl == "" {
+		data.FinalUrl = url
+	}
+	if data.StatusCode == 0 {
+		data.StatusCode = http.StatusOK
+	}
+	for key, value := range data.Headers {
+		if strings.EqualFold(key, "Set-Cookie") {
+			delete(data.Headers, key)
+		} else if data.ContentType == "" && strings.EqualFold(key, "Content-Type") {
+			data.ContentType = value
+		}
+	}
+	if data.FetchTime.IsZero() {
+		data.FetchTime = time.Now()
+	}
+}
+
+// RemoteCrawler 调用外部抓取服务，支持浏览器渲染
+type RemoteCrawler struct {
+	name    string
+	host    string        // 为空时使用api_domain.crawler_api
+	browser bool          // 始终使用浏览器渲染
+	timeout time.Duration // 为空时使用默认超时
+}
+
+func (r *RemoteCrawler) Name() string {
+	return r.name
+}
+
+func (r *RemoteCrawler) Browser() bool {
+	return r.browser
+}
+
+func (r *RemoteCrawler) Crawl(ctx context.Context, url string, ext *CrawlExtraParams) (*CrawlHtmlResp, error) {
 	// 抓取网页
 	reqBody := crawlHtmlReq{
 		Url: url,
 	}
+	if r.browser {
+		reqBody.ForceBrowser = thrift.BoolPtr(true)
+	}
+	timeout := consts2.CrawlHtmlTimeout
+	if r.timeout > 0 {
+		timeout = r.timeout
+	}
 	if ext != nil {
 		if ext.ForceBroswer {
 			reqBody.ForceBrowser = thrift.BoolPtr(ext.ForceBroswer)
 		}
+		reqBody.Headers = ext.Headers
+		reqBody.Cookie = ext.Cookie
+		if ext.Timeout > 0 {
+			timeout = ext.Timeout
+		}
 	}
+	reqBody.Timeout = int(timeout.Seconds())
 	utils.PrintRequestLog(ctx, consts2.ActionCrawlHtml, url, consts2.ActionType_Request, reqBody)
 
 	req := protocol.AcquireRequest()
@@ -95,17 +292,20 @@ func CrawlHtml(ctx context.Context, url string, ext *CrawlExtraParams) (retData
 	req.SetMethod(consts.MethodPost)
 	req.SetRequestURI("/crawl")
 	req.SetHeader(consts.HeaderContentType, consts.MIMEApplicationJSON)
-	req.SetHost(conf.GetConfig().ApiDomain.CrawlerApi)
+	host := r.host
+	if host == "" {
+		host = conf.GetConfig().ApiDomain.CrawlerApi
+	}
+	req.SetHost(host)
 
-	timeout := consts2.CrawlHtmlTimeout
 	err = crawlerClient.DoTimeout(ctx, req, resp, timeout)
 	if err != nil {
 		hlog.CtxErrorf(ctx, "CrawlHtml crawlerClient.DoTimeout failed, err: %v", err)
 		return nil, err
 	}
 	if resp.StatusCode() != http.StatusOK {
-		err = fmt.Errorf("CrawlHtml crawlerClient.DoTimeout failed, status code: %d", resp.StatusCode())
-		hlog.CtxErrorf(ctx, "%v", err)
+		err = &StatusError{StatusCode: resp.StatusCode()}
+		hlog.CtxErrorf(ctx, "CrawlHtml crawlerClient.DoTimeout failed, err: %v", err)
 		return nil, err
 	}
 
@@ -121,6 +321,11 @@ func CrawlHtml(ctx context.Context, url string, ext *CrawlExtraParams) (retData
 		hlog.CtxErrorf(ctx, "%v", err)
 		return nil, err
 	}
+	if isTransientStatus(result.Data.StatusCode) {
+		err = &StatusError{StatusCode: result.Data.StatusCode}
+		hlog.CtxErrorf(ctx, "CrawlHtml page status not ok, err: %v", err)
+		return nil, err
+	}
 	utils.PrintRequestLog(ctx, consts2.ActionCrawlHtml, url, consts2.ActionType_Response, result)
 
 	return result, nil
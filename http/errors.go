@@ -0,0 +1,21 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	errs "github.com/cloudwego/hertz/pkg/common/errors"
+)
+
+// IsTimeoutErr 判断请求是否因超时失败
+func IsTimeoutErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, errs.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
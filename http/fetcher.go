@@ -0,0 +1,200 @@
+package http
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/utils"
+	"github.com/andybalholm/brotli"
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	defaultNativeUserAgent    = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/142.0.0.0 Safari/537.36"
+	defaultNativeMaxBodyBytes = 10 << 20
+	defaultNativeTimeout      = 30 * time.Second
+	defaultNativeMaxRedirects = 10
+)
+
+var ErrBrowserNotSupported = errors.New("native fetcher does not support browser crawl")
+
+// NativeFetcher 进程内直接请求网页，不依赖外部抓取服务，不支持浏览器渲染
+type NativeFetcher struct {
+	name         string
+	userAgent    string
+	maxBodyBytes int64
+	timeout      time.Duration
+	client       *http.Client
+}
+
+func NewNativeFetcher(name string, c conf.NativeCrawl) *NativeFetcher {
+	f := &NativeFetcher{
+		name:         name,
+		userAgent:    c.UserAgent,
+		maxBodyBytes: c.MaxBodyBytes,
+		timeout:      time.Duration(c.TimeoutSeconds) * time.Second,
+	}
+	if f.userAgent == "" {
+		f.userAgent = defaultNativeUserAgent
+	}
+	if f.maxBodyBytes <= 0 {
+		f.maxBodyBytes = defaultNativeMaxBodyBytes
+	}
+	if f.timeout <= 0 {
+		f.timeout = defaultNativeTimeout
+	}
+	maxRedirects := c.MaxRedirects
+	if maxRedirects <= 0 {
+		maxRedirects = defaultNativeMaxRedirects
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 自行处理压缩，才能支持br
+	transport.DisableCompression = true
+	f.client = &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			return nil
+		},
+	}
+	return f
+}
+
+func (f *NativeFetcher) Name() string {
+	return f.name
+}
+
+func (f *NativeFetcher) Browser() bool {
+	return false
+}
+
+func (f *NativeFetcher) Crawl(ctx context.Context, url string, ext *CrawlExtraParams) (*CrawlHtmlResp, error) {
+	if ext != nil && ext.ForceBroswer {
+		return nil, ErrBrowserNotSupported
+	}
+	timeout := f.timeout
+	if ext != nil && ext.Timeout > 0 {
+		timeout = ext.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", f.userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
+	req.Header.Set("Accept-Encoding", "gzip, deflate, br")
+	if ext != nil {
+		for key, value := range ext.Headers {
+			req.Header.Set(key, value)
+		}
+		if ext.Cookie != "" {
+			req.Header.Set("Cookie", ext.Cookie)
+		}
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// 可重试的状态码直接返回错误；404等其他状态码返回网页，由调用方按状态码处理
+	if isTransientStatus(resp.StatusCode) {
+		return nil, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	body, err := f.readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	contentType := resp.Header.Get("Content-Type")
+	result := &CrawlHtmlResp{}
+	result.Data = CrawlHtmlData{
+		Html:        decodeHtml(body, contentType),
+		FinalUrl:    resp.Request.URL.String(),
+		StatusCode:  resp.StatusCode,
+		Headers:     flattenHeader(resp.Header),
+		ContentType: contentType,
+		FetchTime:   time.Now(),
+	}
+	return result, nil
+}
+
+// flattenHeader 多个值用", "连接
+func flattenHeader(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+	for key, values := range header {
+		result[key] = strings.Join(values, ", ")
+	}
+	return result
+}
+
+// readBody 按Content-Encoding解压，解压后超过大小上限时返回错误
+func (f *NativeFetcher) readBody(resp *http.Response) ([]byte, error) {
+	if resp.ContentLength > f.maxBodyBytes {
+		return nil, fmt.Errorf("body too large: %d bytes, max: %d", resp.ContentLength, f.maxBodyBytes)
+	}
+	var reader io.Reader = resp.Body
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "gzip", "x-gzip":
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	case "br":
+		reader = brotli.NewReader(resp.Body)
+	case "deflate":
+		zlibReader, err := zlib.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer zlibReader.Close()
+		reader = zlibReader
+	}
+
+	body, err := io.ReadAll(io.LimitReader(reader, f.maxBodyBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > f.maxBodyBytes {
+		return nil, fmt.Errorf("body too large, max: %d bytes", f.maxBodyBytes)
+	}
+	return body, nil
+}
+
+// decodeHtml 依次按BOM、Content-Type、meta标签识别编码并转为utf-8。
+// 识别不出编码或声明与内容不符时，按GBK系列编码尝试
+func decodeHtml(body []byte, contentType string) string {
+	enc, name, certain := charset.DetermineEncoding(body, contentType)
+	if name == "utf-8" && utf8.Valid(body) {
+		return string(body)
+	}
+	// 没有声明编码时DetermineEncoding会猜测为windows-1252；声明utf-8但内容不合法时也按GBK系列尝试
+	if name == "utf-8" || (name == "windows-1252" && !certain) {
+		if text := utils.TryReadText(body); text != "" {
+			return text
+		}
+	}
+	decoded, err := enc.NewDecoder().Bytes(body)
+	if err != nil {
+		return string(body)
+	}
+	return string(decoded)
+}
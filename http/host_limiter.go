@@ -0,0 +1,206 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/conf"
+)
+
+// 超过该数量后清理空闲的host
+const maxIdleHostLimiters = 10000
+
+var ErrRateLimited = errors.New("crawl rate limited")
+
+// HostLimit 单个host的抓取频率和并发限制
+type HostLimit struct {
+	Qps         float64 // 每秒抓取次数，<=0时不限制
+	Burst       int     // 允许的突发次数，<=0时为1
+	MaxInFlight int     // 同时进行的抓取数，<=0时不限制
+}
+
+// hostLimiter 令牌桶加并发计数，每个host一个。同一host的规则限制不同时（如path_pattern不同），
+// 按每次请求的限制补充令牌、判断并发，已有的令牌和进行中的抓取数不会重置
+type hostLimiter struct {
+	mu       sync.Mutex
+	qps      float64 // 最近一次请求的限制，用于补充令牌和判断是否空闲
+	burst    int
+	tokens   float64
+	last     time.Time
+	inFlight int
+	released chan struct{} // 有抓取结束时关闭并替换，唤醒等待并发的请求
+}
+
+func newHostLimiter(limit HostLimit) *hostLimiter {
+	return &hostLimiter{
+		qps:      limit.Qps,
+		burst:    max(limit.Burst, 1),
+		tokens:   float64(max(limit.Burst, 1)),
+		last:     time.Now(),
+		released: make(chan struct{}),
+	}
+}
+
+// refill 按之前的频率补充令牌，再切换为本次请求的限制
+func (l *hostLimiter) refill(limit HostLimit) {
+	now := time.Now()
+	if l.qps > 0 {
+		l.tokens += now.Sub(l.last).Seconds() * l.qps
+	}
+	l.qps, l.burst = limit.Qps, max(limit.Burst, 1)
+	l.tokens = min(float64(l.burst), l.tokens)
+	l.last = now
+}
+
+// reserve 预占一个令牌，返回需要等待的时间。等待时间超过maxWait时不预占
+func (l *hostLimiter) reserve(limit HostLimit, maxWait time.Duration) (time.Duration, bool) {
+	if limit.Qps <= 0 {
+		return 0, true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill(limit)
+	wait := time.Duration(0)
+	if l.tokens < 1 {
+		wait = time.Duration((1 - l.tokens) / limit.Qps * float64(time.Second))
+	}
+	if wait > maxWait {
+		return wait, false
+	}
+	l.tokens--
+	return wait, true
+}
+
+func (l *hostLimiter) cancelReserve(limit HostLimit) {
+	if limit.Qps <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(float64(l.burst), l.tokens+1)
+}
+
+// tryAcquire 进行中的抓取数小于maxInFlight时占用一个，否则返回有抓取结束时会关闭的channel
+func (l *hostLimiter) tryAcquire(maxInFlight int) (bool, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inFlight < maxInFlight {
+		l.inFlight++
+		return true, nil
+	}
+	return false, l.released
+}
+
+func (l *hostLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+func (l *hostLimiter) idle() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	full := l.qps <= 0 || l.tokens+time.Since(l.last).Seconds()*l.qps >= float64(l.burst)
+	return full && l.inFlight == 0
+}
+
+var (
+	hostLimitersMu sync.Mutex
+	hostLimiters   = map[string]*hostLimiter{}
+)
+
+// getHostLimiter 每个host只有一个限流器，限制变化时沿用已有的令牌和并发数
+func getHostLimiter(host string, limit HostLimit) *hostLimiter {
+	hostLimitersMu.Lock()
+	defer hostLimitersMu.Unlock()
+	if l, ok := hostLimiters[host]; ok {
+		return l
+	}
+	if len(hostLimiters) >= maxIdleHostLimiters {
+		for key, l := range hostLimiters {
+			if l.idle() {
+				delete(hostLimiters, key)
+			}
+		}
+	}
+	l := newHostLimiter(limit)
+	hostLimiters[host] = l
+	return l
+}
+
+// mergeHostLimit limit中大于0的项覆盖全局配置
+func mergeHostLimit(limit HostLimit) HostLimit {
+	cfg := conf.GetConfig().Parse.Crawl.RateLimit
+	merged := HostLimit{
+		Qps:         cfg.Qps,
+		Burst:       cfg.Burst,
+		MaxInFlight: cfg.MaxInFlight,
+	}
+	if limit.Qps > 0 {
+		merged.Qps = limit.Qps
+	}
+	if limit.Burst > 0 {
+		merged.Burst = limit.Burst
+	}
+	if limit.MaxInFlight > 0 {
+		merged.MaxInFlight = limit.MaxInFlight
+	}
+	return merged
+}
+
+// acquireHost 按host限流，超过限制时最多等待max_wait_ms，仍无法抓取时返回ErrRateLimited。
+// 成功时返回的release需在抓取结束后调用
+func acquireHost(ctx context.Context, host string, limit HostLimit) (func(), error) {
+	limit = mergeHostLimit(limit)
+	if limit.Qps <= 0 && limit.MaxInFlight <= 0 {
+		return func() {}, nil
+	}
+	maxWait := time.Duration(conf.GetConfig().Parse.Crawl.RateLimit.MaxWaitMs) * time.Millisecond
+	if deadline, ok := ctx.Deadline(); ok {
+		maxWait = max(min(maxWait, time.Until(deadline)), 0)
+	}
+	deadline := time.Now().Add(maxWait)
+	l := getHostLimiter(host, limit)
+
+	wait, ok := l.reserve(limit, maxWait)
+	if !ok {
+		return nil, fmt.Errorf("%w, host: %v, qps: %v, need wait: %v", ErrRateLimited, host, limit.Qps, wait)
+	}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			l.cancelReserve(limit)
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if limit.MaxInFlight <= 0 {
+		return func() {}, nil
+	}
+	timer := time.NewTimer(max(time.Until(deadline), 0))
+	defer timer.Stop()
+	for {
+		acquired, released := l.tryAcquire(limit.MaxInFlight)
+		if acquired {
+			return l.release, nil
+		}
+		// 没有抓取时归还令牌
+		select {
+		case <-released:
+		case <-ctx.Done():
+			l.cancelReserve(limit)
+			return nil, ctx.Err()
+		case <-timer.C:
+			l.cancelReserve(limit)
+			return nil, fmt.Errorf("%w, host: %v, max in flight: %v", ErrRateLimited, host, limit.MaxInFlight)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/DeepLangAI/wcd/utils"
+)
+
+// 图片最多跟随5次重定向，探测尺寸时只读取前64KB
+const (
+	imageMaxRedirects = 5
+	imageProbeBytes   = 64 << 10
+)
+
+var imageClient = &http.Client{
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= imageMaxRedirects {
+			return fmt.Errorf("stopped after %d redirects", imageMaxRedirects)
+		}
+		return nil
+	},
+}
+
+// FetchImage 直接下载图片，返回内容和content type。referer为图片所在的网页，部分网站有防盗链。
+// 超过maxBytes或不是图片时返回错误
+func FetchImage(ctx context.Context, imgUrl string, referer string, maxBytes int64, timeout time.Duration) ([]byte, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imgUrl, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("User-Agent", defaultNativeUserAgent)
+	req.Header.Set("Accept", "image/avif,image/webp,image/*,*/*;q=0.8")
+	if referer != "" {
+		req.Header.Set("Referer", referer)
+	}
+	resp, err := imageClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, "", &StatusError{StatusCode: resp.StatusCode}
+	}
+	if maxBytes > 0 && resp.ContentLength > maxBytes {
+		return nil, "", fmt.Errorf("image too large: %d bytes", resp.ContentLength)
+	}
+	reader := io.Reader(resp.Body)
+	if maxBytes > 0 {
+		reader = io.LimitReader(resp.Body, maxBytes+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, "", err
+	}
+	if maxBytes > 0 && int64(len(data)) > maxBytes {
+		return nil, "", fmt.Errorf("image too large: over %d bytes", maxBytes)
+	}
+
+	// 以响应头为准，没有时按内容判断。svg按内容会判断为text/xml
+	contentType := strings.ToLower(strings.TrimSpace(strings.Split(resp.Header.Get("Content-Type"), ";")[0]))
+	if !strings.HasPrefix(contentType, "image/") {
+		contentType = http.DetectContentType(data)
+	}
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, "", fmt.Errorf("not an image, content type: %v", contentType)
+	}
+	return data, contentType, nil
+}
+
+// ProbeImage 只下载图片的头部，返回宽高和格式。服务端不支持Range时也只读取前64KB
+func ProbeImage(ctx context.Context, imgUrl string, referer string, timeout time.Duration) (int, int, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imgUrl, nil)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	req.Header.Set("User-Agent", defaultNativeUserAgent)
+	req.Header.Set("Range", fmt.Sprintf("bytes=0-%d", imageProbeBytes-1))
+	if referer != "" {
+		req.Header.Set("Referer", referer)
+	}
+	resp, err := imageClient.Do(req)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, 0, "", &StatusError{StatusCode: resp.StatusCode}
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, imageProbeBytes))
+	if err != nil && len(data) == 0 {
+		return 0, 0, "", err
+	}
+	width, height, mimeType, ok := utils.ImageConfig(data)
+	if !ok {
+		return 0, 0, "", fmt.Errorf("unknown image format")
+	}
+	return width, height, mimeType, nil
+}
@@ -3,4 +3,5 @@ package http
 func Init() {
 	InitLabelClient()
 	InitCrawlerClient()
+	InitCallbackClient()
 }
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// robots.txt最多跟随5次重定向，读取前500KB
+const (
+	robotsMaxRedirects = 5
+	robotsMaxBytes     = 500 << 10
+)
+
+var robotsClient = &http.Client{
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= robotsMaxRedirects {
+			return fmt.Errorf("stopped after %d redirects", robotsMaxRedirects)
+		}
+		return nil
+	},
+}
+
+// FetchRobotsTxt 直接请求robots.txt，返回状态码和内容。robots.txt很小，不经过抓取链和限流。
+// userAgent与匹配robots.txt规则的名称一致，网站按user agent返回不同内容时结果才准确
+func FetchRobotsTxt(ctx context.Context, robotsUrl string, userAgent string, timeout time.Duration) (int, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsUrl, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := robotsClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, robotsMaxBytes))
+	if err != nil {
+		return resp.StatusCode, "", err
+	}
+	return resp.StatusCode, string(body), nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/DeepLangAI/go_lib/middleware"
 	"github.com/DeepLangAI/go_lib/utillib"
+	"time"
 
 	"github.com/DeepLangAI/wcd/conf"
 	consts2 "github.com/DeepLangAI/wcd/consts"
@@ -34,12 +35,35 @@ func InitLabelClient() {
 }
 
 type ModelRawReqResp struct {
-	Req  string
-	Resp string
-	Code int
+	Req     string
+	Resp    string
+	Code    int
+	Labeler string // 实际完成标注的标注器
 }
 
+// LabelApi 标注模型接口地址，为空的字段使用默认配置
+type LabelApi struct {
+	Host    string
+	Path    string
+	Timeout time.Duration
+}
+
+const defaultLabelPath = "parser-v2/text"
+
 func ParseLabel(ctx context.Context, reqBody *http_model.LabelModelReq) (*http_model.LabelModelResp, *ModelRawReqResp, error) {
+	return ParseLabelWithApi(ctx, reqBody, LabelApi{})
+}
+
+func ParseLabelWithApi(ctx context.Context, reqBody *http_model.LabelModelReq, api LabelApi) (*http_model.LabelModelResp, *ModelRawReqResp, error) {
+	if api.Host == "" {
+		api.Host = conf.GetConfig().ApiDomain.AiApi
+	}
+	if api.Path == "" {
+		api.Path = defaultLabelPath
+	}
+	if api.Timeout <= 0 {
+		api.Timeout = consts2.TextParseReadTimeOut
+	}
 	io := &ModelRawReqResp{}
 	io.Code = http_model.LabelModelCode_Unk
 	req := protocol.AcquireRequest()
@@ -54,10 +78,10 @@ func ParseLabel(ctx context.Context, reqBody *http_model.LabelModelReq) (*http_m
 	io.Req = string(req.Body())
 	req.SetHeader(consts.HeaderContentType, consts.MIMEApplicationJSON)
 	req.SetMethod(consts.MethodPost)
-	req.SetRequestURI("parser-v2/text")
-	req.SetHost(conf.GetConfig().ApiDomain.AiApi)
+	req.SetRequestURI(api.Path)
+	req.SetHost(api.Host)
 
-	err := labelClient.DoTimeout(ctx, req, resp, consts2.TextParseReadTimeOut)
+	err := labelClient.DoTimeout(ctx, req, resp, api.Timeout)
 	if err != nil {
 		hlog.CtxErrorf(ctx, "ParseLabel do req error %v", err)
 		return nil, io, err
@@ -15,11 +15,39 @@ enum RuleStageGroupEnum{
     TestingPrior = 3
 }
 
+// 结构化正文中的一个块
+struct ContentBlock{
+    1: string type // heading / paragraph / image / table / list / code / quote / reference / caption
+    2: string text // 纯文本，列表各项、表格各行以换行连接
+    3: optional i32 level // heading：标题层级，文章标题为1
+    4: optional string url // image：图片链接
+    5: optional string alt // image：图片的alt
+    6: optional string caption // image、table：图注或表格标题
+    7: optional list<list<string>> rows // table：按行排列的单元格文本
+    8: optional list<string> items // list：列表项
+    9: optional bool ordered // list：是否有序列表
+    10: optional string language // code：代码语言
+    11: list<i32> position_ids // 块内容对应的切分原子的position_id，按文档顺序
+    12: list<string> xpaths // 块内容对应的切分原子的xpath
+}
+
+struct ImageInfo{
+    1: string url
+    2: optional i32 width // 来自标签的width、style或图片头部，未知时为空
+    3: optional i32 height
+    4: optional string mime_type // 来自图片内容，未下载时按扩展名判断
+    5: optional string alt // alt，没有时为title
+    6: optional string caption // 所在figure的图注
+}
+
 struct WcdParseReq{
     1: string url
     2: string html
-    3: optional bool reparse // 是否强制重新解析，不走缓存
+    3: optional bool reparse // 是否强制重新解析，不读取解析结果缓存
     4: optional RuleStageGroupEnum rule_stage_group // 规则组，默认为ProdOnly
+    5: optional string labeler // 指定标注器，为空时依次使用站点规则、全局配置中的标注器
+    6: optional list<string> output_formats // 除text和readable_html之外，额外返回的格式：markdown、blocks
+    7: optional bool mirror_images // 是否把正文图片保存到对象存储并替换为镜像地址，为空时使用全局配置
 }
 
 struct WcdParseResp{
@@ -44,6 +72,35 @@ struct WcdParseResp{
     22: optional string site_icon // 网站图标
     23: optional string description // 网页描述
     24: optional string surface_image // 封面图
+    25: optional string labeler // 实际使用的标注器
+    26: optional string markdown // 正文markdown，output_formats包含markdown时返回
+    27: optional list<ContentBlock> blocks // 结构化正文，output_formats包含blocks时返回
+    28: optional list<CrawlAttempt> crawl_attempts // 抓取过程，传入html时为空
+    29: optional CrawlMeta crawl_meta // 使用的网页的抓取信息，传入html时为空
+    30: optional bool from_cache // 解析结果是否来自缓存
+    31: optional map<string,string> mirrored_images // 图片原地址到镜像地址，开启图片镜像时返回，下载失败的图片不包含在内
+    32: optional list<ImageInfo> image_infos // 正文图片的尺寸、格式和图注，与readable_html中的图片顺序一致
+}
+
+struct CrawlAttempt{
+    1: string backend // 抓取后端，命中缓存时为cache
+    2: i32 attempt // 同一后端的第几次尝试，从1开始
+    3: bool success // 是否使用了本次抓取的网页
+    4: string error
+    5: i64 cost_ms
+    6: string final_url
+    7: i32 status_code // 网页的http状态码，抓取失败时为0
+}
+
+struct CrawlMeta{
+    1: i32 status_code // 网页的http状态码
+    2: string final_url // 重定向后的最终url
+    3: string content_type
+    4: map<string, string> headers // 网页的响应头，不含Set-Cookie
+    5: string fetch_time // 抓取时间，命中缓存时为缓存的抓取时间
+    6: string content_hash // 网页html的md5，用于判断内容是否变化
+    7: optional bool changed // 与上次缓存的网页相比内容是否变化，没有缓存时为空
+    8: bool revalidated // 缓存过期后通过条件请求确认网页未变化
 }
 
 struct AtomicText{
@@ -123,6 +180,8 @@ struct DistillReq{
     4: string html
     5: string url
     6: ArticleMeta article_meta
+    7: optional RuleStageGroupEnum rule_stage_group // 规则组，默认为ProdOnly
+    8: optional list<string> output_formats // 额外返回的格式：markdown、blocks
 }
 struct DistillResp{
     1: i32 code
@@ -134,6 +193,9 @@ struct DistillResp{
     6: list<string> images // 正文图片url
     7: bool worthless // 是否无意义
     8: i32 worth_type
+    9: optional string markdown // 正文markdown
+    10: optional list<ContentBlock> blocks // 结构化正文
+    11: optional list<ImageInfo> image_infos // 正文图片的尺寸、格式和图注
 }
 
 
@@ -144,13 +206,70 @@ struct BaseParseReq{
 
     6: optional bool with_raw_html // 是否返回原始html
     7: optional RuleStageGroupEnum rule_stage_group // 规则组，默认为ProdOnly
-    8: optional bool skip_cache // 解析时是否强制跳过缓存
-    9: optional bool save_crawl_html // 解析后是否保存抓取的html
+    8: optional bool skip_cache // 不读取网页缓存和解析结果缓存，重新抓取和解析，结果仍按配置写入缓存
+    9: optional bool save_crawl_html // 解析后是否缓存html，为空时缓存抓取到的网页，不缓存请求传入的html
+    10: optional string labeler // 指定标注器，为空时依次使用站点规则、全局配置中的标注器
+    11: optional list<string> output_formats // 额外返回的格式：markdown、blocks
+    12: optional bool respect_robots // 是否遵守robots.txt和meta robots，为空时使用全局配置
+    13: optional bool mirror_images // 是否把正文图片保存到对象存储并替换为镜像地址，为空时使用全局配置
+}
+
+struct BaseParseBatchReq{
+    1: list<BaseParseReq> items // 相同url和html的请求只解析一次
+}
+struct BaseParseBatchResp{
+    1: i32 code
+    2: string msg
+    3: list<WcdParseResp> items // 与请求的顺序一一对应，每项有各自的code
+}
+
+enum ParseJobStatus{
+    Pending = 0
+    Running = 1
+    Succeeded = 2
+    Failed = 3
+}
+enum ParseJobCallbackStatus{
+    None = 0 // 未设置回调地址
+    Pending = 1
+    Succeeded = 2
+    Failed = 3 // 重试次数用完仍失败
+}
+struct ParseJobSubmitReq{
+    1: BaseParseReq req
+    2: optional string callback_url // 任务结束后以POST方式推送ParseJobResp，失败时重试
+}
+struct ParseJobSubmitResp{
+    1: i32 code
+    2: string msg
+    3: string job_id
+}
+struct ParseJobReq{
+    1: string job_id
+}
+struct ParseJobResp{
+    1: i32 code
+    2: string msg
+    3: string job_id
+    4: string url
+    5: ParseJobStatus status
+    6: optional WcdParseResp result // 任务结束后才有，解析失败时result中的code非0
+    7: ParseJobCallbackStatus callback_status
+    8: i32 callback_attempts
+    9: string create_time
+    10: string update_time
+    11: string finish_time
 }
 
 service WcdService{
     // 基础解析。pdf：切句。web：抓取、切句、去噪
     WcdParseResp BaseParse(1: BaseParseReq req)(api.post="/base-parse")
+    // 批量基础解析
+    BaseParseBatchResp BaseParseBatch(1: BaseParseBatchReq req)(api.post="/base-parse/batch")
+    // 提交异步解析任务
+    ParseJobSubmitResp SubmitParseJob(1: ParseJobSubmitReq req)(api.post="/base-parse/job")
+    // 查询异步解析任务的状态和结果
+    ParseJobResp GetParseJob(1: ParseJobReq req)(api.get="/base-parse/job")
 
     // 切分+去噪
     WcdParseResp WcdParse(1: WcdParseReq req)(api.post="/wcd/parse")
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"context"
 	"github.com/DeepLangAI/go_lib/logger"
 	"path/filepath"
 	"strings"
@@ -10,6 +11,8 @@ import (
 	"github.com/DeepLangAI/wcd/conf"
 	"github.com/DeepLangAI/wcd/dal"
 	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/service/wcd"
+	"github.com/DeepLangAI/wcd/tools/labeler"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
@@ -19,6 +22,8 @@ func main() {
 	logger.Init(conf.GetConfig().Logger)
 	dal.Init()
 	http.Init()
+	labeler.Init()
+	wcd.StartParseJobWorkers(context.Background())
 
 	h := server.Default(server.WithHostPorts(conf.GetConfig().Server.Port), server.WithMaxRequestBodySize(-1))
 	staticFs(h)
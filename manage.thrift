@@ -35,6 +35,21 @@ struct SiteRuleData{
 
     14: string create_time
     15: string update_time
+    16: string labeler // 指定标注器，为空时使用全局配置
+    17: list<string> crawl_backends // 依次尝试的抓取后端，为空时使用全局配置的抓取链
+    18: list<string> crawl_headers // 抓取时附加的请求头，格式为"Name: value"
+    19: string crawl_cookie // 抓取时附加的Cookie，格式为"a=1; b=2"
+    20: string crawl_timeout // 单次抓取的超时，如"30s"，为空时使用抓取后端的默认超时
+    21: double crawl_qps // 每秒抓取次数上限，为0时使用全局配置
+    22: i32 crawl_max_in_flight // 同时抓取数上限，为0时使用全局配置
+
+    23: optional string revision_author // 更新规则时的修改人，记录在规则版本中
+    24: optional string revision_comment // 更新规则时的修改说明，记录在规则版本中
+
+    25: string match_type // host的匹配方式：exact、suffix、glob、regex，为空时按旧的前缀和正则匹配
+    26: string host_pattern // 按match_type匹配的host，为空时使用host
+    27: string path_pattern // url路径的匹配规则，为空时匹配所有路径
+    28: i32 priority // 同时命中多条规则时，优先级高的生效
 }
 
 // 查看各站点规则详情
@@ -65,6 +80,7 @@ struct UpdateSiteRuleResp{
     1: i32 code
     2: string msg
     3: SiteRuleData data
+    4: optional list<SiteRuleFieldError> errors // 规则校验不通过时的字段错误
 }
 
 // 从正式规则导出一个测试规则
@@ -80,6 +96,8 @@ struct ExportProdRuleToTestResp{
 // 发布测试状态的规则至正式
 struct PublishSiteRuleReq{
     1: list<string> host
+    2: optional string author // 记录在规则版本中
+    3: optional string comment
 }
 struct PublishSiteRuleResp{
     1: i32 code
@@ -91,6 +109,8 @@ struct PublishSiteRuleResp{
 struct DeleteSiteRuleReq{
     1: string host
     2: wcd.RuleStageType stage
+    3: optional string author // 记录在规则版本中
+    4: optional string comment
 }
 struct DeleteSiteRuleResp{
     1: i32 code
@@ -109,8 +129,174 @@ struct ExportSiteRulesResp{
 struct ImportSiteRulesResp{
     1: i32 code
     2: string msg
+    3: optional list<SiteRuleFieldError> errors // 规则校验不通过时的字段错误
+}
+
+// 规则的一个版本，发布、更新、删除、回滚和导入时写入
+struct SiteRuleRevision{
+    1: string host
+    2: i64 revision // 同一host内从1开始递增
+    3: string action // update / publish / delete / rollback / import
+    4: SiteRuleData rule // 变更后的规则，删除时为删除前的规则
+    5: string author
+    6: string comment
+    7: string create_time
+}
+
+// 查看规则的版本列表
+struct SiteRuleRevisionListReq{
+    1: string host
+    2: optional i32 limit // 为空时返回全部
+}
+struct SiteRuleRevisionListResp{
+    1: i32 code
+    2: string msg
+    3: list<SiteRuleRevision> data // 按版本号从新到旧
+}
+
+// 比较两个版本
+struct SiteRuleRevisionDiffReq{
+    1: string host
+    2: i64 from_revision
+    3: i64 to_revision
+}
+struct SiteRuleFieldDiff{
+    1: string field
+    2: string from_value // 字段值的json
+    3: string to_value
+}
+struct SiteRuleRevisionDiffResp{
+    1: i32 code
+    2: string msg
+    3: list<SiteRuleFieldDiff> data // 只包含有变化的字段
 }
 
+// 把正式规则回滚到指定版本
+struct RollbackSiteRuleReq{
+    1: string host
+    2: i64 revision
+    3: optional string author
+    4: optional string comment
+}
+struct RollbackSiteRuleResp{
+    1: i32 code
+    2: string msg
+    3: SiteRuleData data
+}
+
+// 用测试规则和正式规则分别解析样例网页，比较结果
+struct SiteRuleDryRunPage{
+    1: string url
+    2: optional string html // 为空时依次使用缓存的网页、重新抓取
+}
+struct SiteRuleDryRunReq{
+    1: string host
+    2: list<SiteRuleDryRunPage> pages
+    3: optional i32 cached_limit // pages为空时，使用该host最近缓存的网页，默认5个
+    4: optional string labeler // 指定标注器，为空时依次使用站点规则、全局配置中的标注器
+}
+struct SiteRuleDryRunResult{
+    1: string title
+    2: string author
+    3: string pub_time
+    4: i32 text_length // 字符数
+    5: i32 image_count
+    6: bool worthless
+    7: i32 worth_type
+    8: string rule_host // 命中的规则，未命中时为空
+    9: string error // 解析失败时的错误
+}
+struct SiteRuleDryRunItem{
+    1: string url
+    2: string html_source // request / cache / 抓取后端名
+    3: SiteRuleDryRunResult prod // 只使用正式规则
+    4: SiteRuleDryRunResult testing // 优先使用测试规则
+    5: list<string> changed_fields // 有变化的字段
+    6: list<string> text_diff // 正文按行比较，"-"开头为只在正式结果中的行，"+"开头为只在测试结果中的行
+    7: string error // 获取网页失败时的错误
+}
+struct SiteRuleDryRunResp{
+    1: i32 code
+    2: string msg
+    3: list<SiteRuleDryRunItem> data
+}
+
+// 规则字段的校验错误
+struct SiteRuleFieldError{
+    1: string host
+    2: string field // 字段的json名，如bodies、title
+    3: i32 index // 列表字段中的序号，非列表字段为0
+    4: string value
+    5: string message
+}
+// xpath在样例网页中命中的节点数
+struct SiteRuleXpathMatch{
+    1: string field
+    2: i32 index
+    3: string xpath
+    4: i32 count
+}
+// 校验规则，不保存
+struct ValidateSiteRuleReq{
+    1: SiteRuleData rule
+    2: optional string sample_url // 样例网页，用于统计xpath命中的节点数
+    3: optional string sample_html // 为空时使用sample_url缓存的网页
+}
+struct ValidateSiteRuleResp{
+    1: i32 code
+    2: string msg
+    3: list<SiteRuleFieldError> errors
+    4: list<SiteRuleXpathMatch> matches // 传入样例网页时返回
+}
+
+// 查看url会命中哪条规则及原因
+struct ExplainSiteRuleMatchReq{
+    1: string url
+    2: wcd.RuleStageGroupEnum stage_group
+}
+struct SiteRuleMatchCandidate{
+    1: string host
+    2: wcd.RuleStageType stage
+    3: string match_type
+    4: string host_pattern
+    5: string path_pattern
+    6: i32 priority
+    7: bool matched
+    8: string reason // 命中或未命中的原因
+}
+struct ExplainSiteRuleMatchResp{
+    1: i32 code
+    2: string msg
+    3: optional SiteRuleData data // 生效的规则，未命中时为空
+    4: list<SiteRuleMatchCandidate> candidates // 命中的规则按优先顺序在前，其后为host相近但未命中的规则
+    5: string match_host // 用于匹配的host，已去掉www前缀
+    6: string match_path
+}
+
+// 根据样例网页推荐正文和噪声的xpath
+struct SuggestSiteRuleReq{
+    1: string url
+    2: optional string html // 为空时依次使用缓存的网页、重新抓取
+    3: optional i32 max_noises // 最多推荐的噪声数，默认10
+}
+struct SiteRuleXpathSuggestion{
+    1: string xpath
+    2: i32 text_length // 字符数
+    3: double text_share // 节点文本占整个网页文本的比例
+    4: double link_density // 链接文本占节点文本的比例
+    5: i32 match_count // xpath在样例网页中命中的节点数
+    6: bool stable // 只使用id、class定位，不依赖节点位置
+    7: string reason // 推荐的原因
+    8: string preview // 节点文本的开头
+}
+struct SuggestSiteRuleResp{
+    1: i32 code
+    2: string msg
+    3: list<SiteRuleXpathSuggestion> bodies // 第一条为推荐的正文节点，其后为范围更大的备选
+    4: list<SiteRuleXpathSuggestion> noises // 正文节点中的噪声块
+    5: string preview // 使用第一条正文和全部噪声后的正文，未经语义去噪
+    6: string html_source // request / cache / 抓取后端名
+}
 
 service RuleFactory{
     // 查看各站点规则列表
@@ -149,4 +335,32 @@ service RuleFactory{
     ImportSiteRulesResp ImportSiteRules(1: EmptyReq req)(
         api.post="/api/v1/site_rule/import"
     )
+    // 查看规则的版本列表
+    SiteRuleRevisionListResp SiteRuleRevisionList(1: SiteRuleRevisionListReq req)(
+        api.post="/api/v1/site_rule/revision/list"
+    )
+    // 比较规则的两个版本
+    SiteRuleRevisionDiffResp SiteRuleRevisionDiff(1: SiteRuleRevisionDiffReq req)(
+        api.post="/api/v1/site_rule/revision/diff"
+    )
+    // 把正式规则回滚到指定版本
+    RollbackSiteRuleResp RollbackSiteRule(1: RollbackSiteRuleReq req)(
+        api.post="/api/v1/site_rule/prod/rollback"
+    )
+    // 校验规则的xpath和host，可以统计xpath在样例网页中命中的节点数
+    ValidateSiteRuleResp ValidateSiteRule(1: ValidateSiteRuleReq req)(
+        api.post="/api/v1/site_rule/validate"
+    )
+    // 用测试规则和正式规则分别解析样例网页，比较结果
+    SiteRuleDryRunResp SiteRuleDryRun(1: SiteRuleDryRunReq req)(
+        api.post="/api/v1/site_rule/testing/dry_run"
+    )
+    // 查看url会命中哪条规则及原因
+    ExplainSiteRuleMatchResp ExplainSiteRuleMatch(1: ExplainSiteRuleMatchReq req)(
+        api.post="/api/v1/site_rule/match/explain"
+    )
+    // 根据样例网页推荐正文和噪声的xpath
+    SuggestSiteRuleResp SuggestSiteRule(1: SuggestSiteRuleReq req)(
+        api.post="/api/v1/site_rule/suggest"
+    )
 }
\ No newline at end of file
@@ -0,0 +1,46 @@
+package manage
+
+import (
+	"errors"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/rule_index"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// ExplainMatch 查看url在指定规则阶段组合下会命中哪条规则，以及各条相关规则命中或未命中的原因
+func (r *RuleManageService) ExplainMatch(req wcd_manage.ExplainSiteRuleMatchReq) (*wcd_manage.ExplainSiteRuleMatchResp, error) {
+	if req.URL == "" {
+		return nil, errors.New("url is empty")
+	}
+	explanations, err := rule_index.SiteRuleIndex.Explain(r.ctx, req.URL, req.StageGroup)
+	if err != nil {
+		hlog.CtxErrorf(r.ctx, "explain site rule match failed, url: %v, err: %v", req.URL, err)
+		return nil, err
+	}
+	ruleUrl := mongo.ParseRuleUrl(req.URL)
+	resp := &wcd_manage.ExplainSiteRuleMatchResp{
+		Candidates: []*wcd_manage.SiteRuleMatchCandidate{},
+		MatchHost:  ruleUrl.Host,
+		MatchPath:  ruleUrl.Path,
+	}
+	for _, explanation := range explanations {
+		rule := explanation.Rule
+		if explanation.Matched && resp.Data == nil {
+			resp.Data = rule.ToThrift()
+		}
+		resp.Candidates = append(resp.Candidates, &wcd_manage.SiteRuleMatchCandidate{
+			Host:        rule.Host,
+			Stage:       wcd.RuleStageType(rule.Stage),
+			MatchType:   rule.MatchType,
+			HostPattern: rule.GetHostPattern(),
+			PathPattern: rule.PathPattern,
+			Priority:    int32(rule.Priority),
+			Matched:     explanation.Matched,
+			Reason:      explanation.Reason,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,180 @@
+package manage
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/rule_index"
+	"github.com/DeepLangAI/wcd/dal/store"
+	"github.com/DeepLangAI/wcd/utils"
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 比较版本时忽略的字段，只在写入时变化
+var revisionDiffIgnoredFields = []string{"id", "create_time", "update_time", "revision_author", "revision_comment"}
+
+func revisionToThrift(model mongo.SiteRuleRevisionModel) *wcd_manage.SiteRuleRevision {
+	return &wcd_manage.SiteRuleRevision{
+		Host:       model.Host,
+		Revision:   model.Revision,
+		Action:     model.Action,
+		Rule:       model.Rule.ToThrift(),
+		Author:     model.Author,
+		Comment:    model.Comment,
+		CreateTime: model.CreateTime.Local().Format(time.DateTime),
+	}
+}
+
+// recordRevision 按存储中的当前内容写入版本，rule不为nil时直接使用。
+// 规则已经变更，写入失败时返回错误，让调用方知道这次变更没有版本记录
+func (r *RuleManageService) recordRevision(host string, stage consts.RuleStage, rule *mongo.SiteRuleModel, action string, author string, comment string) error {
+	if rule == nil {
+		current, err := store.SiteRules.FindOne(r.ctx, host, stage)
+		if err != nil {
+			hlog.CtxErrorf(r.ctx, "find site rule for revision failed, host: %v, stage: %v, err: %v", host, stage, err)
+			return fmt.Errorf("site rule %v saved, but record revision failed: %w", host, err)
+		}
+		rule = current
+	}
+	// 快照与存储中的规则不共享切片
+	snapshot := mongo.SiteRuleModel{}
+	utils.DumpAndLoad(r.ctx, rule, &snapshot)
+	model := &mongo.SiteRuleRevisionModel{
+		Host:       host,
+		Action:     action,
+		Rule:       snapshot,
+		Author:     author,
+		Comment:    comment,
+		CreateTime: time.Now(),
+	}
+	if err := store.RuleRevisions.Insert(r.ctx, model); err != nil {
+		hlog.CtxErrorf(r.ctx, "insert site rule revision failed, host: %v, action: %v, err: %v", host, action, err)
+		return fmt.Errorf("site rule %v saved, but record revision failed: %w", host, err)
+	}
+	hlog.CtxInfof(r.ctx, "site rule revision recorded, host: %v, revision: %v, action: %v", host, model.Revision, action)
+	return nil
+}
+
+func (r *RuleManageService) ListRevisions(req wcd_manage.SiteRuleRevisionListReq) ([]*wcd_manage.SiteRuleRevision, error) {
+	if req.Host == "" {
+		return nil, errors.New("host is empty")
+	}
+	revisions, err := store.RuleRevisions.ListByHost(r.ctx, req.Host, int(req.GetLimit()))
+	if err != nil {
+		hlog.CtxErrorf(r.ctx, "list site rule revisions failed, host: %v, err: %v", req.Host, err)
+		return nil, err
+	}
+	return utils.Map(revisions, revisionToThrift), nil
+}
+
+func (r *RuleManageService) findRevision(host string, revision int64) (*mongo.SiteRuleRevisionModel, error) {
+	model, err := store.RuleRevisions.FindOne(r.ctx, host, revision)
+	if err != nil {
+		hlog.CtxErrorf(r.ctx, "find site rule revision failed, host: %v, revision: %v, err: %v", host, revision, err)
+		return nil, fmt.Errorf("revision %v of %v not found", revision, host)
+	}
+	return model, nil
+}
+
+// DiffRevisions 按SiteRuleData的json字段逐个比较，返回有变化的字段
+func (r *RuleManageService) DiffRevisions(req wcd_manage.SiteRuleRevisionDiffReq) ([]*wcd_manage.SiteRuleFieldDiff, error) {
+	from, err := r.findRevision(req.Host, req.FromRevision)
+	if err != nil {
+		return nil, err
+	}
+	to, err := r.findRevision(req.Host, req.ToRevision)
+	if err != nil {
+		return nil, err
+	}
+	fromFields, err := ruleFields(from.Rule.ToThrift())
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := ruleFields(to.Rule.ToThrift())
+	if err != nil {
+		return nil, err
+	}
+	fields := utils.Set(append(utils.KeysOfMap(fromFields), utils.KeysOfMap(toFields)...))
+	slices.Sort(fields)
+	diffs := []*wcd_manage.SiteRuleFieldDiff{}
+	for _, field := range fields {
+		if utils.Contains(revisionDiffIgnoredFields, field) {
+			continue
+		}
+		fromValue, toValue := fromFields[field], toFields[field]
+		if fromValue == toValue {
+			continue
+		}
+		diffs = append(diffs, &wcd_manage.SiteRuleFieldDiff{
+			Field:     field,
+			FromValue: fromValue,
+			ToValue:   toValue,
+		})
+	}
+	return diffs, nil
+}
+
+// ruleFields 规则各字段的json，空列表和null视为相同
+func ruleFields(rule *wcd_manage.SiteRuleData) (map[string]string, error) {
+	data, err := sonic.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]any{}
+	if err := sonic.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	fields := map[string]string{}
+	for key, value := range values {
+		if value == nil {
+			value = []any{}
+		}
+		valueStr, err := sonic.MarshalString(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = valueStr
+	}
+	return fields, nil
+}
+
+// Rollback 用指定版本的规则覆盖正式规则，并记录为新的版本。只能回滚到正式规则的版本，测试规则需要走发布流程
+func (r *RuleManageService) Rollback(req wcd_manage.RollbackSiteRuleReq) (*wcd_manage.SiteRuleData, error) {
+	revision, err := r.findRevision(req.Host, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	if revision.Rule.Stage != consts.RuleStageProd {
+		return nil, fmt.Errorf("revision %v of %v is not a prod rule, only prod revisions can be rolled back to", req.Revision, req.Host)
+	}
+	rule := revision.Rule
+	rule.Status = consts.StatusValid
+	// 版本写入后校验规则可能有变化，回滚前重新校验
+	if err := r.validateOrError([]*wcd_manage.SiteRuleData{rule.ToThrift()}, nil); err != nil {
+		return nil, err
+	}
+	if err := store.SiteRules.UpsertMany(r.ctx, []*mongo.SiteRuleModel{&rule}); err != nil {
+		hlog.CtxErrorf(r.ctx, "rollback site rule failed, host: %v, revision: %v, err: %v", req.Host, req.Revision, err)
+		return nil, err
+	}
+	rule_index.SiteRuleIndex.Invalidate(r.ctx)
+
+	comment := req.GetComment()
+	if comment == "" {
+		comment = fmt.Sprintf("rollback to revision %v", req.Revision)
+	}
+	if err := r.recordRevision(req.Host, consts.RuleStageProd, nil, consts.RuleAction_Rollback, req.GetAuthor(), comment); err != nil {
+		return nil, err
+	}
+	current, err := store.SiteRules.FindOne(r.ctx, req.Host, consts.RuleStageProd)
+	if err != nil {
+		return nil, err
+	}
+	return current.ToThrift(), nil
+}
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
 	"github.com/DeepLangAI/wcd/consts"
 	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/rule_index"
+	"github.com/DeepLangAI/wcd/dal/store"
+	"github.com/DeepLangAI/wcd/http"
 	"github.com/DeepLangAI/wcd/utils"
 	"github.com/bytedance/sonic"
 	"github.com/cloudwego/hertz/pkg/app"
@@ -64,7 +68,7 @@ func (r *RuleManageService) getOrderFunc(orderBy string) utils.CmpFunc[*wcd_mana
 }
 
 func (r *RuleManageService) List(req wcd_manage.SiteRuleListReq) ([]*wcd_manage.SiteRuleData, error) {
-	dal := mongo.SiteRuleModelDal
+	dal := store.SiteRules
 	data := []*wcd_manage.SiteRuleData{}
 	rules, err := dal.ListAll(r.ctx)
 	if err != nil {
@@ -108,7 +112,7 @@ func (r *RuleManageService) List(req wcd_manage.SiteRuleListReq) ([]*wcd_manage.
 }
 
 func (r *RuleManageService) Detail(req wcd_manage.SiteRuleDetailReq) (*wcd_manage.SiteRuleData, error) {
-	dal := mongo.SiteRuleModelDal
+	dal := store.SiteRules
 	rule, err := dal.FindOne(r.ctx, req.Host, consts.RuleStage(req.Stage))
 	if err != nil {
 		hlog.CtxErrorf(r.ctx, "find site rule failed, host: %s, err: %v", req.Host, err)
@@ -118,7 +122,7 @@ func (r *RuleManageService) Detail(req wcd_manage.SiteRuleDetailReq) (*wcd_manag
 }
 
 func (r *RuleManageService) NewTestingRule(req wcd_manage.CreateSiteRuleReq) (*wcd_manage.SiteRuleData, error) {
-	dal := mongo.SiteRuleModelDal
+	dal := store.SiteRules
 	// 1. 先判断是否存在测试规则
 	testingExists := dal.Exists(r.ctx, req.Host, consts.RuleStageTesting)
 	if testingExists {
@@ -138,11 +142,12 @@ func (r *RuleManageService) NewTestingRule(req wcd_manage.CreateSiteRuleReq) (*w
 		hlog.CtxErrorf(r.ctx, "create site rule failed, host: %s, err: %v", req.Host, err)
 		return nil, err
 	}
+	rule_index.SiteRuleIndex.Invalidate(r.ctx)
 	return rule.ToThrift(), nil
 }
 
 func (r *RuleManageService) ProdToTest(req wcd_manage.ExportProdRuleToTestReq) (*wcd_manage.SiteRuleData, error) {
-	dal := mongo.SiteRuleModelDal
+	dal := store.SiteRules
 	// 1. 先判断是否存在测试规则
 	testingExists := dal.Exists(r.ctx, req.Host, consts.RuleStageTesting)
 	if testingExists {
@@ -163,11 +168,12 @@ func (r *RuleManageService) ProdToTest(req wcd_manage.ExportProdRuleToTestReq) (
 		hlog.CtxErrorf(r.ctx, "create site rule failed, host: %s, err: %v", req.Host, err)
 		return nil, err
 	}
+	rule_index.SiteRuleIndex.Invalidate(r.ctx)
 	return rule.ToThrift(), nil
 }
 
 func (r *RuleManageService) Publish(req wcd_manage.PublishSiteRuleReq) error {
-	dal := mongo.SiteRuleModelDal
+	dal := store.SiteRules
 	testingRules, err := dal.FindManyTestingRules(r.ctx, req.Host)
 	if err != nil {
 		hlog.CtxErrorf(r.ctx, "find site rule failed, host: %s, err: %v", req.Host, err)
@@ -193,24 +199,31 @@ func (r *RuleManageService) Publish(req wcd_manage.PublishSiteRuleReq) error {
 		hlog.CtxErrorf(r.ctx, "delete site rule failed, host: %s, err: %v", req.Host, err)
 		return err
 	}
-	return nil
+	rule_index.SiteRuleIndex.Invalidate(r.ctx)
+	revisionErrs := []error{}
+	for _, rule := range prodRules {
+		revisionErrs = append(revisionErrs, r.recordRevision(rule.Host, consts.RuleStageProd, nil, consts.RuleAction_Publish, req.GetAuthor(), req.GetComment()))
+	}
+	return errors.Join(revisionErrs...)
 }
 
 func (r *RuleManageService) Delete(req wcd_manage.DeleteSiteRuleReq) error {
-	dal := mongo.SiteRuleModelDal
-	exists := dal.Exists(r.ctx, req.Host, consts.RuleStage(req.Stage))
-	if !exists {
+	dal := store.SiteRules
+	// 删除前的规则记录在版本中
+	oldModel, err := dal.FindOne(r.ctx, req.Host, consts.RuleStage(req.Stage))
+	if err != nil || oldModel == nil {
 		hlog.CtxErrorf(r.ctx, "site rule not found, host: %s, stage: %s", req.Host, req.Stage)
 		return errors.New("site rule not found")
 	}
-	err := dal.DeleteMany(r.ctx, []mongo.SiteRuleModel{
+	err = dal.DeleteMany(r.ctx, []mongo.SiteRuleModel{
 		{Host: req.Host, Stage: consts.RuleStage(req.Stage)},
 	})
 	if err != nil {
 		hlog.CtxErrorf(r.ctx, "delete site rule failed, host: %s, err: %v", req.Host, err)
 		return err
 	}
-	return nil
+	rule_index.SiteRuleIndex.Invalidate(r.ctx)
+	return r.recordRevision(req.Host, oldModel.Stage, oldModel, consts.RuleAction_Delete, req.GetAuthor(), req.GetComment())
 }
 
 func (r *RuleManageService) Update(req wcd_manage.SiteRuleData) error {
@@ -218,8 +231,14 @@ func (r *RuleManageService) Update(req wcd_manage.SiteRuleData) error {
 		// 不允许更新线上规则
 		return errors.New("prod rule can not be updated")
 	}
+	if err := checkCrawlPolicy(req); err != nil {
+		return err
+	}
+	if err := r.validateOrError([]*wcd_manage.SiteRuleData{&req}, nil); err != nil {
+		return err
+	}
 	// 1. 先判断是否存在测试规则
-	dal := mongo.SiteRuleModelDal
+	dal := store.SiteRules
 	oldModel, err := dal.FindOne(r.ctx, req.Host, consts.RuleStageTesting)
 	if err != nil {
 		hlog.CtxErrorf(r.ctx, "find site rule failed, host: %s, err: %v", req.Host, err)
@@ -234,6 +253,10 @@ func (r *RuleManageService) Update(req wcd_manage.SiteRuleData) error {
 	//	oldModel.Host = req.Host
 	//}
 	oldModel.HostName = req.Name
+	oldModel.MatchType = req.MatchType
+	oldModel.HostPattern = req.HostPattern
+	oldModel.PathPattern = req.PathPattern
+	oldModel.Priority = int(req.Priority)
 	oldModel.Bodies = req.Bodies
 	oldModel.Noises = req.Noises
 	oldModel.Author = req.Author
@@ -243,6 +266,13 @@ func (r *RuleManageService) Update(req wcd_manage.SiteRuleData) error {
 	oldModel.NoSemanticDenoise = req.NoSemanticDenoise
 	oldModel.NeedBrowserCrawl = req.NeedBrowserCrawl
 	oldModel.BodyUseRuleOnly = req.BodyUseRuleOnly
+	oldModel.Labeler = req.Labeler
+	oldModel.CrawlBackends = req.CrawlBackends
+	oldModel.CrawlHeaders = req.CrawlHeaders
+	oldModel.CrawlCookie = req.CrawlCookie
+	oldModel.CrawlTimeout = req.CrawlTimeout
+	oldModel.CrawlQps = req.CrawlQPS
+	oldModel.CrawlMaxInFlight = int(req.CrawlMaxInFlight)
 	// 2. 更新测试规则
 	err = dal.UpsertMany(r.ctx, []*mongo.SiteRuleModel{
 		oldModel,
@@ -251,11 +281,26 @@ func (r *RuleManageService) Update(req wcd_manage.SiteRuleData) error {
 		hlog.CtxErrorf(r.ctx, "update site rule failed, host: %s, err: %v", req.Host, err)
 		return err
 	}
-	return nil
+	rule_index.SiteRuleIndex.Invalidate(r.ctx)
+	return r.recordRevision(req.Host, consts.RuleStageTesting, nil, consts.RuleAction_Update, req.GetRevisionAuthor(), req.GetRevisionComment())
+}
+
+// checkCrawlPolicy 抓取后端需已注册，请求头和超时需能解析
+func checkCrawlPolicy(req wcd_manage.SiteRuleData) error {
+	if req.CrawlQPS < 0 || req.CrawlMaxInFlight < 0 {
+		return errors.New("crawl qps and max in flight can not be negative")
+	}
+	for _, name := range req.CrawlBackends {
+		if http.GetCrawler(name) == nil {
+			return fmt.Errorf("crawl backend not found: %v", name)
+		}
+	}
+	_, err := http.NewCrawlExtraParams(req.CrawlHeaders, req.CrawlCookie, req.CrawlTimeout)
+	return err
 }
 
 func (r *RuleManageService) Export(req wcd_manage.EmptyReq) ([]*wcd_manage.SiteRuleData, error) {
-	dal := mongo.SiteRuleModelDal
+	dal := store.SiteRules
 	models, err := dal.FindMany(r.ctx, consts.RuleStageProd)
 	if err != nil {
 		hlog.CtxErrorf(r.ctx, "find site rule failed, err: %v", err)
@@ -292,15 +337,24 @@ func (r *RuleManageService) ImportFromCtx(c *app.RequestContext) error {
 }
 
 func (r *RuleManageService) Import(data []*wcd_manage.SiteRuleData) error {
-	dal := mongo.SiteRuleModelDal
+	dal := store.SiteRules
 	models := utils.Map(data, func(rule *wcd_manage.SiteRuleData) *mongo.SiteRuleModel {
 		model := &mongo.SiteRuleModel{}
 		return model.FromThrift(rule)
 	})
+	// 导入的规则之间也不能重叠
+	if err := r.validateOrError(data, models); err != nil {
+		return err
+	}
 	err := dal.SaveMany(r.ctx, models)
 	if err != nil {
 		hlog.CtxErrorf(r.ctx, "update site rule failed, err: %v", err)
 		return err
 	}
-	return nil
+	rule_index.SiteRuleIndex.Invalidate(r.ctx)
+	revisionErrs := []error{}
+	for _, model := range models {
+		revisionErrs = append(revisionErrs, r.recordRevision(model.Host, model.Stage, nil, consts.RuleAction_Import, "", ""))
+	}
+	return errors.Join(revisionErrs...)
 }
@@ -0,0 +1,253 @@
+package manage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/store"
+	"github.com/DeepLangAI/wcd/tools/doc"
+	"github.com/DeepLangAI/wcd/tools/extractor"
+	"github.com/DeepLangAI/wcd/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 样例网页只用于统计命中数，较早缓存的网页也可以使用
+const sampleHtmlMaxAge = 30 * 24 * time.Hour
+
+// RuleValidationError 规则校验不通过，Errors为各字段的错误
+type RuleValidationError struct {
+	Errors []*wcd_manage.SiteRuleFieldError
+}
+
+func (e *RuleValidationError) Error() string {
+	if len(e.Errors) == 0 {
+		return "invalid site rule"
+	}
+	first := e.Errors[0]
+	msg := fmt.Sprintf("invalid site rule, host: %v, field: %v, value: %v, err: %v", first.Host, first.Field, first.Value, first.Message)
+	if len(e.Errors) > 1 {
+		msg += fmt.Sprintf(" (and %v more)", len(e.Errors)-1)
+	}
+	return msg
+}
+
+// ruleXpath 规则中的一条xpath，标题、作者、发布时间可以用" | "分隔多条
+type ruleXpath struct {
+	field   string
+	index   int32
+	xpath   string
+	extract bool // 提取字段的xpath可以带/@attr、/text()等后缀
+}
+
+func ruleXpaths(rule *wcd_manage.SiteRuleData) []ruleXpath {
+	xpaths := []ruleXpath{}
+	for _, field := range []struct {
+		name   string
+		values []string
+	}{
+		{"bodies", rule.Bodies},
+		{"noises", rule.Noises},
+	} {
+		for i, value := range field.values {
+			xpaths = append(xpaths, ruleXpath{field: field.name, index: int32(i), xpath: value})
+		}
+	}
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"title", rule.Title},
+		{"author", rule.Author},
+		{"pub_time", rule.PubTime},
+	} {
+		if field.value == "" || field.value == consts.EmptyExtractXpath {
+			continue
+		}
+		for i, value := range strings.Split(field.value, consts.XpathSep) {
+			xpaths = append(xpaths, ruleXpath{field: field.name, index: int32(i), xpath: value, extract: true})
+		}
+	}
+	return xpaths
+}
+
+// elemXpath 用于查询节点的xpath
+func (x ruleXpath) elemXpath() string {
+	if x.extract {
+		return extractor.ElemXpath(x.xpath)
+	}
+	return x.xpath
+}
+
+// RuleValidator 检查规则的xpath语法和host，others为用于检查host重叠的其他规则
+type RuleValidator struct {
+	others []*mongo.SiteRuleModel
+}
+
+// newRuleValidator extra为将要一起保存的规则，如导入的规则
+func (r *RuleManageService) newRuleValidator(extra []*mongo.SiteRuleModel) (*RuleValidator, error) {
+	others := []*mongo.SiteRuleModel{}
+	for _, stage := range []consts.RuleStage{consts.RuleStageProd, consts.RuleStageTesting} {
+		models, err := store.SiteRules.FindMany(r.ctx, stage)
+		if err != nil {
+			hlog.CtxErrorf(r.ctx, "find site rule failed, stage: %v, err: %v", stage, err)
+			return nil, err
+		}
+		for i := range models {
+			others = append(others, &models[i])
+		}
+	}
+	return &RuleValidator{others: append(others, extra...)}, nil
+}
+
+func (v *RuleValidator) Validate(rule *wcd_manage.SiteRuleData) []*wcd_manage.SiteRuleFieldError {
+	fieldErrors := append([]*wcd_manage.SiteRuleFieldError{}, v.validateHost(rule)...)
+	for _, x := range ruleXpaths(rule) {
+		newError := func(msg string) *wcd_manage.SiteRuleFieldError {
+			return &wcd_manage.SiteRuleFieldError{Host: rule.Host, Field: x.field, Index: x.index, Value: x.xpath, Message: msg}
+		}
+		if strings.TrimSpace(x.xpath) == "" {
+			fieldErrors = append(fieldErrors, newError("xpath is empty"))
+			continue
+		}
+		if err := doc.CompileXpath(x.elemXpath()); err != nil {
+			fieldErrors = append(fieldErrors, newError(err.Error()))
+		}
+	}
+	return fieldErrors
+}
+
+// validateHost 检查host、匹配方式和路径规则，以及与其他规则的重叠。
+// 正则或通配符规则与其他规则重叠，且按mongo.CompareMatchers无法区分先后时，结果只取决于host的字典序，不允许保存
+func (v *RuleValidator) validateHost(rule *wcd_manage.SiteRuleData) []*wcd_manage.SiteRuleFieldError {
+	newError := func(field string, value string, format string, args ...any) []*wcd_manage.SiteRuleFieldError {
+		return []*wcd_manage.SiteRuleFieldError{{Host: rule.Host, Field: field, Value: value, Message: fmt.Sprintf(format, args...)}}
+	}
+	host := rule.Host
+	if host == "" {
+		return newError("host", host, "host is empty")
+	}
+	if strings.ContainsFunc(host, unicode.IsSpace) {
+		return newError("host", host, "host contains whitespace")
+	}
+	if !utils.Contains(consts.HostMatchTypes, rule.MatchType) {
+		return newError("match_type", rule.MatchType, "unknown match type, should be one of: %v", strings.Join(consts.HostMatchTypes[1:], ", "))
+	}
+	model := (&mongo.SiteRuleModel{}).FromThrift(rule)
+	hostField := "host"
+	if rule.HostPattern != "" {
+		hostField = "host_pattern"
+	}
+	hostPattern := model.GetHostPattern()
+	// 匹配前会去掉url的协议头和www前缀，带有这些前缀的host不会命中任何url
+	if strings.Contains(hostPattern, "://") || strings.HasPrefix(hostPattern, "www.") {
+		return newError(hostField, hostPattern, "host should not contain scheme or www prefix, they are removed from url before matching")
+	}
+	if rule.MatchType != consts.HostMatch_Legacy && strings.ContainsAny(hostPattern, "/:") {
+		return newError(hostField, hostPattern, "host pattern should not contain path or port, use path_pattern instead")
+	}
+	if rule.PathPattern != "" && rule.MatchType != consts.HostMatch_Regex && !strings.HasPrefix(rule.PathPattern, "/") {
+		return newError("path_pattern", rule.PathPattern, "path pattern should start with /")
+	}
+	matcher := mongo.NewRuleMatcher(model)
+	if err := matcher.Err(); err != nil {
+		if matcher.HostErr() {
+			return newError(hostField, hostPattern, "invalid host pattern: %v", err)
+		}
+		return newError("path_pattern", rule.PathPattern, "invalid path pattern: %v", err)
+	}
+
+	overlaps := []string{}
+	for _, other := range v.others {
+		if other.Host == host || utils.Contains(overlaps, other.Host) {
+			continue
+		}
+		otherMatcher := mongo.NewRuleMatcher(other)
+		// 能按优先级、匹配方式或长度区分先后的重叠是确定的，如旧规则按host长度取最长的一条
+		if otherMatcher.Err() != nil || mongo.CompareMatchers(matcher, otherMatcher) != 0 {
+			continue
+		}
+		overlapped := false
+		if sample, ok := otherMatcher.SampleUrl(); ok && matcher.IsPattern() {
+			overlapped = matcher.Match(sample)
+		} else if sample, ok := matcher.SampleUrl(); ok && otherMatcher.IsPattern() {
+			overlapped = otherMatcher.Match(sample)
+		}
+		if overlapped {
+			overlaps = append(overlaps, other.Host)
+		}
+	}
+	if len(overlaps) > 0 {
+		return newError("host", host, "host overlaps with other site rules: %v, set a different priority to decide which one wins", strings.Join(overlaps, ", "))
+	}
+	return nil
+}
+
+// validateOrError 校验不通过时返回*RuleValidationError
+func (r *RuleManageService) validateOrError(rules []*wcd_manage.SiteRuleData, extra []*mongo.SiteRuleModel) error {
+	validator, err := r.newRuleValidator(extra)
+	if err != nil {
+		return err
+	}
+	fieldErrors := []*wcd_manage.SiteRuleFieldError{}
+	for _, rule := range rules {
+		fieldErrors = append(fieldErrors, validator.Validate(rule)...)
+	}
+	if len(fieldErrors) > 0 {
+		hlog.CtxWarnf(r.ctx, "site rule validation failed, num errors: %v", len(fieldErrors))
+		return &RuleValidationError{Errors: fieldErrors}
+	}
+	return nil
+}
+
+// Validate 校验规则但不保存，传入样例网页时统计各xpath命中的节点数
+func (r *RuleManageService) Validate(req wcd_manage.ValidateSiteRuleReq) ([]*wcd_manage.SiteRuleFieldError, []*wcd_manage.SiteRuleXpathMatch, error) {
+	if req.Rule == nil {
+		return nil, nil, errors.New("rule is empty")
+	}
+	validator, err := r.newRuleValidator(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	fieldErrors := validator.Validate(req.Rule)
+	if req.GetSampleURL() == "" && req.GetSampleHTML() == "" {
+		return fieldErrors, []*wcd_manage.SiteRuleXpathMatch{}, nil
+	}
+
+	htmlStr := req.GetSampleHTML()
+	if htmlStr == "" {
+		model, err := store.CrawlHtml.FindByUrl(r.ctx, utils.CanonicalUrl(req.GetSampleURL()), sampleHtmlMaxAge)
+		if err != nil || model == nil {
+			return nil, nil, errors.New("sample page not cached, pass sample_html or parse sample_url first")
+		}
+		htmlStr = model.Html
+	}
+	sampleDoc, err := doc.NewDocument(r.ctx, utils.UnescapeHtml(htmlStr), req.GetSampleURL(), wcd.RuleStageGroupEnum_ProdOnly)
+	if err != nil {
+		hlog.CtxErrorf(r.ctx, "load sample page failed, err: %v", err)
+		return nil, nil, err
+	}
+	invalid := map[string]bool{}
+	for _, fieldError := range fieldErrors {
+		invalid[fmt.Sprintf("%v|%v", fieldError.Field, fieldError.Index)] = true
+	}
+	matches := []*wcd_manage.SiteRuleXpathMatch{}
+	for _, x := range ruleXpaths(req.Rule) {
+		if invalid[fmt.Sprintf("%v|%v", x.field, x.index)] {
+			continue
+		}
+		matches = append(matches, &wcd_manage.SiteRuleXpathMatch{
+			Field: x.field,
+			Index: x.index,
+			Xpath: x.xpath,
+			Count: int32(len(sampleDoc.Xpath(x.elemXpath()))),
+		})
+	}
+	return fieldErrors, matches, nil
+}
@@ -3,6 +3,7 @@ package wcd
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -11,11 +12,14 @@ import (
 	"github.com/DeepLangAI/wcd/conf"
 	"github.com/DeepLangAI/wcd/consts"
 	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/store"
 	"github.com/DeepLangAI/wcd/http"
 	"github.com/DeepLangAI/wcd/model/http_model"
 	"github.com/DeepLangAI/wcd/tools/doc"
+	"github.com/DeepLangAI/wcd/tools/robots"
 	"github.com/DeepLangAI/wcd/utils"
 
+	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/beevik/etree"
 
 	"github.com/bytedance/sonic"
@@ -44,28 +48,74 @@ func (b *BaseParseService) BaseParse(ctx context.Context, req wcd.BaseParseReq)
 			)
 		}
 	}()
+	if bizErr := checkOutputFormats(req.GetOutputFormats()); bizErr != nil {
+		return nil, bizErr
+	}
 	return b.webBaseParse(ctx, req)
 }
 
 const (
-	CrawlerName_Unk     = "unk"
-	CrawlerName_Cache   = "cache"
-	CrawlerName_Crawler = "crawler"
+	CrawlerName_Unk   = "unk"
+	CrawlerName_Cache = "cache"
 )
 
 type CrawlResult struct {
 	Html        string
 	NeedCache   bool
 	CrawlerName string
+	FinalUrl    string            // 重定向后的最终url
+	StatusCode  int               // 网页的http状态码，旧的缓存为0
+	Headers     map[string]string // 网页的响应头
+	ContentType string
+	FetchTime   time.Time           // 抓取时间，命中缓存时为缓存的抓取时间
+	ContentHash string              // 缓存的html的md5
+	Changed     *bool               // 与上次缓存的网页相比内容是否变化，没有缓存时为nil
+	Revalidated bool                // 缓存过期后通过条件请求确认网页未变化
+	Attempts    []*wcd.CrawlAttempt // 抓取过程，抓取失败时也有
+}
+
+// Meta 返回给调用方的抓取信息
+func (c *CrawlResult) Meta() *wcd.CrawlMeta {
+	meta := &wcd.CrawlMeta{
+		StatusCode:  int32(c.StatusCode),
+		FinalURL:    c.FinalUrl,
+		ContentType: c.ContentType,
+		Headers:     c.Headers,
+		ContentHash: c.ContentHash,
+		Changed:     c.Changed,
+		Revalidated: c.Revalidated,
+	}
+	if !c.FetchTime.IsZero() {
+		meta.FetchTime = c.FetchTime.Format(time.RFC3339)
+	}
+	return meta
 }
 
-func (b *BaseParseService) checkUrlNeedBrowserCrawl(ctx context.Context, htmlUrl string, ruleStageGeoup wcd.RuleStageGroupEnum) bool {
+// matchRule 抓取前按url匹配站点规则，未匹配时返回nil
+func (b *BaseParseService) matchRule(htmlUrl string, ruleStageGeoup wcd.RuleStageGroupEnum) *mongo.SiteRuleModel {
 	doc := doc.Document{Url: htmlUrl, RuleStageGroup: ruleStageGeoup}
 	rule, err := doc.MatchRule()
-	if err != nil || rule == nil {
-		return false
+	if err != nil {
+		return nil
+	}
+	return rule
+}
+
+// crawlPolicy 站点规则中配置的抓取链、请求参数和限流，未配置时使用全局配置
+func (b *BaseParseService) crawlPolicy(ctx context.Context, rule *mongo.SiteRuleModel) ([]http.Crawler, *http.CrawlExtraParams) {
+	if rule == nil {
+		return http.ResolveChain(ctx, nil), nil
+	}
+	ext, err := http.NewCrawlExtraParams(rule.CrawlHeaders, rule.CrawlCookie, rule.CrawlTimeout)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "invalid crawl policy of site rule, ignore, host: %v, err: %v", rule.Host, err)
+		ext = &http.CrawlExtraParams{}
 	}
-	return rule.NeedBrowserCrawl
+	ext.Limit = http.HostLimit{
+		Qps:         rule.CrawlQps,
+		MaxInFlight: rule.CrawlMaxInFlight,
+	}
+	return http.ResolveChain(ctx, rule.CrawlBackends), ext
 }
 
 func (b *BaseParseService) checkNeedBrowserCrawl(ctx context.Context, htmlUrl string, html string, ruleStageGeoup wcd.RuleStageGroupEnum) bool {
@@ -90,81 +140,256 @@ func (b *BaseParseService) checkNeedBrowserCrawl(ctx context.Context, htmlUrl st
 	return false
 }
 
+func toThriftCrawlAttempt(attempt http.CrawlAttempt) *wcd.CrawlAttempt {
+	result := &wcd.CrawlAttempt{
+		Backend:    attempt.Backend,
+		Attempt:    int32(attempt.Attempt),
+		CostMs:     attempt.Cost.Milliseconds(),
+		FinalURL:   attempt.FinalUrl,
+		StatusCode: int32(attempt.StatusCode),
+	}
+	if attempt.Err != nil {
+		result.Error = attempt.Err.Error()
+	}
+	return result
+}
+
+// cachedCrawlResult 使用缓存的网页
+func cachedCrawlResult(htmlUrl string, model *mongo.CrawlHtmlModel) *CrawlResult {
+	finalUrl, fetchTime := model.FinalUrl, model.FetchTime
+	if finalUrl == "" {
+		finalUrl = htmlUrl
+	}
+	if fetchTime.IsZero() {
+		fetchTime = model.CreateTime
+	}
+	return &CrawlResult{
+		Html:        model.Html,
+		NeedCache:   false,
+		CrawlerName: CrawlerName_Cache,
+		FinalUrl:    finalUrl,
+		StatusCode:  model.StatusCode,
+		Headers:     model.Headers,
+		ContentType: model.ContentType,
+		FetchTime:   fetchTime,
+		ContentHash: contentHash(model),
+		Changed:     thrift.BoolPtr(false),
+		Attempts: []*wcd.CrawlAttempt{
+			{Backend: CrawlerName_Cache, Attempt: 1, Success: true, FinalURL: finalUrl, StatusCode: int32(model.StatusCode)},
+		},
+	}
+}
+
+// contentHash 旧的缓存没有保存hash，按html计算
+func contentHash(model *mongo.CrawlHtmlModel) string {
+	if model.ContentHash != "" {
+		return model.ContentHash
+	}
+	return utils.StrToMd5(model.Html)
+}
+
+// conditionalHeaders 缓存中有ETag或Last-Modified时返回条件请求的请求头，否则返回nil
+func conditionalHeaders(model *mongo.CrawlHtmlModel) map[string]string {
+	if model == nil || (model.ETag == "" && model.LastModified == "") {
+		return nil
+	}
+	headers := map[string]string{}
+	if model.ETag != "" {
+		headers["If-None-Match"] = model.ETag
+	}
+	if model.LastModified != "" {
+		headers["If-Modified-Since"] = model.LastModified
+	}
+	return headers
+}
+
+// crawlHtmlWithCache 先查缓存，未命中时依次使用抓取链中的后端抓取。
+// 站点需要浏览器渲染时只使用浏览器后端；普通抓取的内容不完整时改用之后的浏览器后端。
+// 网页返回404等状态码时继续尝试之后的后端，都没有2xx的网页时使用第一个非2xx的网页。
+// 缓存过期但在html_revalidate_hours内时，第一个非浏览器后端发送条件请求，返回304时继续使用缓存。
+// readCache为false时不查缓存，直接抓取
 func (b *BaseParseService) crawlHtmlWithCache(
 	ctx context.Context,
 	htmlUrl string,
 	ruleStageGroup wcd.RuleStageGroupEnum,
-	needBrowserCrawl bool,
+	readCache bool,
 ) (*CrawlResult, error) {
-	expireDuration := time.Hour * time.Duration(conf.GetConfig().Parse.Crawl.HtmlCacheHours)
-	model, err := mongo.CrawlHtmlModelDal.FindByUrl(ctx, htmlUrl, expireDuration)
-	if err == nil && model.Html != "" {
+	crawlCfg := conf.GetConfig().Parse.Crawl
+	expireDuration := time.Hour * time.Duration(crawlCfg.HtmlCacheHours)
+	revalidateDuration := time.Hour * time.Duration(max(crawlCfg.HtmlRevalidateHours, 0))
+	// 上次缓存的网页，用于条件请求和判断内容是否变化
+	var previous *mongo.CrawlHtmlModel
+	if readCache {
+		model, err := store.CrawlHtml.FindByUrl(ctx, utils.CanonicalUrl(htmlUrl), expireDuration+revalidateDuration)
+		if err == nil && model.Html != "" {
+			previous = model
+		}
+	}
+	if previous != nil && !previous.CreateTime.Before(time.Now().Add(-1*expireDuration)) {
 		hlog.CtxInfof(ctx, "crawlHtmlWithCache hit cache")
-		return &CrawlResult{
-			Html:        model.Html,
-			NeedCache:   false,
-			CrawlerName: CrawlerName_Cache,
-		}, nil
+		return cachedCrawlResult(htmlUrl, previous), nil
 	} else {
-		hlog.CtxInfof(ctx, "crawlHtmlWithCache not hit cache")
-	}
-	// 用crawler
-	if !needBrowserCrawl {
-		crawlResult, err := http.CrawlHtml(ctx, htmlUrl, nil)
-		data := crawlResult.Data
-		if err == nil && data.Html != "" {
-			if !b.checkNeedBrowserCrawl(ctx, htmlUrl, data.Html, ruleStageGroup) {
-				return &CrawlResult{
-					Html:        data.Html,
-					NeedCache:   true,
-					CrawlerName: CrawlerName_Crawler,
-				}, nil
-			} else {
-				needBrowserCrawl = true
+		hlog.CtxInfof(ctx, "crawlHtmlWithCache not hit cache, has previous: %v", previous != nil)
+	}
+	condHeaders := conditionalHeaders(previous)
+
+	rule := b.matchRule(htmlUrl, ruleStageGroup)
+	chain, ext := b.crawlPolicy(ctx, rule)
+	hasBrowser := utils.Any(chain, func(crawler http.Crawler) bool {
+		return crawler.Browser()
+	})
+	needBrowserCrawl := rule != nil && rule.NeedBrowserCrawl
+	if needBrowserCrawl && !hasBrowser {
+		hlog.CtxWarnf(ctx, "site rule need browser crawl, but no browser crawler in chain")
+	}
+
+	result := &CrawlResult{Attempts: []*wcd.CrawlAttempt{}}
+	var fallback *CrawlResult
+	var fallbackAttempt *wcd.CrawlAttempt
+	for _, crawler := range chain {
+		if needBrowserCrawl && hasBrowser && !crawler.Browser() {
+			continue
+		}
+		crawlExt, conditional := ext, false
+		if condHeaders != nil && !crawler.Browser() {
+			// 只发送一次条件请求，失败时之后的后端正常抓取
+			crawlExt, conditional = ext.WithHeaders(condHeaders), true
+			condHeaders = nil
+		}
+		resp, attempts, err := http.CrawlWithRetry(ctx, crawler, htmlUrl, crawlExt)
+		result.Attempts = append(result.Attempts, utils.Map(attempts, toThriftCrawlAttempt)...)
+		if errors.Is(err, http.ErrRateLimited) {
+			// 限流针对站点，换后端也一样
+			return result, err
+		}
+		if err != nil {
+			continue
+		}
+		last := result.Attempts[len(result.Attempts)-1]
+		if conditional && resp.Data.NotModified() {
+			hlog.CtxInfof(ctx, "crawlHtmlWithCache revalidated, crawler: %v", crawler.Name())
+			last.Success = true
+			revalidated := cachedCrawlResult(htmlUrl, previous)
+			revalidated.NeedCache = true // 重新保存，刷新缓存时间
+			revalidated.CrawlerName = crawler.Name()
+			revalidated.Revalidated = true
+			revalidated.Attempts = result.Attempts
+			return revalidated, nil
+		}
+		if resp.Data.Html == "" {
+			last.Error = "html is empty"
+			continue
+		}
+		if hasBrowser && !crawler.Browser() && b.checkNeedBrowserCrawl(ctx, htmlUrl, resp.Data.Html, ruleStageGroup) {
+			last.Error = "need browser crawl"
+			needBrowserCrawl = true
+			continue
+		}
+		crawled := &CrawlResult{
+			Html:        resp.Data.Html,
+			NeedCache:   resp.Data.OK(),
+			CrawlerName: crawler.Name(),
+			FinalUrl:    resp.Data.FinalUrl,
+			StatusCode:  resp.Data.StatusCode,
+			Headers:     resp.Data.Headers,
+			ContentType: resp.Data.ContentType,
+			FetchTime:   resp.Data.FetchTime,
+			ContentHash: utils.StrToMd5(utils.UnescapeHtml(resp.Data.Html)), // 与缓存的html一致
+		}
+		if previous != nil {
+			crawled.Changed = thrift.BoolPtr(crawled.ContentHash != contentHash(previous))
+		}
+		if !resp.Data.OK() {
+			last.Error = fmt.Sprintf("http status %d", resp.Data.StatusCode)
+			if fallback == nil {
+				fallback, fallbackAttempt = crawled, last
 			}
+			continue
 		}
+		last.Success = true
+		crawled.Attempts = result.Attempts
+		return crawled, nil
 	}
 
-	// 用crawler，用浏览器抓取
-	if needBrowserCrawl {
-		crawlResult, err := http.CrawlHtml(ctx, htmlUrl, &http.CrawlExtraParams{ForceBroswer: true})
-		data := crawlResult.Data
-		if err == nil && data.Html != "" {
-			return &CrawlResult{
-				Html:        data.Html,
-				NeedCache:   true,
-				CrawlerName: CrawlerName_Crawler,
-			}, nil
+	if fallback != nil {
+		fallbackAttempt.Success = true
+		fallback.Attempts = result.Attempts
+		return fallback, nil
+	}
+	return result, errors.New("all crawler failed")
+}
+
+// cacheUrls 网页缓存的url和别名，都是规范化后的url。网页有同host的rel=canonical时以它为准，
+// 请求的url和跳转后的url作为别名，之后用这些url都能命中缓存
+func (b *BaseParseService) cacheUrls(reqUrl string, crawlResult *CrawlResult, htmlStr string) (string, []string) {
+	pageUrl := reqUrl
+	if crawlResult != nil && crawlResult.FinalUrl != "" {
+		pageUrl = crawlResult.FinalUrl
+	}
+	cacheUrl := utils.CanonicalUrl(pageUrl)
+	// 只接受同host的canonical，避免其他站点的网页覆盖该站点的缓存
+	if link := utils.ExtractCanonicalLink(htmlStr, pageUrl); link != "" {
+		canonical := utils.CanonicalUrl(link)
+		if utils.ExtractUrlHost(canonical) == utils.ExtractUrlHost(cacheUrl) {
+			cacheUrl = canonical
 		}
 	}
+	aliases := []string{}
+	for _, u := range []string{reqUrl, pageUrl} {
+		if alias := utils.CanonicalUrl(u); alias != cacheUrl && !utils.Contains(aliases, alias) {
+			aliases = append(aliases, alias)
+		}
+	}
+	return cacheUrl, aliases
+}
 
-	return nil, errors.New("all crawler failed")
+// needSaveHtml 抓取到的网页默认缓存，save_crawl_html为false时不缓存；请求传入的html只在save_crawl_html为true时缓存
+func (b *BaseParseService) needSaveHtml(req wcd.BaseParseReq) bool {
+	if req.SaveCrawlHTML != nil {
+		return *req.SaveCrawlHTML
+	}
+	return req.GetHTML() == ""
 }
 
 func (b *BaseParseService) webBaseParse(ctx context.Context, req wcd.BaseParseReq) (*wcd.WcdParseResp, *consts.BizCode) {
 
-	// 如果传入了html，但检测到需要浏览器渲染后的抓取结果，则忽略传入的html
-	needBrowserCrawl := false
-
 	// 1. 抓取网页
 	var err error
 	htmlStr := ""
 	needCacheHtml := false
 	crawlerName := ""
+	var crawlAttempts []*wcd.CrawlAttempt
+	var crawlResult *CrawlResult
+	respectRobots := robots.Enabled(req.RespectRobots)
+	// skip_cache时不读取网页和解析结果的缓存，仍按配置写入
+	cachePolicy := cachePolicyOf(ctx, req.GetSkipCache())
 
 	if req.GetHTML() == "" {
-		if b.checkUrlNeedBrowserCrawl(ctx, req.URL, req.GetRuleStageGroup()) {
-			hlog.CtxInfof(ctx, "checkUrlNeedBrowserCrawl before crawl, check need browser to crawl")
-			needBrowserCrawl = true
+		if respectRobots && !robots.Allowed(ctx, req.URL) {
+			return nil, consts.RobotsDisallowed.WithDetail("robots.txt")
 		}
-		crawlResult, err := b.crawlHtmlWithCache(ctx, req.URL, req.GetRuleStageGroup(), needBrowserCrawl)
+		crawlResult, err = b.crawlHtmlWithCache(ctx, req.URL, req.GetRuleStageGroup(), cachePolicy.Read)
 		if err != nil {
 			hlog.CtxErrorf(ctx, "webBaseParse crawlHtmlWithCache err:%v", err)
-			return nil, &consts.CrawlFailed
+			if errors.Is(err, http.ErrRateLimited) {
+				return &wcd.WcdParseResp{CrawlAttempts: crawlResult.Attempts}, consts.CrawlRateLimited.WithDetail("%v", utils.ExtractUrlHost(req.URL))
+			}
+			return &wcd.WcdParseResp{CrawlAttempts: crawlResult.Attempts}, &consts.CrawlFailed
 		}
 		htmlStr = crawlResult.Html
 		needCacheHtml = crawlResult.NeedCache
 		crawlerName = crawlResult.CrawlerName
+		crawlAttempts = crawlResult.Attempts
+		if crawlResult.FinalUrl != "" && crawlResult.FinalUrl != req.URL {
+			hlog.CtxInfof(ctx, "crawl redirected, url: %v, final_url: %v", req.URL, crawlResult.FinalUrl)
+			// 重定向后的网页可能被robots.txt禁止，按最终地址重新检查
+			if respectRobots && !robots.Allowed(ctx, crawlResult.FinalUrl) {
+				return &wcd.WcdParseResp{CrawlAttempts: crawlAttempts}, consts.RobotsDisallowed.WithDetail("robots.txt of redirected url")
+			}
+		}
+		if crawlResult.StatusCode != 0 && crawlResult.StatusCode != 200 {
+			hlog.CtxInfof(ctx, "crawl status not ok, url: %v, status: %v", req.URL, crawlResult.StatusCode)
+		}
 	} else {
 		htmlStr = req.GetHTML()
 		needCacheHtml = true
@@ -173,37 +398,77 @@ func (b *BaseParseService) webBaseParse(ctx context.Context, req wcd.BaseParseRe
 	htmlStr = utils.UnescapeHtml(htmlStr)
 	hlog.CtxInfof(ctx, "crawler_name: %v, crawl html success, url:%v", crawlerName, req.URL)
 
+	// noindex的网页不解析；noarchive的网页不缓存，也不返回原始html
+	directives := robots.Directives{}
+	if respectRobots {
+		directives = robots.ParseMeta(htmlStr, robots.UserAgent())
+		if directives.NoIndex {
+			hlog.CtxInfof(ctx, "meta robots noindex, url: %v", req.URL)
+			return &wcd.WcdParseResp{CrawlAttempts: crawlAttempts}, consts.RobotsDisallowed.WithDetail("meta robots noindex")
+		}
+		if directives.NoArchive {
+			needCacheHtml = false
+		}
+	}
+
 	// 2. 解析
 	service := WcdParseService{}
 	parseResult, bizErr := service.WcdParse(ctx, wcd.WcdParseReq{
 		HTML:           htmlStr,
 		URL:            req.URL,
+		Reparse:        req.SkipCache,
 		RuleStageGroup: req.RuleStageGroup,
+		Labeler:        req.Labeler,
+		OutputFormats:  req.OutputFormats,
+		MirrorImages:   req.MirrorImages,
 	})
 
-	if req.GetWithRawHTML() == true {
+	if req.GetWithRawHTML() == true && !directives.NoArchive {
 		parseResult.RawHTML = &htmlStr
 	}
+	parseResult.CrawlAttempts = crawlAttempts
+	if crawlResult != nil {
+		parseResult.CrawlMeta = crawlResult.Meta()
+	}
 
 	if bizErr != nil {
 		hlog.CtxErrorf(ctx, "webBaseParse WcdParse err:%v", err)
 		return parseResult, bizErr
 	}
 
+	// 网页返回404等状态码时，不论内容如何都视为无意义
+	if crawlResult != nil {
+		if worthType := consts.WorthTypeOfStatus(crawlResult.StatusCode); worthType != consts.WorthType_Valueable {
+			parseResult.Worthless = true
+			parseResult.WorthType = int32(worthType)
+		}
+	}
+
 	// 如果本次重新抓取，且解析结果有效，则缓存html。之后可以根据缓存来判断是否需要重新抓取
-	if needCacheHtml && parseResult.Worthless == false {
+	if cachePolicy.Write && needCacheHtml && parseResult.Worthless == false && b.needSaveHtml(req) {
 		hlog.CtxInfof(ctx, "crawler_name: %v, parse result is valid", crawlerName)
-		if req.GetSaveCrawlHTML() {
-			err = mongo.CrawlHtmlModelDal.SaveOne(ctx, mongo.CrawlHtmlModel{
-				Url:        req.URL,
-				Html:       htmlStr,
-				CreateTime: time.Now(),
-				UpdateTime: time.Now(),
-				Status:     consts.StatusValid,
-			})
-			if err != nil {
-				hlog.CtxErrorf(ctx, "crawlHtmlWithCache SaveOne err:%v", err)
-			}
+		cacheUrl, aliases := b.cacheUrls(req.URL, crawlResult, htmlStr)
+		model := mongo.CrawlHtmlModel{
+			Url:        cacheUrl,
+			Aliases:    aliases,
+			Html:       htmlStr,
+			CreateTime: time.Now(),
+			UpdateTime: time.Now(),
+			Status:     consts.StatusValid,
+		}
+		if crawlResult != nil {
+			model.FinalUrl = crawlResult.FinalUrl
+			model.StatusCode = crawlResult.StatusCode
+			model.Headers = crawlResult.Headers
+			model.ContentType = crawlResult.ContentType
+			model.FetchTime = crawlResult.FetchTime
+			model.ETag = http.HeaderValue(crawlResult.Headers, "ETag")
+			model.LastModified = http.HeaderValue(crawlResult.Headers, "Last-Modified")
+			model.ContentHash = crawlResult.ContentHash
+		}
+		err = store.CrawlHtml.SaveOne(ctx, model)
+		if err != nil {
+			hlog.CtxErrorf(ctx, "crawlHtmlWithCache SaveOne err:%v", err)
 		}
 	}
 	return parseResult, nil
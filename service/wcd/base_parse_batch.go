@@ -0,0 +1,146 @@
+package wcd
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/DeepLangAI/go_lib/utillib"
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/utils"
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	defaultBatchMaxItems  = 50
+	defaultBatchWorkerNum = 8
+)
+
+// FailedResp 解析失败时的返回结果，保留已经解析出的部分内容
+func (b *BaseParseService) FailedResp(ctx context.Context, req wcd.BaseParseReq, resp *wcd.WcdParseResp, bizErr *consts.BizCode) *wcd.WcdParseResp {
+	if resp == nil {
+		resp = &wcd.WcdParseResp{}
+	}
+	return &wcd.WcdParseResp{
+		Code:           bizErr.Code,
+		Msg:            bizErr.Msg,
+		URL:            req.URL,
+		Text:           resp.Text,
+		Images:         resp.Images,
+		ReadableHTML:   resp.ReadableHTML,
+		Title:          resp.Title,
+		Author:         resp.Author,
+		ContentSource:  resp.ContentSource,
+		PubTime:        resp.PubTime,
+		ModelInputStr:  resp.ModelInputStr,
+		ModelResultStr: resp.ModelResultStr,
+		Worthless:      true,
+		WorthType:      consts.WorthType_NoContent,
+		WcdRequestID:   utils.GetCtxOperationId(ctx),
+		RawHTML:        resp.RawHTML,
+		CrawlAttempts:  resp.CrawlAttempts,
+		CrawlMeta:      resp.CrawlMeta,
+	}
+}
+
+// batchItemKey 所有字段都相同的请求只解析一次，url相同但规则阶段等选项不同时分别解析
+func batchItemKey(req *wcd.BaseParseReq) string {
+	data, err := sonic.Marshal(req)
+	if err != nil {
+		// 无法序列化时不去重
+		return fmt.Sprintf("%p", req)
+	}
+	return utils.StrToMd5(string(data))
+}
+
+// safeBaseParse 单条解析出错或panic都只影响该条结果。
+// 是否失败以返回的bizErr为准，部分错误码（如抓取失败）与成功相同，不能按resp.Code判断
+func (b *BaseParseService) safeBaseParse(ctx context.Context, req wcd.BaseParseReq) (resp *wcd.WcdParseResp, bizErr *consts.BizCode) {
+	defer func() {
+		if r := recover(); r != nil {
+			buffer := make([]byte, 4096)
+			n := runtime.Stack(buffer, false)
+			hlog.CtxErrorf(ctx, "BaseParseBatch item panic, url: %v, err: %v\nstack:\n%v", req.URL, r, string(buffer[:n]))
+			bizErr = &consts.SystemErr
+			resp = b.FailedResp(ctx, req, nil, bizErr)
+		}
+	}()
+	result, bizErr := b.BaseParse(ctx, req)
+	if bizErr != nil {
+		return b.FailedResp(ctx, req, result, bizErr), bizErr
+	}
+	return result, nil
+}
+
+func (b *BaseParseService) BaseParseBatch(ctx context.Context, req wcd.BaseParseBatchReq) (*wcd.BaseParseBatchResp, *consts.BizCode) {
+	batchConf := conf.GetConfig().Parse.Batch
+	maxItems, workerNum := batchConf.MaxItems, batchConf.WorkerNum
+	if maxItems <= 0 {
+		maxItems = defaultBatchMaxItems
+	}
+	if workerNum <= 0 {
+		workerNum = defaultBatchWorkerNum
+	}
+	if len(req.Items) == 0 {
+		return nil, consts.ReqParamError.WithDetail("items is empty")
+	}
+	if len(req.Items) > maxItems {
+		return nil, consts.ReqParamError.WithDetail("too many items: %v, max: %v", len(req.Items), maxItems)
+	}
+
+	// 去重，相同的请求共享同一个解析结果
+	keyToIdx := map[string]int{}
+	uniqueItems := []*wcd.BaseParseReq{}
+	itemToUnique := make([]int, len(req.Items))
+	for i, item := range req.Items {
+		if item == nil || item.URL == "" {
+			itemToUnique[i] = -1
+			continue
+		}
+		key := batchItemKey(item)
+		idx, ok := keyToIdx[key]
+		if !ok {
+			idx = len(uniqueItems)
+			keyToIdx[key] = idx
+			uniqueItems = append(uniqueItems, item)
+		}
+		itemToUnique[i] = idx
+	}
+	hlog.CtxInfof(ctx, "BaseParseBatch begin, num items: %v, num unique: %v", len(req.Items), len(uniqueItems))
+
+	results := make([]*wcd.WcdParseResp, len(uniqueItems))
+	funcs := make([]utillib.AsyncFunc, 0, len(uniqueItems))
+	for i, item := range uniqueItems {
+		funcs = append(funcs, func() error {
+			var bizErr *consts.BizCode
+			results[i], bizErr = b.safeBaseParse(ctx, *item)
+			if bizErr != nil {
+				return fmt.Errorf("parse failed, url: %v, code: %v, msg: %v", item.URL, bizErr.Code, bizErr.Msg)
+			}
+			return nil
+		})
+	}
+	errs := utillib.ParallelExec(ctx, funcs, workerNum)
+	hlog.CtxInfof(ctx, "BaseParseBatch end, num unique: %v, num failed: %v", len(uniqueItems), len(errs))
+
+	resp := &wcd.BaseParseBatchResp{
+		Code:  consts.ResSuccess.Code,
+		Msg:   consts.ResSuccess.Msg,
+		Items: make([]*wcd.WcdParseResp, 0, len(req.Items)),
+	}
+	for i, idx := range itemToUnique {
+		if idx < 0 {
+			item := wcd.BaseParseReq{}
+			if req.Items[i] != nil {
+				item = *req.Items[i]
+			}
+			resp.Items = append(resp.Items, b.FailedResp(ctx, item, nil, consts.ReqParamError.WithDetail("url is empty")))
+			continue
+		}
+		resp.Items = append(resp.Items, results[idx])
+	}
+	return resp, nil
+}
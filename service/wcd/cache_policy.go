@@ -0,0 +1,41 @@
+package wcd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// CachePolicy 网页缓存和解析结果缓存是否读取、写入
+type CachePolicy struct {
+	Read  bool
+	Write bool
+}
+
+// NewCachePolicy 按读写方式创建，未知的方式按read_write处理
+func NewCachePolicy(ctx context.Context, mode string) CachePolicy {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case consts.CacheMode_ReadOnly:
+		return CachePolicy{Read: true}
+	case consts.CacheMode_Bypass:
+		return CachePolicy{}
+	case consts.CacheMode_Refresh:
+		return CachePolicy{Write: true}
+	case consts.CacheMode_ReadWrite, "":
+	default:
+		hlog.CtxWarnf(ctx, "unknown cache mode: %v, use %v", mode, consts.CacheMode_ReadWrite)
+	}
+	return CachePolicy{Read: true, Write: true}
+}
+
+// cachePolicyOf 全局配置的读写方式，skipRead为true时不读取缓存，仍按配置写入新的结果
+func cachePolicyOf(ctx context.Context, skipRead bool) CachePolicy {
+	policy := NewCachePolicy(ctx, conf.GetConfig().Parse.Cache.Mode)
+	if skipRead {
+		policy.Read = false
+	}
+	return policy
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/DeepLangAI/wcd/consts"
 	"github.com/DeepLangAI/wcd/tools"
 	wcdDoc "github.com/DeepLangAI/wcd/tools/doc"
+	"github.com/DeepLangAI/wcd/tools/render"
 	"github.com/DeepLangAI/wcd/utils"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -25,6 +26,55 @@ func NewDistillService(ctx context.Context, htmlStr string, htmlUrl string, rule
 	}
 }
 
+// NewDistillServiceFromReq 单独调用distill时，从请求中的html加载文档，并校验句子引用的position_id都存在
+func NewDistillServiceFromReq(ctx context.Context, req wcd.DistillReq) (*DistillService, *consts.BizCode) {
+	if req.GetHTML() == "" {
+		return nil, consts.ReqParamError.WithDetail("html is empty")
+	}
+	if bizErr := checkOutputFormats(req.GetOutputFormats()); bizErr != nil {
+		return nil, bizErr
+	}
+	doc := wcdDoc.LoadDocumentFromSegmentResult(ctx, req.GetHTML(), req.GetURL(), req.GetRuleStageGroup())
+	if doc == nil {
+		hlog.CtxErrorf(ctx, "load document failed, url: %v", req.GetURL())
+		return nil, consts.ReqParamError.WithDetail("load html failed")
+	}
+	if missing := findMissingPositionIds(doc, req.GetSentences()); len(missing) > 0 {
+		hlog.CtxWarnf(ctx, "sentence position ids not found in html, url: %v, ids: %v", req.GetURL(), missing)
+		return nil, consts.SentencePositionIdNotFound.WithDetail("%v", missing)
+	}
+	return &DistillService{
+		doc: doc,
+	}, nil
+}
+
+func findMissingPositionIds(doc *wcdDoc.Document, sentences []*wcd.TextParseLabelSentence) []int32 {
+	missing := []int32{}
+	visited := map[int32]int{}
+	for _, sentence := range sentences {
+		for _, atom := range sentence.Atoms {
+			// position_id为0的是虚拟节点（如标题），html中不存在
+			if atom.PositionID == 0 || visited[atom.PositionID] > 0 {
+				continue
+			}
+			visited[atom.PositionID] += 1
+			if !doc.HasPositionId(int64(atom.PositionID)) {
+				missing = append(missing, atom.PositionID)
+			}
+		}
+	}
+	return missing
+}
+
+func checkOutputFormats(formats []string) *consts.BizCode {
+	for _, format := range formats {
+		if !utils.Contains(consts.OUTPUT_FORMATS, format) {
+			return consts.ReqParamError.WithDetail("unknown output format: %v", format)
+		}
+	}
+	return nil
+}
+
 func (d *DistillService) CheckWorthless(ctx context.Context, req wcd.DistillReq, doc *wcdDoc.Document) int {
 	if req.ArticleMeta == nil {
 		req.ArticleMeta = &wcd.ArticleMeta{}
@@ -51,6 +101,10 @@ func (d *DistillService) Distill(ctx context.Context, req wcd.DistillReq) (*wcd.
 	cleaner.PostPurify()
 	utils.CoreLog(ctx, utils.CoreNamePostDistill, utils.NodeNamePostDistillDistill)
 
+	// 在生成阅读器网页之前去掉装饰性图片
+	imageInfos := tools.NewImageFilter(ctx, doc).Filter()
+	utils.CoreLog(ctx, utils.CoreNamePostDistill, utils.NodeNamePostDistillFilterImg)
+
 	doc.InsertMeta(req.ArticleMeta)
 	readerHtml, err := doc.ToString()
 	if err != nil {
@@ -77,9 +131,19 @@ func (d *DistillService) Distill(ctx context.Context, req wcd.DistillReq) (*wcd.
 		HTML:        readerHtml,
 		Text:        text,
 		Images:      images,
+		ImageInfos:  imageInfos,
 		Worthless:   worthType != consts.WorthType_Valueable,
 		WorthType:   int32(worthType),
 	}
+	if utils.Contains(req.GetOutputFormats(), consts.OutputFormat_Markdown) {
+		markdown := render.NewMarkdownRenderer(ctx, doc, req.ArticleMeta).Render()
+		result.Markdown = &markdown
+		utils.CoreLog(ctx, utils.CoreNamePostDistill, utils.NodeNamePostDistillRenderMarkdown)
+	}
+	if utils.Contains(req.GetOutputFormats(), consts.OutputFormat_Blocks) {
+		result.Blocks = render.NewBlockRenderer(ctx, doc, req.ArticleMeta, req.Sentences).Render()
+		utils.CoreLog(ctx, utils.CoreNamePostDistill, utils.NodeNamePostDistillRenderBlocks)
+	}
 	req.GetArticleMeta()
 	utils.CoreLog(ctx, utils.CoreNamePostDistill, utils.NodeDone)
 	return result, nil
@@ -0,0 +1,241 @@
+package wcd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/rule_index"
+	"github.com/DeepLangAI/wcd/tools/labeler"
+	"github.com/DeepLangAI/wcd/utils"
+)
+
+// 回归测试：testdata/golden下每个目录是一个用例，包含
+//   - case.json：url、标注器、规则组和用例用到的站点规则
+//   - page.html：保存的网页
+//   - expected.json：期望的解析结果
+//
+// 修改规则或启发式逻辑后，确认结果变化符合预期，再用以下命令重新生成期望结果：
+//
+//	go test ./service/wcd -run TestGolden -update
+var updateGolden = flag.Bool("update", false, "用当前的解析结果重新生成golden文件")
+
+const (
+	goldenDir          = "testdata/golden"
+	goldenCaseFile     = "case.json"
+	goldenPageFile     = "page.html"
+	goldenExpectedFile = "expected.json"
+
+	// 差异前后保留的行数
+	goldenDiffContext = 2
+)
+
+type goldenCase struct {
+	URL            string                     `json:"url"`
+	Labeler        string                     `json:"labeler"` // 为空时使用mock
+	RuleStageGroup wcd.RuleStageGroupEnum     `json:"rule_stage_group"`
+	Rules          []*wcd_manage.SiteRuleData `json:"rules"`
+}
+
+type goldenResult struct {
+	Code      int32    `json:"code"`
+	Title     string   `json:"title"`
+	Author    string   `json:"author"`
+	PubTime   string   `json:"pub_time"`
+	Worthless bool     `json:"worthless"`
+	Images    []string `json:"images"`
+	Text      string   `json:"text"`
+}
+
+func TestGolden(t *testing.T) {
+	entries, err := os.ReadDir(goldenDir)
+	if err != nil {
+		t.Fatalf("read golden dir error: %v", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		// 规则索引是全局的，用例之间串行执行
+		t.Run(entry.Name(), func(t *testing.T) {
+			runGoldenCase(t, filepath.Join(goldenDir, entry.Name()))
+		})
+	}
+}
+
+func runGoldenCase(t *testing.T, dir string) {
+	c := goldenCase{}
+	if err := readGoldenJson(filepath.Join(dir, goldenCaseFile), &c); err != nil {
+		t.Fatalf("read case error: %v", err)
+	}
+	html, err := os.ReadFile(filepath.Join(dir, goldenPageFile))
+	if err != nil {
+		t.Fatalf("read page error: %v", err)
+	}
+	if c.Labeler == "" {
+		c.Labeler = labeler.TypeMock
+	}
+
+	ctx := context.Background()
+	rule_index.SiteRuleIndex.SetRules(ctx, utils.Map(c.Rules, func(data *wcd_manage.SiteRuleData) *mongo.SiteRuleModel {
+		model := (&mongo.SiteRuleModel{}).FromThrift(data)
+		model.Status = consts.StatusValid
+		return model
+	}))
+	defer rule_index.SiteRuleIndex.SetRules(ctx, nil)
+
+	s := WcdParseService{}
+	resp, bizErr := s.WcdParse(ctx, wcd.WcdParseReq{
+		URL:            c.URL,
+		HTML:           string(html),
+		RuleStageGroup: &c.RuleStageGroup,
+		Labeler:        &c.Labeler,
+	})
+	actual := goldenResult{
+		Code:      consts.ResSuccess.Code,
+		Title:     resp.Title,
+		Author:    resp.Author,
+		PubTime:   resp.PubTime,
+		Worthless: resp.Worthless,
+		Images:    resp.Images,
+		Text:      resp.Text,
+	}
+	if bizErr != nil {
+		actual.Code = bizErr.Code
+	}
+	if actual.Images == nil {
+		actual.Images = []string{}
+	}
+
+	expectedPath := filepath.Join(dir, goldenExpectedFile)
+	if *updateGolden {
+		if err := writeGoldenJson(expectedPath, actual); err != nil {
+			t.Fatalf("write golden error: %v", err)
+		}
+		return
+	}
+	expected := goldenResult{}
+	if err := readGoldenJson(expectedPath, &expected); err != nil {
+		t.Fatalf("read golden error: %v, run with -update to generate it", err)
+	}
+	if diff := diffGoldenResult(expected, actual); diff != "" {
+		t.Errorf("result differs from %v (-expected +actual):\n%v", expectedPath, diff)
+	}
+}
+
+func readGoldenJson(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func writeGoldenJson(path string, v any) error {
+	buf := bytes.Buffer{}
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// diffGoldenResult 逐字段比较，正文和图片按行输出差异
+func diffGoldenResult(expected, actual goldenResult) string {
+	sb := strings.Builder{}
+	scalar := func(name string, expected, actual any) {
+		if expected != actual {
+			sb.WriteString(fmt.Sprintf("%v:\n- %v\n+ %v\n", name, expected, actual))
+		}
+	}
+	scalar("code", expected.Code, actual.Code)
+	scalar("title", expected.Title, actual.Title)
+	scalar("author", expected.Author, actual.Author)
+	scalar("pub_time", expected.PubTime, actual.PubTime)
+	scalar("worthless", expected.Worthless, actual.Worthless)
+	if diff := diffLines(expected.Images, actual.Images); diff != "" {
+		sb.WriteString("images:\n" + diff)
+	}
+	if diff := diffLines(strings.Split(expected.Text, "\n"), strings.Split(actual.Text, "\n")); diff != "" {
+		sb.WriteString("text:\n" + diff)
+	}
+	return sb.String()
+}
+
+// diffLines 基于最长公共子序列的逐行差异，只保留变化行附近的上下文
+func diffLines(a, b []string) string {
+	// lcs[i][j]为a[i:]和b[j:]的最长公共子序列长度
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	type diffLine struct {
+		op   byte
+		text string
+	}
+	lines := []diffLine{}
+	changed := false
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{'-', a[i]})
+			changed = true
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			changed = true
+			j++
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	lastPrinted := -1
+	for k, line := range lines {
+		near := false
+		for d := max(0, k-goldenDiffContext); d <= min(len(lines)-1, k+goldenDiffContext); d++ {
+			if lines[d].op != ' ' {
+				near = true
+				break
+			}
+		}
+		if !near {
+			continue
+		}
+		if lastPrinted >= 0 && k > lastPrinted+1 {
+			sb.WriteString("  ...\n")
+		}
+		sb.WriteString(fmt.Sprintf("%c %v\n", line.op, line.text))
+		lastPrinted = k
+	}
+	return sb.String()
+}
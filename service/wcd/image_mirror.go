@@ -0,0 +1,220 @@
+package wcd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/object"
+	"github.com/DeepLangAI/wcd/dal/store"
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/utils"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	defaultMirrorWorkerNum = 8
+	defaultMirrorTimeout   = 20 * time.Second
+	defaultMirrorMaxBytes  = 20 << 20
+)
+
+// 可以镜像的图片类型及文件扩展名，镜像按扩展名返回Content-Type。
+// svg中可以包含脚本，和管理页面同源访问时有XSS风险，不镜像，使用原地址
+var imageExtensions = map[string]string{
+	"image/jpeg":   ".jpg",
+	"image/png":    ".png",
+	"image/gif":    ".gif",
+	"image/webp":   ".webp",
+	"image/avif":   ".avif",
+	"image/bmp":    ".bmp",
+	"image/x-icon": ".ico",
+}
+
+// mirrorImagesEnabled 请求指定时以请求为准，否则使用全局配置
+func mirrorImagesEnabled(reqMirror *bool) bool {
+	if reqMirror != nil {
+		return *reqMirror
+	}
+	return conf.GetConfig().Parse.ImageMirror.Enabled
+}
+
+// ImageMirrorService 下载正文图片保存到对象存储，映射记录在crawl_image中
+type ImageMirrorService struct {
+	objects object.Store
+	mapping store.ImageMapping
+}
+
+func NewImageMirrorService() *ImageMirrorService {
+	return &ImageMirrorService{
+		objects: object.Default,
+		mapping: store.CrawlImage,
+	}
+}
+
+// Mirror 返回原地址到镜像记录。已镜像过的图片不再下载，下载失败的图片不包含在内
+func (s *ImageMirrorService) Mirror(ctx context.Context, pageUrl string, imgUrls []string) map[string]*mongo.CrawlImageModel {
+	imgUrls = utils.Set(imgUrls)
+	mirrored := map[string]*mongo.CrawlImageModel{}
+	if len(imgUrls) == 0 {
+		return mirrored
+	}
+	existing, err := s.mapping.FindBySrcImgUrls(ctx, imgUrls)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "find crawled images error: %v", err)
+	}
+	for _, model := range existing {
+		mirrored[model.SrcImgUrl] = model
+	}
+	missing := []string{}
+	for _, imgUrl := range imgUrls {
+		if _, ok := mirrored[imgUrl]; !ok {
+			missing = append(missing, imgUrl)
+		}
+	}
+	if len(missing) == 0 {
+		return mirrored
+	}
+
+	cfg := conf.GetConfig().Parse.ImageMirror
+	workerNum := cmp.Or(max(cfg.WorkerNum, 0), defaultMirrorWorkerNum)
+	timeout := cmp.Or(time.Duration(max(cfg.TimeoutSeconds, 0))*time.Second, defaultMirrorTimeout)
+	maxBytes := cmp.Or(max(cfg.MaxBytes, 0), defaultMirrorMaxBytes)
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, workerNum)
+		models = []mongo.CrawlImageModel{}
+	)
+	for _, imgUrl := range missing {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			model, err := s.mirrorOne(ctx, pageUrl, imgUrl, maxBytes, timeout)
+			if err != nil {
+				hlog.CtxWarnf(ctx, "mirror image failed, url: %v, err: %v", imgUrl, err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			mirrored[imgUrl] = model
+			models = append(models, *model)
+		}()
+	}
+	wg.Wait()
+
+	if len(models) > 0 {
+		if err := s.mapping.UpsertMany(ctx, models); err != nil {
+			hlog.CtxErrorf(ctx, "save crawled images error: %v", err)
+		}
+	}
+	hlog.CtxInfof(ctx, "mirror images, total: %v, existing: %v, downloaded: %v", len(imgUrls), len(existing), len(models))
+	return mirrored
+}
+
+// mirrorOne 对象key由原地址计算，同一图片重复下载时覆盖
+func (s *ImageMirrorService) mirrorOne(ctx context.Context, pageUrl string, imgUrl string, maxBytes int64, timeout time.Duration) (*mongo.CrawlImageModel, error) {
+	data, contentType, err := http.FetchImage(ctx, imgUrl, pageUrl, maxBytes, timeout)
+	if err != nil {
+		return nil, err
+	}
+	model := &mongo.CrawlImageModel{
+		SrcImgUrl: imgUrl,
+		MimeType:  contentType,
+		Status:    consts.StatusValid,
+	}
+	if width, height, mimeType, ok := utils.ImageConfig(data); ok {
+		model.Width, model.Height, model.MimeType = int32(width), int32(height), mimeType
+	}
+	extension, ok := imageExtensions[model.MimeType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported image type: %v", model.MimeType)
+	}
+	hash := utils.StrToMd5(imgUrl)
+	key := "images/" + hash[:2] + "/" + hash + extension
+	model.OssImgUrl, err = s.objects.Put(ctx, key, model.MimeType, data)
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// rewriteImageUrls 把解析结果中的图片地址替换为镜像地址，并用图片内容补全图片信息
+func rewriteImageUrls(resp *wcd.WcdParseResp, mirroredModels map[string]*mongo.CrawlImageModel) {
+	if len(mirroredModels) == 0 {
+		return
+	}
+	mirrored := map[string]string{}
+	for imgUrl, model := range mirroredModels {
+		mirrored[imgUrl] = model.OssImgUrl
+	}
+	resp.MirroredImages = mirrored
+	for _, info := range resp.ImageInfos {
+		model, ok := mirroredModels[info.URL]
+		if !ok {
+			continue
+		}
+		info.URL = model.OssImgUrl
+		if !info.IsSetWidth() && model.Width > 0 {
+			info.Width = thrift.Int32Ptr(model.Width)
+		}
+		if !info.IsSetHeight() && model.Height > 0 {
+			info.Height = thrift.Int32Ptr(model.Height)
+		}
+		if model.MimeType != "" {
+			info.MimeType = &model.MimeType
+		}
+	}
+	resp.Images = utils.Map(resp.Images, func(imgUrl string) string {
+		return cmp.Or(mirrored[imgUrl], imgUrl)
+	})
+	for _, block := range resp.Blocks {
+		if block.URL != nil {
+			if ossUrl, ok := mirrored[*block.URL]; ok {
+				block.URL = &ossUrl
+			}
+		}
+	}
+
+	// html中的地址可能被转义，较长的地址先替换，避免只替换了另一个地址的前缀
+	textPairs := [][2]string{}
+	htmlPairs := [][2]string{}
+	for imgUrl, ossUrl := range mirrored {
+		textPairs = append(textPairs, [2]string{imgUrl, ossUrl})
+		htmlPairs = append(htmlPairs, [2]string{imgUrl, ossUrl})
+		if escaped := strings.ReplaceAll(imgUrl, "&", "&amp;"); escaped != imgUrl {
+			htmlPairs = append(htmlPairs, [2]string{escaped, strings.ReplaceAll(ossUrl, "&", "&amp;")})
+		}
+	}
+	resp.ReadableHTML = newLongestFirstReplacer(htmlPairs).Replace(resp.ReadableHTML)
+	if resp.Markdown != nil {
+		markdown := newLongestFirstReplacer(textPairs).Replace(*resp.Markdown)
+		resp.Markdown = &markdown
+	}
+}
+
+// newLongestFirstReplacer 同一位置有多个可替换的地址时，替换最长的
+func newLongestFirstReplacer(pairs [][2]string) *strings.Replacer {
+	slices.SortFunc(pairs, func(a, b [2]string) int {
+		return cmp.Compare(len(b[0]), len(a[0]))
+	})
+	oldNew := make([]string, 0, len(pairs)*2)
+	for _, pair := range pairs {
+		oldNew = append(oldNew, pair[0], pair[1])
+	}
+	return strings.NewReplacer(oldNew...)
+}
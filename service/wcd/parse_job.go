@@ -0,0 +1,350 @@
+package wcd
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	constslib "github.com/DeepLangAI/go_lib/consts"
+	"github.com/DeepLangAI/go_lib/utillib"
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/hertz-contrib/logger/zap"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultJobWorkerNum       = 4
+	defaultJobPollSeconds     = 5
+	defaultJobMaxAttempts     = 3
+	defaultJobCallbackRetries = 5
+	defaultJobCallbackTimeout = 10 * time.Second
+	// 默认租约覆盖一次抓取加一次标注的超时
+	defaultJobLease = consts.CrawlHtmlTimeout + consts.TextParseReadTimeOut + time.Minute
+
+	maxCallbackBackoff = 5 * time.Minute
+	// 领取回调后的租约在单次回调超时之外留出的余量
+	callbackLeaseMargin = time.Minute
+)
+
+type ParseJobService struct {
+}
+
+// checkParseJobAvailable 异步任务保存在mongo中，未连接mongo时不可用
+func checkParseJobAvailable() *consts.BizCode {
+	if !mongo.Ready() {
+		return consts.SystemErr.WithDetail("parse job requires mongo")
+	}
+	return nil
+}
+
+func (p *ParseJobService) Submit(ctx context.Context, req wcd.ParseJobSubmitReq) (*wcd.ParseJobSubmitResp, *consts.BizCode) {
+	if bizErr := checkParseJobAvailable(); bizErr != nil {
+		return nil, bizErr
+	}
+	if req.Req == nil || req.Req.URL == "" {
+		return nil, consts.ReqParamError.WithDetail("url is empty")
+	}
+	if callbackUrl := req.GetCallbackURL(); callbackUrl != "" {
+		u, err := url.Parse(callbackUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, consts.ReqParamError.WithDetail("invalid callback_url: %v", callbackUrl)
+		}
+	}
+	reqStr, err := sonic.MarshalString(req.Req)
+	if err != nil {
+		return nil, consts.ReqParamError.WithDetail("%v", err)
+	}
+
+	now := time.Now()
+	model := mongo.ParseJobModel{
+		JobId:          primitive.NewObjectID().Hex(),
+		Url:            req.Req.URL,
+		Req:            reqStr,
+		CallbackUrl:    req.GetCallbackURL(),
+		JobStatus:      wcd.ParseJobStatus_Pending,
+		CallbackStatus: wcd.ParseJobCallbackStatus_None,
+		CreateTime:     now,
+		UpdateTime:     now,
+		Status:         consts.StatusValid,
+	}
+	if model.CallbackUrl != "" {
+		model.CallbackStatus = wcd.ParseJobCallbackStatus_Pending
+	}
+	if err := mongo.ParseJobModelDal.SaveOne(ctx, model); err != nil {
+		hlog.CtxErrorf(ctx, "save parse job error: %v", err)
+		return nil, &consts.WriteDbError
+	}
+	hlog.CtxInfof(ctx, "parse job submitted, job_id: %v, url: %v", model.JobId, model.Url)
+	notifyParseJobWorkers()
+
+	return &wcd.ParseJobSubmitResp{
+		Code:  consts.ResSuccess.Code,
+		Msg:   consts.ResSuccess.Msg,
+		JobID: model.JobId,
+	}, nil
+}
+
+func (p *ParseJobService) Get(ctx context.Context, req wcd.ParseJobReq) (*wcd.ParseJobResp, *consts.BizCode) {
+	if bizErr := checkParseJobAvailable(); bizErr != nil {
+		return nil, bizErr
+	}
+	if req.JobID == "" {
+		return nil, consts.ReqParamError.WithDetail("job_id is empty")
+	}
+	model, err := mongo.ParseJobModelDal.FindByJobId(ctx, req.JobID)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return nil, &consts.ParseJobNotFound
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "find parse job error: %v", err)
+		return nil, &consts.QueryDataError
+	}
+	return parseJobResp(ctx, model), nil
+}
+
+func parseJobResp(ctx context.Context, model *mongo.ParseJobModel) *wcd.ParseJobResp {
+	resp := model.ToThrift()
+	if model.Result != "" {
+		result := &wcd.WcdParseResp{}
+		if err := sonic.UnmarshalString(model.Result, result); err != nil {
+			hlog.CtxErrorf(ctx, "unmarshal parse job result error, job_id: %v, err: %v", model.JobId, err)
+		} else {
+			resp.Result = result
+		}
+	}
+	return resp
+}
+
+type parseJobWorkerConf struct {
+	workerNum       int
+	poll            time.Duration
+	lease           time.Duration
+	maxAttempts     int
+	callbackRetries int
+	callbackTimeout time.Duration
+}
+
+func newParseJobWorkerConf() parseJobWorkerConf {
+	c := conf.GetConfig().Parse.Job
+	w := parseJobWorkerConf{
+		workerNum:       c.WorkerNum,
+		poll:            time.Duration(c.PollSeconds) * time.Second,
+		lease:           time.Duration(c.LeaseSeconds) * time.Second,
+		maxAttempts:     c.MaxAttempts,
+		callbackRetries: c.CallbackRetries,
+		callbackTimeout: time.Duration(c.CallbackTimeoutSeconds) * time.Second,
+	}
+	if w.workerNum == 0 {
+		w.workerNum = defaultJobWorkerNum
+	}
+	if w.poll <= 0 {
+		w.poll = defaultJobPollSeconds * time.Second
+	}
+	if w.lease <= 0 {
+		w.lease = defaultJobLease
+	}
+	if w.maxAttempts <= 0 {
+		w.maxAttempts = defaultJobMaxAttempts
+	}
+	if w.callbackRetries <= 0 {
+		w.callbackRetries = defaultJobCallbackRetries
+	}
+	if w.callbackTimeout <= 0 {
+		w.callbackTimeout = defaultJobCallbackTimeout
+	}
+	return w
+}
+
+// parseJobNotify 有新任务提交时唤醒空闲的worker，不必等到下一次轮询
+var parseJobNotify = make(chan struct{}, defaultJobWorkerNum)
+
+func notifyParseJobWorkers() {
+	select {
+	case parseJobNotify <- struct{}{}:
+	default:
+	}
+}
+
+// parseJobCallbackNotify 有任务结束并待回调时唤醒空闲的回调worker
+var parseJobCallbackNotify = make(chan struct{}, defaultJobWorkerNum)
+
+func notifyParseJobCallbackWorkers() {
+	select {
+	case parseJobCallbackNotify <- struct{}{}:
+	default:
+	}
+}
+
+// StartParseJobWorkers 启动异步解析任务和回调的worker，任务状态保存在mongo中，多实例部署时共同消费。
+// 回调由单独的worker执行，重试等待期间不占用解析任务的worker，实例重启后未完成的回调会被重新领取
+func StartParseJobWorkers(ctx context.Context) {
+	w := newParseJobWorkerConf()
+	if w.workerNum < 0 {
+		hlog.CtxInfof(ctx, "parse job worker disabled")
+		return
+	}
+	if !mongo.Ready() {
+		hlog.CtxWarnf(ctx, "mongo is not ready, parse job worker disabled")
+		return
+	}
+	if err := mongo.ParseJobModelDal.CreateIndexes(ctx); err != nil {
+		hlog.CtxErrorf(ctx, "create parse job indexes error: %v", err)
+	}
+	for i := 0; i < w.workerNum; i++ {
+		go w.run(ctx)
+		go w.runCallbacks(ctx)
+	}
+	hlog.CtxInfof(ctx, "parse job workers started, num workers: %v", w.workerNum)
+}
+
+func (w parseJobWorkerConf) run(ctx context.Context) {
+	ticker := time.NewTicker(w.poll)
+	defer ticker.Stop()
+	for {
+		// 有任务时连续执行，直到任务表为空
+		for w.runOnce(ctx) {
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-parseJobNotify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce 领取并执行一个任务，没有可执行的任务时返回false
+func (w parseJobWorkerConf) runOnce(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	model, err := mongo.ParseJobModelDal.ClaimOne(ctx, w.lease)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "claim parse job error: %v", err)
+		return false
+	}
+	if model == nil {
+		return false
+	}
+
+	// 任务的日志和返回结果中的wcd_request_id都使用job_id，便于追踪
+	jobCtx := utillib.NewCtxWithTraceId(ctx)
+	jobCtx = context.WithValue(jobCtx, zap.ExtraKey(constslib.OperationIdKey), model.JobId)
+	w.execute(jobCtx, model)
+	return true
+}
+
+func (w parseJobWorkerConf) execute(ctx context.Context, model *mongo.ParseJobModel) {
+	timeBegin := time.Now()
+	req := wcd.BaseParseReq{}
+	s := BaseParseService{}
+
+	var resp *wcd.WcdParseResp
+	var bizErr *consts.BizCode
+	if err := sonic.UnmarshalString(model.Req, &req); err != nil {
+		hlog.CtxErrorf(ctx, "unmarshal parse job req error: %v", err)
+		bizErr = consts.ReqParamError.WithDetail("%v", err)
+		resp = s.FailedResp(ctx, req, nil, bizErr)
+	} else if model.Attempts > w.maxAttempts {
+		// 多次执行都没有结束，通常是解析过程中实例退出，不再重试
+		bizErr = consts.SystemErr.WithDetail("job exceeded max attempts: %v", w.maxAttempts)
+		resp = s.FailedResp(ctx, req, nil, bizErr)
+	} else {
+		resp, bizErr = s.safeBaseParse(ctx, req)
+	}
+
+	// 抓取失败等错误码与成功相同，按bizErr判断任务是否失败
+	jobStatus := wcd.ParseJobStatus_Succeeded
+	if bizErr != nil {
+		jobStatus = wcd.ParseJobStatus_Failed
+	}
+	result, _ := sonic.MarshalString(resp)
+	finished, err := mongo.ParseJobModelDal.Finish(ctx, model, jobStatus, result)
+	if err != nil {
+		// 结果没有保存成功，租约过期后任务会被重新执行
+		hlog.CtxErrorf(ctx, "save parse job result error: %v", err)
+		return
+	}
+	if !finished {
+		// 租约过期后任务已被重新领取，结果和回调以重新执行的为准
+		hlog.CtxWarnf(ctx, "parse job was claimed again, result discarded, job_id: %v, attempts: %v", model.JobId, model.Attempts)
+		return
+	}
+	hlog.CtxInfof(ctx, "parse job finished, job_id: %v, status: %v, attempts: %v, cost: %.2fs",
+		model.JobId, jobStatus, model.Attempts, time.Since(timeBegin).Seconds())
+	if model.CallbackUrl != "" {
+		notifyParseJobCallbackWorkers()
+	}
+}
+
+func (w parseJobWorkerConf) runCallbacks(ctx context.Context) {
+	ticker := time.NewTicker(w.poll)
+	defer ticker.Stop()
+	for {
+		for w.callbackOnce(ctx) {
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-parseJobCallbackNotify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// callbackOnce 领取并推送一个到期的回调，失败时按指数退避记录下次回调时间；没有到期的回调时返回false
+func (w parseJobWorkerConf) callbackOnce(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	model, err := mongo.ParseJobModelDal.ClaimCallback(ctx, w.callbackTimeout+callbackLeaseMargin)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "claim parse job callback error: %v", err)
+		return false
+	}
+	if model == nil {
+		return false
+	}
+
+	jobCtx := utillib.NewCtxWithTraceId(ctx)
+	jobCtx = context.WithValue(jobCtx, zap.ExtraKey(constslib.OperationIdKey), model.JobId)
+	w.callback(jobCtx, model)
+	return true
+}
+
+// callback 推送任务结果，model.CallbackAttempts为包含本次在内的回调次数
+func (w parseJobWorkerConf) callback(ctx context.Context, model *mongo.ParseJobModel) {
+	attempt := model.CallbackAttempts
+	body := parseJobResp(ctx, model)
+	body.CallbackStatus = wcd.ParseJobCallbackStatus_Pending
+
+	err := http.PostCallback(ctx, model.CallbackUrl, body, w.callbackTimeout)
+	if err == nil {
+		hlog.CtxInfof(ctx, "parse job callback success, job_id: %v, attempts: %v", model.JobId, attempt)
+		w.saveCallback(ctx, model, wcd.ParseJobCallbackStatus_Succeeded, "", time.Time{})
+		return
+	}
+	hlog.CtxWarnf(ctx, "parse job callback failed, job_id: %v, url: %v, attempts: %v, err: %v",
+		model.JobId, model.CallbackUrl, attempt, err)
+
+	if attempt > w.callbackRetries {
+		w.saveCallback(ctx, model, wcd.ParseJobCallbackStatus_Failed, err.Error(), time.Time{})
+		return
+	}
+	backoff := min(time.Second<<min(attempt-1, 16), maxCallbackBackoff)
+	w.saveCallback(ctx, model, wcd.ParseJobCallbackStatus_Pending, err.Error(), time.Now().Add(backoff))
+}
+
+func (w parseJobWorkerConf) saveCallback(ctx context.Context, model *mongo.ParseJobModel, status wcd.ParseJobCallbackStatus, errMsg string, nextCallbackTime time.Time) {
+	if err := mongo.ParseJobModelDal.UpdateCallback(ctx, model.JobId, model.CallbackAttempts, status, errMsg, nextCallbackTime); err != nil {
+		hlog.CtxErrorf(ctx, "save parse job callback status error, job_id: %v, err: %v", model.JobId, err)
+	}
+}
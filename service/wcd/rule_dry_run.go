@@ -0,0 +1,216 @@
+package wcd
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DeepLangAI/go_lib/utillib"
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/rule_index"
+	"github.com/DeepLangAI/wcd/dal/store"
+	"github.com/DeepLangAI/wcd/utils"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	defaultDryRunCachedLimit = 5
+	htmlSourceRequest        = "request" // 请求传入的网页
+)
+
+// RuleDryRunService 发布前用测试规则和正式规则分别解析样例网页，比较两次的结果
+type RuleDryRunService struct {
+	rule *mongo.SiteRuleModel // 测试规则
+}
+
+type dryRunPage struct {
+	url    string
+	html   string
+	source string
+}
+
+func (s *RuleDryRunService) DryRun(ctx context.Context, req wcd_manage.SiteRuleDryRunReq) ([]*wcd_manage.SiteRuleDryRunItem, error) {
+	if req.Host == "" {
+		return nil, errors.New("host is empty")
+	}
+	rule, err := store.SiteRules.FindOne(ctx, req.Host, consts.RuleStageTesting)
+	if err != nil || rule == nil {
+		return nil, errors.New("testing rule not found")
+	}
+	s.rule = rule
+
+	batchConf := conf.GetConfig().Parse.Batch
+	maxItems := cmp.Or(max(batchConf.MaxItems, 0), defaultBatchMaxItems)
+	workerNum := cmp.Or(max(batchConf.WorkerNum, 0), defaultBatchWorkerNum)
+	pages := []*dryRunPage{}
+	for _, page := range req.Pages {
+		if page == nil || page.URL == "" {
+			continue
+		}
+		pages = append(pages, &dryRunPage{url: page.URL, html: page.GetHTML(), source: htmlSourceRequest})
+	}
+	if len(req.Pages) == 0 {
+		pages, err = s.cachedPages(ctx, min(cmp.Or(max(int(req.GetCachedLimit()), 0), defaultDryRunCachedLimit), maxItems))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(pages) == 0 {
+		return nil, errors.New("no page to dry run")
+	}
+	if len(pages) > maxItems {
+		return nil, fmt.Errorf("too many pages: %v, max: %v", len(pages), maxItems)
+	}
+	hlog.CtxInfof(ctx, "RuleDryRun begin, host: %v, num pages: %v", req.Host, len(pages))
+
+	items := make([]*wcd_manage.SiteRuleDryRunItem, len(pages))
+	funcs := make([]utillib.AsyncFunc, 0, len(pages))
+	for i, page := range pages {
+		funcs = append(funcs, func() error {
+			items[i] = s.safeDryRunPage(ctx, page, req.Labeler)
+			return nil
+		})
+	}
+	utillib.ParallelExec(ctx, funcs, workerNum)
+	return items, nil
+}
+
+// cachedPages 该host最近缓存的网页
+func (s *RuleDryRunService) cachedPages(ctx context.Context, limit int) ([]*dryRunPage, error) {
+	matcher := mongo.NewRuleMatcher(s.rule)
+	if matcher.Err() != nil {
+		return nil, matcher.Err()
+	}
+	// 先按host粗筛，再按规则的匹配方式过滤
+	models, err := store.CrawlHtml.ListByUrlRegex(ctx, matcher.UrlRegex(), limit*2)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "list cached html error: %v", err)
+		return nil, err
+	}
+	pages := []*dryRunPage{}
+	for _, model := range models {
+		if len(pages) >= limit {
+			break
+		}
+		if !matcher.Match(model.Url) {
+			continue
+		}
+		pages = append(pages, &dryRunPage{url: model.Url, html: model.Html, source: CrawlerName_Cache})
+	}
+	return pages, nil
+}
+
+// safeDryRunPage 单个网页出错或panic只影响该网页的结果
+func (s *RuleDryRunService) safeDryRunPage(ctx context.Context, page *dryRunPage, labeler *string) (item *wcd_manage.SiteRuleDryRunItem) {
+	defer func() {
+		if r := recover(); r != nil {
+			buffer := make([]byte, 4096)
+			n := runtime.Stack(buffer, false)
+			hlog.CtxErrorf(ctx, "RuleDryRun page panic, url: %v, err: %v\nstack:\n%v", page.url, r, string(buffer[:n]))
+			item = &wcd_manage.SiteRuleDryRunItem{URL: page.url, Error: consts.SystemErr.Msg}
+		}
+	}()
+	return s.dryRunPage(ctx, page, labeler)
+}
+
+func (s *RuleDryRunService) dryRunPage(ctx context.Context, page *dryRunPage, labeler *string) *wcd_manage.SiteRuleDryRunItem {
+	item := &wcd_manage.SiteRuleDryRunItem{
+		URL:           page.url,
+		HTMLSource:    page.source,
+		ChangedFields: []string{},
+		TextDiff:      []string{},
+	}
+	if !s.rule.Match(page.url) {
+		item.Error = fmt.Sprintf("url does not match rule host: %v", s.rule.Host)
+		return item
+	}
+	// 只抓取一次，两次解析使用同一个网页。按测试规则的抓取策略抓取
+	if page.html == "" {
+		crawlResult, err := (&BaseParseService{}).crawlHtmlWithCache(ctx, page.url, wcd.RuleStageGroupEnum_TestingPrior, true)
+		if err != nil {
+			hlog.CtxErrorf(ctx, "RuleDryRun crawl error, url: %v, err: %v", page.url, err)
+			item.Error = fmt.Sprintf("%v: %v", consts.CrawlFailed.Msg, err)
+			return item
+		}
+		page.html = crawlResult.Html
+		item.HTMLSource = crawlResult.CrawlerName
+	}
+	htmlStr := utils.UnescapeHtml(page.html)
+
+	prod, prodText := s.parse(ctx, page.url, htmlStr, wcd.RuleStageGroupEnum_ProdOnly, labeler)
+	testing, testingText := s.parse(ctx, page.url, htmlStr, wcd.RuleStageGroupEnum_TestingPrior, labeler)
+	item.Prod, item.Testing = prod, testing
+	item.ChangedFields = changedDryRunFields(prod, testing)
+	item.TextDiff = utils.DiffLines(textLines(prodText), textLines(testingText))
+	return item
+}
+
+func textLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(text, "\n")
+}
+
+// parse 返回解析结果的摘要和正文
+func (s *RuleDryRunService) parse(ctx context.Context, pageUrl string, htmlStr string, group wcd.RuleStageGroupEnum, labeler *string) (*wcd_manage.SiteRuleDryRunResult, string) {
+	result := &wcd_manage.SiteRuleDryRunResult{}
+	if rule, err := rule_index.SiteRuleIndex.Match(ctx, pageUrl, group); err == nil && rule != nil {
+		result.RuleHost = rule.Host
+	}
+	service := WcdParseService{}
+	resp, bizErr := service.WcdParse(ctx, wcd.WcdParseReq{
+		HTML:           htmlStr,
+		URL:            pageUrl,
+		RuleStageGroup: &group,
+		Labeler:        labeler,
+		MirrorImages:   thrift.BoolPtr(false),
+	})
+	if bizErr != nil {
+		result.Error = bizErr.Msg
+	}
+	if resp == nil {
+		return result, ""
+	}
+	result.Title = resp.Title
+	result.Author = resp.Author
+	result.PubTime = resp.PubTime
+	result.TextLength = int32(utf8.RuneCountInString(resp.Text))
+	result.ImageCount = int32(len(resp.Images))
+	result.Worthless = resp.Worthless
+	result.WorthType = resp.WorthType
+	return result, resp.Text
+}
+
+func changedDryRunFields(prod, testing *wcd_manage.SiteRuleDryRunResult) []string {
+	fields := []string{}
+	for _, field := range []struct {
+		name    string
+		changed bool
+	}{
+		{"title", prod.Title != testing.Title},
+		{"author", prod.Author != testing.Author},
+		{"pub_time", prod.PubTime != testing.PubTime},
+		{"text_length", prod.TextLength != testing.TextLength},
+		{"image_count", prod.ImageCount != testing.ImageCount},
+		{"worthless", prod.Worthless != testing.Worthless},
+		{"worth_type", prod.WorthType != testing.WorthType},
+		{"rule_host", prod.RuleHost != testing.RuleHost},
+		{"error", prod.Error != testing.Error},
+	} {
+		if field.changed {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
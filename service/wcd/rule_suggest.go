@@ -0,0 +1,81 @@
+package wcd
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/biz/model/wcd_manage"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/tools"
+	"github.com/DeepLangAI/wcd/tools/doc"
+	"github.com/DeepLangAI/wcd/utils"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	suggestBodyLimit         = 3
+	defaultSuggestNoiseLimit = 10
+)
+
+// RuleSuggestService 根据样例网页推荐站点规则的正文和噪声xpath，用于新站点编写规则
+type RuleSuggestService struct{}
+
+func (s *RuleSuggestService) Suggest(ctx context.Context, req wcd_manage.SuggestSiteRuleReq) (*wcd_manage.SuggestSiteRuleResp, error) {
+	if req.URL == "" {
+		return nil, errors.New("url is empty")
+	}
+	resp := &wcd_manage.SuggestSiteRuleResp{
+		Bodies:     []*wcd_manage.SiteRuleXpathSuggestion{},
+		Noises:     []*wcd_manage.SiteRuleXpathSuggestion{},
+		HTMLSource: htmlSourceRequest,
+	}
+	htmlStr := req.GetHTML()
+	if htmlStr == "" {
+		crawlResult, err := (&BaseParseService{}).crawlHtmlWithCache(ctx, req.URL, wcd.RuleStageGroupEnum_TestingPrior, true)
+		if err != nil {
+			hlog.CtxErrorf(ctx, "RuleSuggest crawl error, url: %v, err: %v", req.URL, err)
+			return nil, fmt.Errorf("%v: %v", consts.CrawlFailed.Msg, err)
+		}
+		htmlStr = crawlResult.Html
+		resp.HTMLSource = crawlResult.CrawlerName
+	}
+	document, err := doc.NewDocument(ctx, utils.UnescapeHtml(htmlStr), req.URL, wcd.RuleStageGroupEnum_TestingPrior)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "RuleSuggest load page error, url: %v, err: %v", req.URL, err)
+		return nil, err
+	}
+
+	suggester := tools.NewRuleSuggester(ctx, document)
+	bodies := suggester.SuggestBodies(suggestBodyLimit)
+	if len(bodies) == 0 {
+		return nil, errors.New("no text found in page")
+	}
+	noises := suggester.SuggestNoises(bodies[0].Matches[0], cmp.Or(max(int(req.GetMaxNoises()), 0), defaultSuggestNoiseLimit))
+	hlog.CtxInfof(ctx, "RuleSuggest done, url: %v, body: %v, num noises: %v", req.URL, bodies[0].Xpath, len(noises))
+
+	for _, body := range bodies {
+		resp.Bodies = append(resp.Bodies, toXpathSuggestion(body))
+	}
+	for _, noise := range noises {
+		resp.Noises = append(resp.Noises, toXpathSuggestion(noise))
+	}
+	resp.Preview = suggester.Preview(bodies[0].Matches[0], noises)
+	return resp, nil
+}
+
+func toXpathSuggestion(suggestion *tools.XpathSuggestion) *wcd_manage.SiteRuleXpathSuggestion {
+	return &wcd_manage.SiteRuleXpathSuggestion{
+		Xpath:       suggestion.Xpath,
+		TextLength:  int32(suggestion.TextLength),
+		TextShare:   suggestion.TextShare,
+		LinkDensity: suggestion.LinkDensity,
+		MatchCount:  int32(len(suggestion.Matches)),
+		Stable:      suggestion.Stable,
+		Reason:      suggestion.Reason,
+		Preview:     suggestion.Preview,
+	}
+}
@@ -2,9 +2,13 @@ package wcd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/DeepLangAI/go_lib/utillib"
 	"runtime"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/DeepLangAI/wcd/biz/model/text_parse"
@@ -12,57 +16,107 @@ import (
 
 	"github.com/DeepLangAI/wcd/conf"
 	"github.com/DeepLangAI/wcd/consts"
-	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/rule_index"
+	"github.com/DeepLangAI/wcd/dal/store"
 	"github.com/DeepLangAI/wcd/model/http_model"
+	"github.com/DeepLangAI/wcd/tools/labeler"
 	"github.com/DeepLangAI/wcd/utils"
 
+	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/bytedance/sonic"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
 )
 
 type WcdParseService struct {
 }
 
-func (s *WcdParseService) textParseLabelize(ctx context.Context, labelModelReq *http_model.LabelModelReq) (*http_model.LabelModelResp, *http.ModelRawReqResp, error) {
-	if conf.GetConfig().Parse.Label.UseMock {
-		labelModelResp := &http_model.LabelModelResp{
-			Code: 0,
-			Msg:  "",
-			Type: "web",
-			Infos: utils.Map(labelModelReq.Infos, func(infoReq *http_model.LabelInfo) *http_model.TextParseInfo {
-				return &http_model.TextParseInfo{
-					LabelInfo: http_model.LabelInfo{
-						Txt:          infoReq.Txt,
-						Position:     infoReq.Position,
-						Tags:         infoReq.Tags,
-						Label:        consts.LABEL_CONTENT, // mock as content
-						Meta:         infoReq.Meta,
-						WebSegmentId: infoReq.WebSegmentId,
-					},
-				}
-			}),
-			ArticleMeta:  nil,
-			LabelVersion: "",
-		}
+// matchRule 按url匹配站点规则，出错或未匹配时返回nil
+func (s *WcdParseService) matchRule(ctx context.Context, req wcd.WcdParseReq) *mongo.SiteRuleModel {
+	rule, err := rule_index.SiteRuleIndex.Match(ctx, req.URL, req.GetRuleStageGroup())
+	if err != nil {
+		hlog.CtxErrorf(ctx, "match rule error: %v", err)
+		return nil
+	}
+	return rule
+}
 
-		rawReq, _ := sonic.MarshalString(labelModelReq)
-		rawResp, _ := sonic.MarshalString(labelModelResp)
+// selectLabeler 依次按请求指定、站点规则指定、全局默认选择标注器
+func (s *WcdParseService) selectLabeler(ctx context.Context, req wcd.WcdParseReq, rule *mongo.SiteRuleModel) (labeler.Labeler, error) {
+	ruleLabeler := ""
+	if rule != nil {
+		ruleLabeler = rule.Labeler
+	}
+	return labeler.Select(ctx, req.GetLabeler(), ruleLabeler)
+}
 
-		io := &http.ModelRawReqResp{
-			Req:  rawReq,
-			Resp: rawResp,
-			Code: 0,
-		}
-		return labelModelResp, io, nil
-	} else {
-		label, io, err := http.ParseLabel(ctx, labelModelReq)
-		if err != nil {
-			hlog.CtxErrorf(ctx, "http.ParseLabel failed, err: %v", err)
-			return label, io, err
+// resultCacheKey url、html、站点规则、标注器、输出格式和图片镜像都相同时，解析结果相同
+func (s *WcdParseService) resultCacheKey(req wcd.WcdParseReq, rule *mongo.SiteRuleModel, textLabeler labeler.Labeler) string {
+	ruleVersion := ""
+	if rule != nil {
+		ruleStr, _ := sonic.MarshalString(rule)
+		ruleVersion = utils.StrToMd5(ruleStr)
+	}
+	formats := slices.Clone(req.GetOutputFormats())
+	slices.Sort(formats)
+	return utils.StrToMd5(strings.Join([]string{
+		conf.GetConfig().Parse.Cache.Version,
+		req.URL,
+		utils.StrToMd5(req.HTML),
+		ruleVersion,
+		textLabeler.Name(),
+		textLabeler.Version(),
+		strings.Join(formats, ","),
+		strconv.FormatBool(mirrorImagesEnabled(req.MirrorImages)),
+	}, "|"))
+}
+
+// findCachedResult 未启用解析结果缓存或未命中时返回nil
+func (s *WcdParseService) findCachedResult(ctx context.Context, key string) *wcd.WcdParseResp {
+	resultHours := conf.GetConfig().Parse.Cache.ResultHours
+	if resultHours <= 0 {
+		return nil
+	}
+	model, err := store.ParseResult.FindByKey(ctx, key, time.Hour*time.Duration(resultHours))
+	if err != nil {
+		if !errors.Is(err, mongodriver.ErrNoDocuments) {
+			hlog.CtxErrorf(ctx, "find cached parse result error: %v", err)
 		}
-		return label, io, nil
+		return nil
+	}
+	result := &wcd.WcdParseResp{}
+	if err := sonic.UnmarshalString(model.Result, result); err != nil {
+		hlog.CtxErrorf(ctx, "unmarshal cached parse result error: %v", err)
+		return nil
+	}
+	result.WcdRequestID = utils.GetCtxOperationId(ctx)
+	result.FromCache = thrift.BoolPtr(true)
+	return result
+}
+
+func (s *WcdParseService) saveCachedResult(ctx context.Context, key string, resp *wcd.WcdParseResp) {
+	if conf.GetConfig().Parse.Cache.ResultHours <= 0 {
+		return
+	}
+	result, err := sonic.MarshalString(resp)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "marshal parse result error: %v", err)
+		return
+	}
+	err = store.ParseResult.SaveOne(ctx, mongo.ParseResultModel{
+		Key:        key,
+		Url:        resp.URL,
+		Result:     result,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+		Status:     consts.StatusValid,
+	})
+	if err != nil {
+		hlog.CtxErrorf(ctx, "save parse result error: %v", err)
 	}
 }
+
 func (s *WcdParseService) labelReqFromSegmentResult(ctx context.Context, url string, resp *wcd.SegmentResp) (*http_model.LabelModelReq, error) {
 	var (
 		labelInfos = make([]*http_model.LabelInfo, 0)
@@ -161,6 +215,25 @@ func (s *WcdParseService) WcdParse(ctx context.Context, req wcd.WcdParseReq) (wc
 		hlog.CtxErrorf(ctx, "WcdParse failed, err: %v", errMsg)
 		return wcdParseResp, &consts.ReqParamError
 	}
+	if bizErr := checkOutputFormats(req.GetOutputFormats()); bizErr != nil {
+		return wcdParseResp, bizErr
+	}
+
+	// 解析结果缓存，reparse时不读取
+	rule := s.matchRule(ctx, req)
+	textLabeler, err := s.selectLabeler(ctx, req, rule)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "s.selectLabeler failed, err: %v", err)
+		return wcdParseResp, consts.ReqParamError.WithDetail("%v", err)
+	}
+	cachePolicy := cachePolicyOf(ctx, req.GetReparse())
+	resultKey := s.resultCacheKey(req, rule, textLabeler)
+	if cachePolicy.Read {
+		if cached := s.findCachedResult(ctx, resultKey); cached != nil {
+			hlog.CtxInfof(ctx, "WcdParse hit result cache, url: %v", req.URL)
+			return cached, nil
+		}
+	}
 
 	segmentService := SegmentService{}
 	segmentReq := wcd.SegmentReq{
@@ -193,15 +266,16 @@ func (s *WcdParseService) WcdParse(ctx context.Context, req wcd.WcdParseReq) (wc
 	}
 
 	timeBeginLabel := time.Now()
-	labelResp, io, err := s.textParseLabelize(ctx, result)
+	labelResp, io, err := textLabeler.Label(ctx, result)
 	labelDuration = time.Since(timeBeginLabel).Seconds()
 
 	if io != nil {
 		wcdParseResp.ModelInputStr = io.Req
 		wcdParseResp.ModelResultStr = io.Resp
+		wcdParseResp.Labeler = &io.Labeler
 	}
 	if err != nil {
-		hlog.CtxErrorf(ctx, "s.textParseLabelize failed, err: %v", err)
+		hlog.CtxErrorf(ctx, "labeler %v failed, err: %v", textLabeler.Name(), err)
 		if io != nil {
 			switch io.Code {
 			case http_model.LabelModelCode_AllEduO, http_model.LabelModelCode_InfosEmpty:
@@ -244,9 +318,10 @@ func (s *WcdParseService) WcdParse(ctx context.Context, req wcd.WcdParseReq) (wc
 				Label:     info.Label,
 			}
 		}),
-		HTML:        segmentResp.HTML,
-		URL:         req.URL,
-		ArticleMeta: articleMeta,
+		HTML:          segmentResp.HTML,
+		URL:           req.URL,
+		ArticleMeta:   articleMeta,
+		OutputFormats: req.OutputFormats,
 	}
 
 	distill, bizErr := distillService.Distill(ctx, distillReq)
@@ -258,6 +333,7 @@ func (s *WcdParseService) WcdParse(ctx context.Context, req wcd.WcdParseReq) (wc
 	wcdParseResp.URL = req.URL                                       // 网页链接
 	wcdParseResp.Text = distill.Text                                 // 去噪文本
 	wcdParseResp.Images = distill.Images                             // 图片
+	wcdParseResp.ImageInfos = distill.ImageInfos                     // 图片尺寸、格式和图注
 	wcdParseResp.ReadableHTML = distill.HTML                         // 阅读器网页
 	wcdParseResp.Title = articleMeta.Title                           // 标题
 	wcdParseResp.Author = articleMeta.Author                         // 作者名
@@ -271,8 +347,24 @@ func (s *WcdParseService) WcdParse(ctx context.Context, req wcd.WcdParseReq) (wc
 	wcdParseResp.ModelResultStr = io.Resp                            // 模型响应原始数据
 	wcdParseResp.Worthless = distill.Worthless                       // 是否无意义
 	wcdParseResp.WorthType = distill.WorthType                       // 意义类型
+	wcdParseResp.Markdown = distill.Markdown                         // markdown，按需返回
+	wcdParseResp.Blocks = distill.Blocks                             // 结构化的块，按需返回
 	wcdParseResp.WcdRequestID = utils.GetCtxOperationId(ctx)
 	//wcdParseResp.OssInfo = nil
 
+	// 5.图片镜像，下载失败的图片保留原地址
+	if mirrorImagesEnabled(req.MirrorImages) && len(distill.Images) > 0 {
+		crawlImagesBegin := time.Now()
+		utils.CoreLog(ctx, utils.CoreNameCrawlImg, utils.NodeBegin)
+		mirrored := NewImageMirrorService().Mirror(ctx, req.URL, distill.Images)
+		rewriteImageUrls(wcdParseResp, mirrored)
+		crawlImagesDuration = time.Since(crawlImagesBegin).Seconds()
+		utils.CoreLog(ctx, utils.CoreNameCrawlImg, utils.NodeDone)
+	}
+
+	// 降级使用其他标注器的结果不缓存
+	if cachePolicy.Write && wcdParseResp.GetLabeler() == textLabeler.Name() {
+		s.saveCachedResult(ctx, resultKey, wcdParseResp)
+	}
 	return wcdParseResp, nil
 }
@@ -15,10 +15,12 @@ import (
 
 	"github.com/DeepLangAI/wcd/consts"
 	"github.com/DeepLangAI/wcd/dal/mongo"
+	"github.com/DeepLangAI/wcd/dal/rule_index"
 	"github.com/DeepLangAI/wcd/tools/sentence"
 	"github.com/DeepLangAI/wcd/utils"
 
 	"github.com/antchfx/xmlquery"
+	antxpath "github.com/antchfx/xpath"
 	"github.com/beevik/etree"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"golang.org/x/net/html"
@@ -389,6 +391,16 @@ func (d *Document) isSimpleXPath(xpath string) bool {
 	return re.MatchString(xpath)
 }
 
+// CompileXpath 按Xpath的方式选择引擎编译，只检查语法：简单的xpath使用etree，其余使用xmlquery
+func CompileXpath(expr string) error {
+	if (&Document{}).isSimpleXPath(expr) {
+		_, err := etree.CompilePath(expr)
+		return err
+	}
+	_, err := antxpath.Compile(expr)
+	return err
+}
+
 func (d *Document) RelativeXpath(elem *etree.Element, xpath string) []*etree.Element {
 	pid := d.GetElemPositionId(elem)
 	pidXpath := utils.GetPositionIdXpath(pid)
@@ -468,6 +480,12 @@ func (d *Document) GetElemPositionId(elem *etree.Element) int64 {
 	return -1
 }
 
+// HasPositionId 判断文档中是否存在该position_id的节点
+func (d *Document) HasPositionId(positionId int64) bool {
+	_, ok := d.xpathCache[strconv.FormatInt(positionId, 10)]
+	return ok
+}
+
 func (d *Document) IncreasePositionId() int64 {
 	d.MaxPositionId += 1
 	return d.MaxPositionId
@@ -839,20 +857,7 @@ func (d *Document) ElemToAtom(elem *etree.Element, text string, tail bool, segme
 	return atom, nil
 }
 func (d *Document) MatchRule() (*mongo.SiteRuleModel, error) {
-	dal := mongo.SiteRuleModelDal
-	rules, err := dal.FindManyByStageGroup(d.ctx, d.RuleStageGroup)
-	if err != nil {
-		return nil, err
-	}
-	sort.Slice(rules, func(i, j int) bool {
-		return len(rules[i].Host) > len(rules[j].Host)
-	})
-	for _, rule := range rules {
-		if rule.Match(d.Url) {
-			return rule, nil
-		}
-	}
-	return nil, nil
+	return rule_index.SiteRuleIndex.Match(d.ctx, d.Url, d.RuleStageGroup)
 }
 
 func (d *Document) GetRawHtmlStr() string {
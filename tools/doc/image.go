@@ -0,0 +1,68 @@
+package doc
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/utils"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/beevik/etree"
+)
+
+// GetImageInfos 正文图片的尺寸、格式、alt和图注，按文档顺序
+func (d *Document) GetImageInfos() []*wcd.ImageInfo {
+	infos := []*wcd.ImageInfo{}
+	for _, elem := range d.Xpath("//img") {
+		if info := d.GetImageInfo(elem); info != nil {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// GetImageInfo 宽高取width、height属性，没有时取style中的像素值。没有http链接时返回nil
+func (d *Document) GetImageInfo(elem *etree.Element) *wcd.ImageInfo {
+	imgUrl := ""
+	for _, key := range consts.IMG_ATTRS {
+		if value := elem.SelectAttrValue(key, ""); strings.HasPrefix(value, "http") {
+			imgUrl = value
+			break
+		}
+	}
+	if imgUrl == "" {
+		return nil
+	}
+	info := &wcd.ImageInfo{URL: imgUrl}
+	styleWidth, styleHeight := utils.ParseStyleImageSize(elem.SelectAttrValue(consts.StyleAttr, ""))
+	if width := cmp.Or(utils.ParseImageSize(elem.SelectAttrValue("width", "")), styleWidth); width > 0 {
+		info.Width = thrift.Int32Ptr(int32(width))
+	}
+	if height := cmp.Or(utils.ParseImageSize(elem.SelectAttrValue("height", "")), styleHeight); height > 0 {
+		info.Height = thrift.Int32Ptr(int32(height))
+	}
+	if mimeType := utils.ImageMimeTypeOfUrl(imgUrl); mimeType != "" {
+		info.MimeType = &mimeType
+	}
+	if alt := singleLine(cmp.Or(elem.SelectAttrValue("alt", ""), elem.SelectAttrValue("title", ""))); alt != "" {
+		info.Alt = &alt
+	}
+	for parent := elem.Parent(); parent != nil; parent = parent.Parent() {
+		if parent.Tag != "figure" {
+			continue
+		}
+		if captionElem := parent.FindElement(".//figcaption"); captionElem != nil {
+			if caption := singleLine(d.GetRawDocText(captionElem)); caption != "" {
+				info.Caption = &caption
+			}
+		}
+		break
+	}
+	return info
+}
+
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -13,6 +13,20 @@ type Extractor interface {
 	Extract() (string, error)
 }
 
+// 提取属性或调用函数的xpath后缀，如/@content、/text()
+var (
+	propertyXpathRegex = regexp.MustCompile(`/@(.*?)$`)
+	functionXpathRegex = regexp.MustCompile(`/([^/]+?)\(\)$`)
+)
+
+// ElemXpath 去掉提取属性或调用函数的后缀，返回选择节点的xpath
+func ElemXpath(xpath string) string {
+	if propertyXpathRegex.MatchString(xpath) {
+		return propertyXpathRegex.ReplaceAllString(xpath, "")
+	}
+	return functionXpathRegex.ReplaceAllString(xpath, "")
+}
+
 func ExtractByXpath(xpath string, doc *doc.Document) (string, error) {
 	if strings.Contains(xpath, consts.XpathSep) {
 		parts := strings.Split(xpath, consts.XpathSep)
@@ -32,19 +46,15 @@ func ExtractByXpath(xpath string, doc *doc.Document) (string, error) {
 }
 
 func extractByXpath(xpath string, doc *doc.Document) (string, error) {
-	propertyXpath := regexp.MustCompile(`/@(.*?)$`)
 	propertyKey := ""
-
-	functionXpath := regexp.MustCompile(`/([^/]+?)\(\)$`)
 	function := ""
 
-	if propertyXpath.MatchString(xpath) {
-		propertyKey = propertyXpath.FindStringSubmatch(xpath)[1]
-		xpath = propertyXpath.ReplaceAllString(xpath, "")
-	} else if functionXpath.MatchString(xpath) {
-		function = functionXpath.FindStringSubmatch(xpath)[1]
-		xpath = functionXpath.ReplaceAllString(xpath, "")
+	if propertyXpathRegex.MatchString(xpath) {
+		propertyKey = propertyXpathRegex.FindStringSubmatch(xpath)[1]
+	} else if functionXpathRegex.MatchString(xpath) {
+		function = functionXpathRegex.FindStringSubmatch(xpath)[1]
 	}
+	xpath = ElemXpath(xpath)
 
 	result := ""
 	coreRegex := `(\s|·)*`
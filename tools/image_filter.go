@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/tools/doc"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/beevik/etree"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	defaultMinImageWidth   = 32
+	defaultMinImageHeight  = 32
+	defaultProbeWorkerNum  = 8
+	defaultProbeTimeout    = 5 * time.Second
+	trackingPixelMaxLength = 2 // 宽或高不超过2px的图片视为跟踪像素或分隔线
+)
+
+// ImageFilter 去掉跟踪像素、占位图、表情等装饰性图片，并整理剩余图片的信息
+type ImageFilter struct {
+	ctx context.Context
+	doc *doc.Document
+	cfg conf.ImageFilter
+}
+
+func NewImageFilter(ctx context.Context, doc *doc.Document) *ImageFilter {
+	return &ImageFilter{
+		ctx: ctx,
+		doc: doc,
+		cfg: conf.GetConfig().Parse.ImageFilter,
+	}
+}
+
+type imageElem struct {
+	elem   *etree.Element
+	info   *wcd.ImageInfo
+	probed bool
+}
+
+// Filter 返回保留的图片信息，按文档顺序
+func (f *ImageFilter) Filter() []*wcd.ImageInfo {
+	images := []*imageElem{}
+	for _, elem := range f.doc.Xpath("//img") {
+		if info := f.doc.GetImageInfo(elem); info != nil {
+			images = append(images, &imageElem{elem: elem, info: info})
+		}
+	}
+	if f.cfg.Probe {
+		f.probe(images)
+	}
+
+	infos := []*wcd.ImageInfo{}
+	for _, image := range images {
+		if reason := f.decorativeReason(image.info); reason != "" {
+			hlog.CtxInfof(f.ctx, "remove decorative image: %v, reason: %v", image.info.URL, reason)
+			if err := f.doc.RemovElem(image.elem); err != nil {
+				hlog.CtxErrorf(f.ctx, "remove decorative image elem err: %v", err)
+			}
+			continue
+		}
+		infos = append(infos, image.info)
+	}
+	return infos
+}
+
+// decorativeReason 不是装饰性图片时返回空
+func (f *ImageFilter) decorativeReason(info *wcd.ImageInfo) string {
+	if match := consts.RegexRule_DecorativeImg.FindString(info.URL); match != "" {
+		return "url matches " + match
+	}
+	if !info.IsSetWidth() && !info.IsSetHeight() {
+		return ""
+	}
+	if (info.IsSetWidth() && info.GetWidth() <= trackingPixelMaxLength) || (info.IsSetHeight() && info.GetHeight() <= trackingPixelMaxLength) {
+		return "tracking pixel"
+	}
+	minWidth := int32(cmp.Or(max(f.cfg.MinWidth, 0), defaultMinImageWidth))
+	minHeight := int32(cmp.Or(max(f.cfg.MinHeight, 0), defaultMinImageHeight))
+	// 已知的宽高都小于下限
+	if (!info.IsSetWidth() || info.GetWidth() < minWidth) && (!info.IsSetHeight() || info.GetHeight() < minHeight) {
+		return "too small"
+	}
+	return ""
+}
+
+// probe 下载宽高未知的图片的头部，补全宽高和格式，并写回标签的width、height属性
+func (f *ImageFilter) probe(images []*imageElem) {
+	workerNum := cmp.Or(max(f.cfg.ProbeWorkerNum, 0), defaultProbeWorkerNum)
+	timeout := cmp.Or(time.Duration(max(f.cfg.ProbeTimeoutSeconds, 0))*time.Second, defaultProbeTimeout)
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, workerNum)
+	)
+	for _, image := range images {
+		if image.info.IsSetWidth() && image.info.IsSetHeight() {
+			continue
+		}
+		if consts.RegexRule_DecorativeImg.MatchString(image.info.URL) {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			width, height, mimeType, err := http.ProbeImage(f.ctx, image.info.URL, f.doc.Url, timeout)
+			if err != nil {
+				hlog.CtxWarnf(f.ctx, "probe image failed, url: %v, err: %v", image.info.URL, err)
+				return
+			}
+			// 标签中的宽高是显示尺寸，优先使用
+			if !image.info.IsSetWidth() {
+				image.info.Width = thrift.Int32Ptr(int32(width))
+			}
+			if !image.info.IsSetHeight() {
+				image.info.Height = thrift.Int32Ptr(int32(height))
+			}
+			image.info.MimeType = &mimeType
+			image.probed = true
+		}()
+	}
+	wg.Wait()
+
+	// etree不是并发安全的，全部探测结束后再修改标签
+	for _, image := range images {
+		if !image.probed {
+			continue
+		}
+		if image.elem.SelectAttr("width") == nil {
+			image.elem.CreateAttr("width", strconv.Itoa(int(image.info.GetWidth())))
+		}
+		if image.elem.SelectAttr("height") == nil {
+			image.elem.CreateAttr("height", strconv.Itoa(int(image.info.GetHeight())))
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package labeler
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DeepLangAI/wcd/biz/model/text_parse"
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/model/http_model"
+	"github.com/DeepLangAI/wcd/utils"
+)
+
+const heuristicVersion = "heuristic-v1"
+
+const (
+	metaMaxLength      = 60  // 作者、时间、来源等元信息句子的最大长度
+	metaSearchWindow   = 15  // 只在标题附近查找元信息
+	headingMaxLength   = 80  // 小标题的最大长度
+	legendMaxLength    = 100 // 图注的最大长度
+	noiseMaxLength     = 50  // 链接密集的短句视为噪声
+	noiseLinkRatio     = 0.8
+	preTitleLinkRatio  = 0.5 // 标题之前的导航区域
+	preTitleMinLength  = 10
+	referenceMaxLength = 500
+)
+
+var headingTags = []string{"h1", "h2", "h3", "h4", "h5", "h6"}
+
+var titleLevelLabels = []string{
+	consts.LABEL_TITLE_L1,
+	consts.LABEL_TITLE_L2,
+	consts.LABEL_TITLE_L3,
+	consts.LABEL_TITLE_L4,
+	consts.LABEL_TITLE_OTHER,
+}
+
+var datetimeRegexes = utils.Map(consts.DATETIME_PATTERN, func(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(pattern)
+})
+
+// HeuristicLabeler 不依赖标注模型，根据提取器结果、标签、链接密度和句子位置做本地打标
+type HeuristicLabeler struct {
+	name string
+}
+
+func NewHeuristicLabeler(name string) *HeuristicLabeler {
+	return &HeuristicLabeler{name: name}
+}
+
+func (h *HeuristicLabeler) Name() string {
+	return h.name
+}
+
+func (h *HeuristicLabeler) Version() string {
+	return heuristicVersion
+}
+
+// sentenceFeature 打标用到的句子特征
+type sentenceFeature struct {
+	text      string
+	length    int
+	tags      []string
+	heading   string // h1..h6，非标题为空
+	linkRatio float32
+	isImage   bool
+	isTable   bool
+	isVirtual bool // 切分时加入的虚拟标题节点
+}
+
+func newSentenceFeature(info *http_model.LabelInfo) *sentenceFeature {
+	text := strings.TrimSpace(info.Txt)
+	f := &sentenceFeature{
+		text:    text,
+		length:  utf8.RuneCountInString(utils.RemoveSpace(text)),
+		tags:    info.Tags,
+		isImage: strings.HasPrefix(text, consts.IMG_TAG_PREFIX),
+		isTable: strings.HasPrefix(text, consts.TABLE_TAG_PREFIX),
+	}
+	for _, tag := range headingTags {
+		if utils.Contains(info.Tags, tag) {
+			f.heading = tag
+			break
+		}
+	}
+	if info.Position != nil {
+		f.linkRatio = linkRatio(info.Position.Atoms)
+		f.isVirtual = len(info.Position.Atoms) > 0 && utils.All(info.Position.Atoms, func(atom *text_parse.AtomicTxt) bool {
+			return atom.PositionID == 0
+		})
+	}
+	return f
+}
+
+// linkRatio 句子中位于<a>内的文本占比
+func linkRatio(atoms []*text_parse.AtomicTxt) float32 {
+	total, inLink := 0, 0
+	for _, atom := range atoms {
+		if atom.Txt == nil {
+			continue
+		}
+		length := utf8.RuneCountInString(*atom.Txt)
+		total += length
+		if xpathInLink(atom.X) {
+			inLink += length
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float32(inLink) / float32(total)
+}
+
+func xpathInLink(xpath string) bool {
+	for _, step := range strings.Split(xpath, "/") {
+		if idx := strings.Index(step, "["); idx >= 0 {
+			step = step[:idx]
+		}
+		if step == "a" {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *HeuristicLabeler) Label(ctx context.Context, req *http_model.LabelModelReq) (*http_model.LabelModelResp, *http.ModelRawReqResp, error) {
+	features := utils.Map(req.Infos, newSentenceFeature)
+	labels := h.labelize(req.ArticleMeta, features)
+
+	infos := make([]*http_model.TextParseInfo, 0, len(req.Infos))
+	for i, info := range req.Infos {
+		labelInfo := *info
+		labelInfo.Label = labels[i]
+		infos = append(infos, &http_model.TextParseInfo{LabelInfo: labelInfo})
+	}
+	resp := &http_model.LabelModelResp{
+		Code:         0,
+		Type:         "web",
+		Infos:        infos,
+		LabelVersion: heuristicVersion,
+	}
+	return resp, newRawReqResp(h.name, req, resp), nil
+}
+
+func (h *HeuristicLabeler) labelize(meta wcd.ArticleMeta, features []*sentenceFeature) []string {
+	labels := make([]string, len(features))
+	for i := range labels {
+		labels[i] = consts.LABEL_CONTENT
+	}
+
+	titleIdx := h.markTitle(meta, features, labels)
+	h.markMeta(meta, features, labels, titleIdx)
+	h.markNoise(features, labels, titleIdx)
+	h.markFigures(features, labels)
+	h.markHeadings(features, labels)
+	h.markReferences(features, labels)
+	return labels
+}
+
+// markTitle 标记文章标题，返回标题所在位置，没有找到时返回-1
+func (h *HeuristicLabeler) markTitle(meta wcd.ArticleMeta, features []*sentenceFeature, labels []string) int {
+	title := utils.Clean(meta.Title)
+	titleIdx := -1
+	for i, f := range features {
+		if title == "" || f.isImage || f.isTable {
+			continue
+		}
+		text := utils.Clean(f.text)
+		if text == title || f.heading != "" && isSimilarTitle(text, title) {
+			labels[i] = consts.LABEL_TITLE
+			// 虚拟标题节点之后如果还有正文中的标题，以正文中的为准
+			if titleIdx < 0 || features[titleIdx].isVirtual {
+				titleIdx = i
+			}
+		}
+	}
+	if titleIdx >= 0 {
+		return titleIdx
+	}
+	// 没有提取到标题时，取第一个h1
+	for i, f := range features {
+		if f.heading == "h1" && f.length <= headingMaxLength && f.linkRatio < preTitleLinkRatio {
+			labels[i] = consts.LABEL_TITLE
+			return i
+		}
+	}
+	return -1
+}
+
+func isSimilarTitle(text, title string) bool {
+	if text == "" || title == "" {
+		return false
+	}
+	lcs := utils.LCS(text, title)
+	return float32(len(lcs))/float32(len(title)) >= consts.LCS_TITLE_RATIO &&
+		float32(len(lcs))/float32(len(text)) >= consts.LCS_TITLE_RATIO
+}
+
+// markMeta 在标题附近查找作者、发布时间、来源
+func (h *HeuristicLabeler) markMeta(meta wcd.ArticleMeta, features []*sentenceFeature, labels []string, titleIdx int) {
+	begin := max(titleIdx-metaSearchWindow, 0)
+	end := min(max(titleIdx, 0)+metaSearchWindow, len(features))
+	for i := begin; i < end; i++ {
+		f := features[i]
+		if labels[i] != consts.LABEL_CONTENT || f.isImage || f.isTable || f.heading != "" {
+			continue
+		}
+		if f.length == 0 || f.length > metaMaxLength {
+			continue
+		}
+		switch {
+		case consts.RegexRule_SourcePrefix.MatchString(f.text),
+			meta.ContentSource != "" && strings.Contains(f.text, meta.ContentSource):
+			labels[i] = consts.LABEL_SOURCE
+		case meta.Author != "" && strings.Contains(f.text, meta.Author),
+			isAuthorLine(f.text):
+			labels[i] = consts.LABEL_AUTHOR
+		case meta.PublishTime != "" && strings.Contains(f.text, meta.PublishTime),
+			isDatetimeLine(f.text):
+			labels[i] = consts.LABEL_PUB_TIME
+		}
+	}
+}
+
+func isAuthorLine(text string) bool {
+	for _, keyword := range consts.AUTHOR_KEYWORDS {
+		for _, sep := range []string{":", "：", "|", " "} {
+			if strings.HasPrefix(text, keyword+sep) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isDatetimeLine 句子主要内容是时间
+func isDatetimeLine(text string) bool {
+	for _, re := range datetimeRegexes {
+		if match := re.FindString(text); match != "" {
+			return utf8.RuneCountInString(match)*2 >= utf8.RuneCountInString(text)
+		}
+	}
+	return false
+}
+
+// markNoise 链接密集的短句、标题之前的导航视为噪声
+func (h *HeuristicLabeler) markNoise(features []*sentenceFeature, labels []string, titleIdx int) {
+	for i, f := range features {
+		if labels[i] != consts.LABEL_CONTENT || f.isTable {
+			continue
+		}
+		if f.linkRatio >= noiseLinkRatio && f.length <= noiseMaxLength {
+			labels[i] = consts.LABEL_NOISE
+			continue
+		}
+		if i < titleIdx && !f.isImage && (f.linkRatio >= preTitleLinkRatio || f.length < preTitleMinLength) {
+			labels[i] = consts.LABEL_NOISE
+		}
+	}
+}
+
+// markFigures 图片标为figure，紧随图片的figcaption或“图1”类短句标为图注
+func (h *HeuristicLabeler) markFigures(features []*sentenceFeature, labels []string) {
+	for i, f := range features {
+		if f.isImage && labels[i] == consts.LABEL_CONTENT {
+			labels[i] = consts.LABEL_FIGURE
+			continue
+		}
+		if labels[i] != consts.LABEL_CONTENT || f.length > legendMaxLength {
+			continue
+		}
+		if utils.Contains(f.tags, "figcaption") || utils.Contains(f.tags, "caption") {
+			labels[i] = consts.LABEL_LEGEND
+			continue
+		}
+		nearFigure := i > 0 && features[i-1].isImage || i+1 < len(features) && features[i+1].isImage
+		if nearFigure && consts.RegexRule_LegendPrefix.MatchString(f.text) {
+			labels[i] = consts.LABEL_LEGEND
+		}
+	}
+}
+
+// markHeadings 按文中出现的标题层级，依次映射为title1..title5
+func (h *HeuristicLabeler) markHeadings(features []*sentenceFeature, labels []string) {
+	levels := map[string]int{}
+	for i, f := range features {
+		if f.heading != "" && labels[i] == consts.LABEL_CONTENT {
+			levels[f.heading] = 1
+		}
+	}
+	headings := utils.KeysOfMap(levels)
+	sort.Strings(headings)
+	for i, f := range features {
+		if f.heading == "" || labels[i] != consts.LABEL_CONTENT || f.length > headingMaxLength {
+			continue
+		}
+		rank := utils.Index(headings, f.heading)
+		labels[i] = titleLevelLabels[min(rank, len(titleLevelLabels)-1)]
+	}
+}
+
+// markReferences “参考文献”之后直到下一个标题的句子标为reference
+func (h *HeuristicLabeler) markReferences(features []*sentenceFeature, labels []string) {
+	inReference := false
+	for i, f := range features {
+		if consts.RegexRule_ReferenceHeading.MatchString(f.text) {
+			inReference = true
+			continue
+		}
+		if !inReference {
+			continue
+		}
+		if f.heading != "" {
+			inReference = false
+			continue
+		}
+		if labels[i] == consts.LABEL_CONTENT && f.length <= referenceMaxLength {
+			labels[i] = consts.LABEL_REFERENCE
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package labeler
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/DeepLangAI/wcd/biz/model/text_parse"
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/model/http_model"
+)
+
+// testSentence 测试用的句子，link为true时整句位于<a>内
+type testSentence struct {
+	text string
+	tags []string
+	link bool
+}
+
+func newTestInfos(sentences []testSentence) []*http_model.LabelInfo {
+	infos := []*http_model.LabelInfo{}
+	for i, s := range sentences {
+		xpath := "/html/body/div/p"
+		if s.link {
+			xpath = "/html/body/div/p/a"
+		}
+		text := s.text
+		infos = append(infos, &http_model.LabelInfo{
+			Txt:  s.text,
+			Tags: s.tags,
+			Position: &http_model.LabelPosition{Atoms: []*text_parse.AtomicTxt{
+				{Txt: &text, PositionID: int32(i + 1), X: xpath},
+			}},
+		})
+	}
+	return infos
+}
+
+func TestHeuristicLabel(t *testing.T) {
+	content := "近年来，多地把老旧小区改造作为城市更新的重要抓手，居民的生活条件明显改善。"
+	cases := []struct {
+		name      string
+		meta      wcd.ArticleMeta
+		sentences []testSentence
+		labels    []string
+	}{
+		{
+			name:      "title equals extracted title",
+			meta:      wcd.ArticleMeta{Title: "老旧小区改造实践"},
+			sentences: []testSentence{{text: "老旧小区改造实践", tags: []string{"div"}}, {text: content}},
+			labels:    []string{consts.LABEL_TITLE, consts.LABEL_CONTENT},
+		},
+		{
+			name:      "similar heading as title",
+			meta:      wcd.ArticleMeta{Title: "城市更新中的老旧小区改造实践_示例新闻网"},
+			sentences: []testSentence{{text: "城市更新中的老旧小区改造实践", tags: []string{"h1"}}, {text: content}},
+			labels:    []string{consts.LABEL_TITLE, consts.LABEL_CONTENT},
+		},
+		{
+			name:      "first h1 without extracted title",
+			sentences: []testSentence{{text: content}, {text: "老旧小区改造实践", tags: []string{"h1"}}, {text: content}},
+			labels:    []string{consts.LABEL_CONTENT, consts.LABEL_TITLE, consts.LABEL_CONTENT},
+		},
+		{
+			name: "meta near title",
+			meta: wcd.ArticleMeta{Title: "老旧小区改造实践"},
+			sentences: []testSentence{
+				{text: "老旧小区改造实践", tags: []string{"h1"}},
+				{text: "来源：示例日报"},
+				{text: "作者：李明"},
+				{text: "2025-03-12 10:20"},
+				{text: content},
+			},
+			labels: []string{consts.LABEL_TITLE, consts.LABEL_SOURCE, consts.LABEL_AUTHOR, consts.LABEL_PUB_TIME, consts.LABEL_CONTENT},
+		},
+		{
+			name: "meta from extracted fields",
+			meta: wcd.ArticleMeta{Title: "老旧小区改造实践", Author: "李明", ContentSource: "示例日报"},
+			sentences: []testSentence{
+				{text: "老旧小区改造实践", tags: []string{"h1"}},
+				{text: "示例日报 记者 李明"},
+				{text: "李明 摄"},
+				{text: content},
+			},
+			labels: []string{consts.LABEL_TITLE, consts.LABEL_SOURCE, consts.LABEL_AUTHOR, consts.LABEL_CONTENT},
+		},
+		{
+			name: "long sentence with date is content",
+			meta: wcd.ArticleMeta{Title: "老旧小区改造实践"},
+			sentences: []testSentence{
+				{text: "老旧小区改造实践", tags: []string{"h1"}},
+				{text: "2025-03-12，街道召开居民议事会，收集居民对停车、照明和无障碍通道的意见。"},
+			},
+			labels: []string{consts.LABEL_TITLE, consts.LABEL_CONTENT},
+		},
+		{
+			name: "navigation before title and link lists",
+			meta: wcd.ArticleMeta{Title: "老旧小区改造实践"},
+			sentences: []testSentence{
+				{text: "首页", link: true},
+				{text: "返回"},
+				{text: "老旧小区改造实践", tags: []string{"h1"}},
+				{text: content},
+				{text: "相关阅读：老旧小区改造", link: true},
+				{text: content + content, link: true},
+			},
+			labels: []string{consts.LABEL_NOISE, consts.LABEL_NOISE, consts.LABEL_TITLE, consts.LABEL_CONTENT, consts.LABEL_NOISE, consts.LABEL_CONTENT},
+		},
+		{
+			name: "figure and legends",
+			meta: wcd.ArticleMeta{Title: "老旧小区改造实践"},
+			sentences: []testSentence{
+				{text: "老旧小区改造实践", tags: []string{"h1"}},
+				{text: `<img src="https://example.cn/a.jpg">`},
+				{text: "图1 改造后的小区中心广场"},
+				{text: "改造后的小区入口", tags: []string{"figcaption"}},
+				{text: "图2 不在图片旁边"},
+			},
+			labels: []string{consts.LABEL_TITLE, consts.LABEL_FIGURE, consts.LABEL_LEGEND, consts.LABEL_LEGEND, consts.LABEL_CONTENT},
+		},
+		{
+			name: "heading levels",
+			meta: wcd.ArticleMeta{Title: "老旧小区改造实践"},
+			sentences: []testSentence{
+				{text: "老旧小区改造实践", tags: []string{"h1"}},
+				{text: "先问需于民", tags: []string{"h2"}},
+				{text: content},
+				{text: "入户走访", tags: []string{"h4"}},
+				{text: content},
+				{text: "长效管理", tags: []string{"h2"}},
+			},
+			labels: []string{consts.LABEL_TITLE, consts.LABEL_TITLE_L1, consts.LABEL_CONTENT, consts.LABEL_TITLE_L2, consts.LABEL_CONTENT, consts.LABEL_TITLE_L1},
+		},
+		{
+			name: "references until next heading",
+			meta: wcd.ArticleMeta{Title: "老旧小区改造实践"},
+			sentences: []testSentence{
+				{text: "老旧小区改造实践", tags: []string{"h1"}},
+				{text: content},
+				{text: "参考文献", tags: []string{"h2"}},
+				{text: "[1] 城镇老旧小区改造工作指引. 2024."},
+				{text: "[2] 城市更新年度报告. 2025."},
+				{text: "附录", tags: []string{"h2"}},
+				{text: content},
+			},
+			labels: []string{consts.LABEL_TITLE, consts.LABEL_CONTENT, consts.LABEL_TITLE_L1, consts.LABEL_REFERENCE, consts.LABEL_REFERENCE, consts.LABEL_TITLE_L1, consts.LABEL_CONTENT},
+		},
+	}
+	h := NewHeuristicLabeler(TypeHeuristic)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp, _, err := h.Label(context.Background(), &http_model.LabelModelReq{
+				ArticleMeta: c.meta,
+				Infos:       newTestInfos(c.sentences),
+			})
+			if err != nil {
+				t.Fatalf("Label() error: %v", err)
+			}
+			labels := []string{}
+			for _, info := range resp.Infos {
+				labels = append(labels, info.Label)
+			}
+			if !slices.Equal(labels, c.labels) {
+				t.Errorf("labels = %v, want %v", labels, c.labels)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package labeler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/model/http_model"
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	TypeMock      = "mock"
+	TypeRemote    = "remote"
+	TypeHeuristic = "heuristic"
+	TypeReplay    = "replay"
+)
+
+// Labeler 对切分后的句子打标
+type Labeler interface {
+	// Name 标注器名称，与配置中的name一致
+	Name() string
+	// Version 标注逻辑或模型的版本，变化后缓存的解析结果失效
+	Version() string
+	// Label 打标，同时返回模型的原始输入输出
+	Label(ctx context.Context, req *http_model.LabelModelReq) (*http_model.LabelModelResp, *http.ModelRawReqResp, error)
+}
+
+var (
+	initOnce    sync.Once
+	registry    map[string]Labeler
+	defaultName string
+)
+
+// Init 按配置注册标注器。未显式调用时，在第一次获取标注器时初始化
+func Init() {
+	initOnce.Do(func() {
+		registry, defaultName = build(conf.GetConfig().Parse.Label)
+	})
+}
+
+func build(cfg conf.Label) (map[string]Labeler, string) {
+	labelers := map[string]Labeler{
+		TypeMock:      NewMockLabeler(TypeMock),
+		TypeHeuristic: NewHeuristicLabeler(TypeHeuristic),
+		TypeRemote:    NewRemoteLabeler(TypeRemote, "", http.LabelApi{}),
+	}
+	for _, c := range cfg.Labelers {
+		l, err := newLabeler(c)
+		if err != nil {
+			hlog.Errorf("register labeler failed, name: %v, err: %v", c.Name, err)
+			continue
+		}
+		labelers[c.Name] = l
+	}
+
+	if fallback, ok := labelers[cfg.Fallback]; ok {
+		for name, l := range labelers {
+			if remote, ok := l.(*RemoteLabeler); ok && name != cfg.Fallback {
+				labelers[name] = &timeoutFallbackLabeler{primary: remote, fallback: fallback}
+			}
+		}
+	} else if cfg.Fallback != "" {
+		hlog.Errorf("fallback labeler not found, name: %v", cfg.Fallback)
+	}
+
+	name := cfg.Default
+	if name == "" {
+		if cfg.UseMock {
+			name = TypeMock
+		} else {
+			name = TypeRemote
+		}
+	}
+	return labelers, name
+}
+
+func newLabeler(c conf.LabelerConfig) (Labeler, error) {
+	if c.Name == "" {
+		return nil, fmt.Errorf("labeler name is empty")
+	}
+	switch c.Type {
+	case TypeMock:
+		return NewMockLabeler(c.Name), nil
+	case TypeHeuristic:
+		return NewHeuristicLabeler(c.Name), nil
+	case TypeRemote:
+		return NewRemoteLabeler(c.Name, c.Version, http.LabelApi{
+			Host:    c.Host,
+			Path:    c.Path,
+			Timeout: time.Duration(c.TimeoutSeconds) * time.Second,
+		}), nil
+	case TypeReplay:
+		if c.ReplayFile == "" {
+			return nil, fmt.Errorf("replay file is empty")
+		}
+		return NewReplayLabeler(c.Name, c.ReplayFile), nil
+	}
+	return nil, fmt.Errorf("unknown labeler type: %v", c.Type)
+}
+
+// Get 按名称获取标注器，不存在时返回nil
+func Get(name string) Labeler {
+	Init()
+	return registry[name]
+}
+
+// Select 依次按请求指定、站点规则指定、全局默认选择标注器。请求指定的标注器不存在时返回错误
+func Select(ctx context.Context, reqName string, ruleName string) (Labeler, error) {
+	Init()
+	if reqName != "" {
+		if l, ok := registry[reqName]; ok {
+			return l, nil
+		}
+		return nil, fmt.Errorf("labeler not found: %v", reqName)
+	}
+	if ruleName != "" {
+		if l, ok := registry[ruleName]; ok {
+			return l, nil
+		}
+		hlog.CtxWarnf(ctx, "labeler of site rule not found, use default, name: %v", ruleName)
+	}
+	if l, ok := registry[defaultName]; ok {
+		return l, nil
+	}
+	hlog.CtxErrorf(ctx, "default labeler not found, use mock, name: %v", defaultName)
+	return registry[TypeMock], nil
+}
+
+// newRawReqResp 本地标注器没有真实的模型调用，按模型接口的格式记录输入输出
+func newRawReqResp(name string, req *http_model.LabelModelReq, resp *http_model.LabelModelResp) *http.ModelRawReqResp {
+	rawReq, _ := sonic.MarshalString(req)
+	rawResp, _ := sonic.MarshalString(resp)
+	return &http.ModelRawReqResp{
+		Req:     rawReq,
+		Resp:    rawResp,
+		Code:    http_model.LabelModelCode_Success,
+		Labeler: name,
+	}
+}
@@ -0,0 +1,50 @@
+package labeler
+
+import (
+	"context"
+
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/model/http_model"
+	"github.com/DeepLangAI/wcd/utils"
+)
+
+// MockLabeler 所有句子都标为正文
+type MockLabeler struct {
+	name string
+}
+
+func NewMockLabeler(name string) *MockLabeler {
+	return &MockLabeler{name: name}
+}
+
+func (m *MockLabeler) Name() string {
+	return m.name
+}
+
+func (m *MockLabeler) Version() string {
+	return "1"
+}
+
+func (m *MockLabeler) Label(ctx context.Context, req *http_model.LabelModelReq) (*http_model.LabelModelResp, *http.ModelRawReqResp, error) {
+	resp := &http_model.LabelModelResp{
+		Code: 0,
+		Msg:  "",
+		Type: "web",
+		Infos: utils.Map(req.Infos, func(infoReq *http_model.LabelInfo) *http_model.TextParseInfo {
+			return &http_model.TextParseInfo{
+				LabelInfo: http_model.LabelInfo{
+					Txt:          infoReq.Txt,
+					Position:     infoReq.Position,
+					Tags:         infoReq.Tags,
+					Label:        consts.LABEL_CONTENT, // mock as content
+					Meta:         infoReq.Meta,
+					WebSegmentId: infoReq.WebSegmentId,
+				},
+			}
+		}),
+		ArticleMeta:  nil,
+		LabelVersion: "",
+	}
+	return resp, newRawReqResp(m.name, req, resp), nil
+}
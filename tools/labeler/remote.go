@@ -0,0 +1,63 @@
+package labeler
+
+import (
+	"context"
+
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/model/http_model"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// RemoteLabeler 调用text-parse标注模型
+type RemoteLabeler struct {
+	name    string
+	version string // 模型的版本，由配置指定
+	api     http.LabelApi
+}
+
+func NewRemoteLabeler(name string, version string, api http.LabelApi) *RemoteLabeler {
+	return &RemoteLabeler{name: name, version: version, api: api}
+}
+
+func (r *RemoteLabeler) Name() string {
+	return r.name
+}
+
+func (r *RemoteLabeler) Version() string {
+	return r.version
+}
+
+func (r *RemoteLabeler) Label(ctx context.Context, req *http_model.LabelModelReq) (*http_model.LabelModelResp, *http.ModelRawReqResp, error) {
+	resp, io, err := http.ParseLabelWithApi(ctx, req, r.api)
+	if io != nil {
+		io.Labeler = r.name
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "http.ParseLabel failed, labeler: %v, err: %v", r.name, err)
+		return resp, io, err
+	}
+	return resp, io, nil
+}
+
+// timeoutFallbackLabeler 远程标注超时后，降级使用本地标注器，避免整个解析失败
+type timeoutFallbackLabeler struct {
+	primary  *RemoteLabeler
+	fallback Labeler
+}
+
+func (t *timeoutFallbackLabeler) Name() string {
+	return t.primary.Name()
+}
+
+func (t *timeoutFallbackLabeler) Version() string {
+	return t.primary.Version()
+}
+
+func (t *timeoutFallbackLabeler) Label(ctx context.Context, req *http_model.LabelModelReq) (*http_model.LabelModelResp, *http.ModelRawReqResp, error) {
+	resp, io, err := t.primary.Label(ctx, req)
+	if err == nil || !http.IsTimeoutErr(err) {
+		return resp, io, err
+	}
+	hlog.CtxWarnf(ctx, "labeler %v timeout, fallback to %v", t.primary.Name(), t.fallback.Name())
+	return t.fallback.Label(ctx, req)
+}
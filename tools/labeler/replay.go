@@ -0,0 +1,97 @@
+package labeler
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/DeepLangAI/wcd/model/http_model"
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// replayRecord 与解析结果的字段名保持一致，可以直接用/wcd/parse的返回结果生成回放文件
+type replayRecord struct {
+	URL            string `json:"url"`
+	ModelResultStr string `json:"model_result_str"`
+}
+
+// ReplayLabeler 按url回放文件中记录的模型结果，用于离线复现和对比
+type ReplayLabeler struct {
+	name string
+	file string
+
+	once    sync.Once
+	records map[string]string
+	loadErr error
+}
+
+func NewReplayLabeler(name string, file string) *ReplayLabeler {
+	return &ReplayLabeler{name: name, file: file}
+}
+
+func (r *ReplayLabeler) Name() string {
+	return r.name
+}
+
+// Version 结果来自回放文件，换文件后版本变化
+func (r *ReplayLabeler) Version() string {
+	return r.file
+}
+
+func (r *ReplayLabeler) load() {
+	path := r.file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(conf.GetProjectPath(), path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		r.loadErr = err
+		return
+	}
+	defer f.Close()
+
+	r.records = map[string]string{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		record := replayRecord{}
+		if err := sonic.Unmarshal(line, &record); err != nil {
+			hlog.Warnf("skip invalid replay record, file: %v, err: %v", r.file, err)
+			continue
+		}
+		if record.URL != "" && record.ModelResultStr != "" {
+			r.records[record.URL] = record.ModelResultStr
+		}
+	}
+	r.loadErr = scanner.Err()
+}
+
+func (r *ReplayLabeler) Label(ctx context.Context, req *http_model.LabelModelReq) (*http_model.LabelModelResp, *http.ModelRawReqResp, error) {
+	r.once.Do(r.load)
+	if r.loadErr != nil {
+		hlog.CtxErrorf(ctx, "load replay file failed, file: %v, err: %v", r.file, r.loadErr)
+		return nil, nil, r.loadErr
+	}
+	url := req.ArticleMeta.URL
+	raw, ok := r.records[url]
+	if !ok {
+		return nil, nil, fmt.Errorf("replay record not found, url: %v", url)
+	}
+	resp := &http_model.LabelModelResp{}
+	if err := sonic.UnmarshalString(raw, resp); err != nil {
+		return nil, nil, err
+	}
+	io := newRawReqResp(r.name, req, resp)
+	io.Resp = raw
+	return resp, io, nil
+}
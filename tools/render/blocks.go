@@ -0,0 +1,365 @@
+package render
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/tools/doc"
+	"github.com/DeepLangAI/wcd/utils"
+
+	"github.com/beevik/etree"
+)
+
+// BlockRenderer 把去噪后的文档按顺序拆成标题、段落、图片、表格等结构化的块，
+// 每个块带上对应切分原子的position_id和xpath，便于回溯到原网页做高亮和引用
+type BlockRenderer struct {
+	ctx       context.Context
+	doc       *doc.Document
+	title     string
+	sentences []*wcd.TextParseLabelSentence
+	xpaths    map[int64]string // 切分原子的position_id到xpath
+
+	headingOffset int // 文章标题占用一级标题，正文标题依次下移一级
+	blocks        []*wcd.ContentBlock
+	pending       *pendingParagraph
+}
+
+// pendingParagraph 正在收集的段落，遇到块级节点时输出
+type pendingParagraph struct {
+	lines []string
+	pids  []int64
+}
+
+func NewBlockRenderer(ctx context.Context, doc *doc.Document, articleMeta *wcd.ArticleMeta, sentences []*wcd.TextParseLabelSentence) *BlockRenderer {
+	r := &BlockRenderer{
+		ctx:       ctx,
+		doc:       doc,
+		sentences: sentences,
+		xpaths:    map[int64]string{},
+	}
+	if articleMeta != nil {
+		r.title = singleLine(articleMeta.Title)
+	}
+	if r.title != "" {
+		r.headingOffset = 1
+	}
+	for _, sentence := range sentences {
+		for _, atom := range sentence.Atoms {
+			// position_id为0的是虚拟节点（如标题），html中不存在
+			if atom.PositionID > 0 {
+				r.xpaths[int64(atom.PositionID)] = atom.Xpath
+			}
+		}
+	}
+	return r
+}
+
+func (r *BlockRenderer) Render() []*wcd.ContentBlock {
+	r.blocks = []*wcd.ContentBlock{}
+	r.pending = nil
+	if r.title != "" {
+		r.emitTitle()
+	}
+	if r.doc == nil || r.doc.Doc == nil || r.doc.Doc.Root() == nil {
+		return r.blocks
+	}
+	root := r.doc.Doc.Root()
+	if body := root.FindElement("./body"); body != nil {
+		root = body
+	}
+	r.walkChildren(root)
+	r.flush()
+	return r.blocks
+}
+
+// emitTitle 文章标题在去噪时已从正文中删除，位置取标注为标题的句子
+func (r *BlockRenderer) emitTitle() {
+	pids := []int64{}
+	for _, sentence := range r.sentences {
+		if sentence.Label != consts.LABEL_TITLE {
+			continue
+		}
+		for _, atom := range sentence.Atoms {
+			pids = append(pids, int64(atom.PositionID))
+		}
+	}
+	level := int32(1)
+	r.emit(&wcd.ContentBlock{Type: consts.BlockType_Heading, Text: r.title, Level: &level}, pids)
+}
+
+func (r *BlockRenderer) walkChildren(elem *etree.Element) {
+	var prev *etree.Element
+	for _, token := range elem.Child {
+		switch t := token.(type) {
+		case *etree.CharData:
+			// 节点的text属于节点自身，tail属于前一个兄弟节点
+			owner := elem
+			if prev != nil {
+				owner = prev
+			}
+			r.appendText(spaceRegex.ReplaceAllString(t.Data, " "), []int64{r.doc.GetElemPositionId(owner)})
+		case *etree.Element:
+			prev = t
+			if shouldSkip(t) {
+				continue
+			}
+			switch {
+			case t.Tag == "br":
+				r.appendText("\n", nil)
+			case isBlock(t):
+				r.flush()
+				r.walkBlock(t)
+				r.flush()
+			case containsBlock(t):
+				// 包裹了块级节点的行内节点，如包含图片的链接
+				r.walkChildren(t)
+			default:
+				r.appendText(plainText(t), r.collectPids(t))
+			}
+		}
+	}
+}
+
+func (r *BlockRenderer) walkBlock(elem *etree.Element) {
+	if level := headingLevel(elem); level > 0 {
+		text := singleLine(plainText(elem))
+		if text != "" {
+			level := int32(min(level+r.headingOffset, 6))
+			r.emit(&wcd.ContentBlock{Type: consts.BlockType_Heading, Text: text, Level: &level}, r.collectPids(elem))
+		}
+		return
+	}
+	if hasAttr(elem, consts.ATTR_PREFIX+"reference") {
+		if text := plainText(elem); text != "" {
+			r.emit(&wcd.ContentBlock{Type: consts.BlockType_Reference, Text: text}, r.collectPids(elem))
+		}
+		return
+	}
+	if hasAttr(elem, consts.ATTR_PREFIX+"legend") {
+		r.caption(elem)
+		return
+	}
+
+	switch {
+	case elem.Tag == "img":
+		r.image(elem)
+	case elem.Tag == "table":
+		r.table(elem)
+	case elem.Tag == "pre":
+		if code := strings.Trim(rawText(elem), "\n"); strings.TrimSpace(code) != "" {
+			language := codeLanguage(elem)
+			r.emit(&wcd.ContentBlock{Type: consts.BlockType_Code, Text: code, Language: &language}, r.collectPids(elem))
+		}
+	case elem.Tag == "blockquote":
+		if text := plainText(elem); text != "" {
+			r.emit(&wcd.ContentBlock{Type: consts.BlockType_Quote, Text: text}, r.collectPids(elem))
+		}
+	case utils.Contains(listTags, elem.Tag):
+		r.list(elem)
+	case elem.Tag == "figcaption" || elem.Tag == "caption":
+		r.caption(elem)
+	case elem.Tag == "hr":
+	default:
+		r.walkChildren(elem)
+	}
+}
+
+func (r *BlockRenderer) image(elem *etree.Element) {
+	src := imageSrc(elem)
+	if src == "" {
+		return
+	}
+	alt := imageAlt(elem)
+	r.emit(&wcd.ContentBlock{Type: consts.BlockType_Image, URL: &src, Alt: &alt}, r.collectPids(elem))
+}
+
+func (r *BlockRenderer) table(elem *etree.Element) {
+	block := &wcd.ContentBlock{Type: consts.BlockType_Table, Rows: [][]string{}}
+	lines := []string{}
+	for _, tr := range elem.FindElements(".//tr") {
+		cells := []string{}
+		for _, cell := range tr.ChildElements() {
+			if cell.Tag == "td" || cell.Tag == "th" {
+				cells = append(cells, singleLine(plainText(cell)))
+			}
+		}
+		if len(cells) > 0 {
+			block.Rows = append(block.Rows, cells)
+			lines = append(lines, strings.Join(cells, "\t"))
+		}
+	}
+	if captionElem := elem.FindElement("./caption"); captionElem != nil {
+		if caption := singleLine(plainText(captionElem)); caption != "" {
+			block.Caption = &caption
+		}
+	}
+	if len(block.Rows) == 0 && block.Caption == nil {
+		return
+	}
+	block.Text = strings.Join(lines, "\n")
+	r.emit(block, r.collectPids(elem))
+}
+
+func (r *BlockRenderer) list(elem *etree.Element) {
+	ordered := elem.Tag == "ol"
+	items := []string{}
+	for _, child := range elem.ChildElements() {
+		if shouldSkip(child) {
+			continue
+		}
+		if text := plainText(child); text != "" {
+			items = append(items, text)
+		}
+	}
+	if len(items) == 0 {
+		return
+	}
+	r.emit(&wcd.ContentBlock{
+		Type:    consts.BlockType_List,
+		Text:    strings.Join(items, "\n"),
+		Items:   items,
+		Ordered: &ordered,
+	}, r.collectPids(elem))
+}
+
+// caption 紧跟在图片或表格后的图注归到前一个块中，否则单独作为一个块
+func (r *BlockRenderer) caption(elem *etree.Element) {
+	text := singleLine(plainText(elem))
+	if text == "" {
+		return
+	}
+	pids := r.collectPids(elem)
+	if len(r.blocks) > 0 {
+		last := r.blocks[len(r.blocks)-1]
+		if (last.Type == consts.BlockType_Image || last.Type == consts.BlockType_Table) && last.Caption == nil {
+			last.Caption = &text
+			r.appendPositions(last, pids)
+			return
+		}
+	}
+	r.emit(&wcd.ContentBlock{Type: consts.BlockType_Caption, Text: text}, pids)
+}
+
+func (r *BlockRenderer) appendText(text string, pids []int64) {
+	if strings.TrimSpace(text) == "" && text != "\n" {
+		if r.pending != nil && text != "" {
+			r.pending.lines[len(r.pending.lines)-1] += " "
+		}
+		return
+	}
+	if r.pending == nil {
+		r.pending = &pendingParagraph{lines: []string{""}}
+	}
+	// 行内节点的纯文本可能包含换行（如<br>），按行拼接
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			r.pending.lines = append(r.pending.lines, "")
+		}
+		r.pending.lines[len(r.pending.lines)-1] += line
+	}
+	r.pending.pids = append(r.pending.pids, pids...)
+}
+
+// flush 输出正在收集的段落
+func (r *BlockRenderer) flush() {
+	if r.pending == nil {
+		return
+	}
+	lines := utils.Filter(utils.Map(r.pending.lines, singleLine), func(line string) bool {
+		return line != ""
+	})
+	if len(lines) > 0 {
+		r.emit(&wcd.ContentBlock{Type: consts.BlockType_Paragraph, Text: strings.Join(lines, "\n")}, r.pending.pids)
+	}
+	r.pending = nil
+}
+
+func (r *BlockRenderer) emit(block *wcd.ContentBlock, pids []int64) {
+	block.PositionIds = []int32{}
+	block.Xpaths = []string{}
+	r.appendPositions(block, pids)
+	r.blocks = append(r.blocks, block)
+}
+
+// appendPositions 只保留切分原子的position_id，按文档顺序去重
+func (r *BlockRenderer) appendPositions(block *wcd.ContentBlock, pids []int64) {
+	for _, pid := range pids {
+		xpath, ok := r.xpaths[pid]
+		if !ok || utils.Contains(block.PositionIds, int32(pid)) {
+			continue
+		}
+		block.PositionIds = append(block.PositionIds, int32(pid))
+		if !utils.Contains(block.Xpaths, xpath) {
+			block.Xpaths = append(block.Xpaths, xpath)
+		}
+	}
+}
+
+func (r *BlockRenderer) collectPids(elem *etree.Element) []int64 {
+	pids := []int64{}
+	var walk func(node *etree.Element)
+	walk = func(node *etree.Element) {
+		if shouldSkip(node) {
+			return
+		}
+		if pid := r.doc.GetElemPositionId(node); pid > 0 {
+			pids = append(pids, pid)
+		}
+		for _, child := range node.ChildElements() {
+			walk(child)
+		}
+	}
+	walk(elem)
+	return pids
+}
+
+// isBlock 单独成块的节点
+func isBlock(elem *etree.Element) bool {
+	if headingLevel(elem) > 0 || hasAttr(elem, consts.ATTR_PREFIX+"reference") || hasAttr(elem, consts.ATTR_PREFIX+"legend") {
+		return true
+	}
+	if utils.Contains([]string{"img", "table", "pre", "blockquote", "figcaption", "caption", "hr"}, elem.Tag) {
+		return true
+	}
+	return utils.Contains(listTags, elem.Tag) || isBlockContainer(elem)
+}
+
+func containsBlock(elem *etree.Element) bool {
+	for _, child := range elem.ChildElements() {
+		if shouldSkip(child) {
+			continue
+		}
+		if isBlock(child) || containsBlock(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// plainText 节点的纯文本，块级节点之间换行
+func plainText(elem *etree.Element) string {
+	sb := strings.Builder{}
+	for _, token := range elem.Child {
+		switch t := token.(type) {
+		case *etree.CharData:
+			sb.WriteString(spaceRegex.ReplaceAllString(t.Data, " "))
+		case *etree.Element:
+			if shouldSkip(t) {
+				continue
+			}
+			if t.Tag == "br" {
+				sb.WriteString("\n")
+			} else if isBlock(t) || utils.Contains([]string{"li", "tr"}, t.Tag) {
+				sb.WriteString("\n" + plainText(t) + "\n")
+			} else {
+				sb.WriteString(plainText(t))
+			}
+		}
+	}
+	lines := utils.Filter(utils.Map(strings.Split(sb.String(), "\n"), singleLine), func(line string) bool {
+		return line != ""
+	})
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,412 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/biz/model/wcd"
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/tools/doc"
+	"github.com/DeepLangAI/wcd/utils"
+
+	"github.com/beevik/etree"
+)
+
+var (
+	mdTrailingSpace = regexp.MustCompile(`[ \t]+\n`)
+	mdBlankLines    = regexp.MustCompile(`\n{3,}`)
+	mdEscaper       = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+)
+
+const mdPlaceholder = "\x00code-%d\x00"
+
+// MarkdownRenderer 把去噪后的文档渲染为markdown，保留标题层级、列表、链接、图片、表格、代码、引用和参考文献
+type MarkdownRenderer struct {
+	ctx   context.Context
+	doc   *doc.Document
+	title string
+
+	headingOffset int      // 文章标题占用一级标题，正文标题依次下移一级
+	inHeading     int      // 标题、链接内的内容只输出为一行
+	codeBlocks    []string // 代码块先用占位符代替，避免空白被规范化
+}
+
+func NewMarkdownRenderer(ctx context.Context, doc *doc.Document, articleMeta *wcd.ArticleMeta) *MarkdownRenderer {
+	r := &MarkdownRenderer{
+		ctx: ctx,
+		doc: doc,
+	}
+	if articleMeta != nil {
+		r.title = strings.TrimSpace(spaceRegex.ReplaceAllString(articleMeta.Title, " "))
+	}
+	if r.title != "" {
+		r.headingOffset = 1
+	}
+	return r
+}
+
+func (r *MarkdownRenderer) Render() string {
+	if r.doc == nil || r.doc.Doc == nil || r.doc.Doc.Root() == nil {
+		return ""
+	}
+	root := r.doc.Doc.Root()
+	if body := root.FindElement("./body"); body != nil {
+		root = body
+	}
+	r.codeBlocks = nil
+	out := normalizeBlock(r.renderChildren(root))
+	for i, code := range r.codeBlocks {
+		out = strings.Replace(out, fmt.Sprintf(mdPlaceholder, i), code, 1)
+	}
+	if r.title != "" {
+		out = strings.TrimSpace("# " + mdEscaper.Replace(r.title) + "\n\n" + out)
+	}
+	if out == "" {
+		return ""
+	}
+	return out + "\n"
+}
+
+func (r *MarkdownRenderer) renderChildren(elem *etree.Element) string {
+	out := ""
+	for _, token := range elem.Child {
+		switch t := token.(type) {
+		case *etree.CharData:
+			text := mdEscaper.Replace(spaceRegex.ReplaceAllString(t.Data, " "))
+			if out == "" || strings.HasSuffix(out, "\n") {
+				text = strings.TrimLeft(text, " ")
+			}
+			out += text
+		case *etree.Element:
+			s := r.renderElem(t)
+			if strings.HasPrefix(s, "\n") {
+				out = strings.TrimRight(out, " ")
+			}
+			out += s
+		}
+	}
+	return out
+}
+
+func (r *MarkdownRenderer) renderElem(elem *etree.Element) string {
+	if shouldSkip(elem) {
+		return ""
+	}
+	if level := r.headingLevel(elem); level > 0 {
+		text := r.renderLine(elem)
+		if text == "" {
+			return ""
+		}
+		return block(strings.Repeat("#", level) + " " + text)
+	}
+	if hasAttr(elem, consts.ATTR_PREFIX+"reference") {
+		return r.renderReference(elem)
+	}
+	if hasAttr(elem, consts.ATTR_PREFIX+"legend") {
+		return r.renderCaption(elem)
+	}
+
+	switch elem.Tag {
+	case "br":
+		return "\n"
+	case "hr":
+		return block("---")
+	case "strong", "b":
+		return wrapInline(r.renderChildren(elem), "**")
+	case "em", "i":
+		return wrapInline(r.renderChildren(elem), "*")
+	case "del", "s", "strike":
+		return wrapInline(r.renderChildren(elem), "~~")
+	case "code", "kbd", "samp":
+		return inlineCode(rawText(elem))
+	case "a":
+		return r.renderLink(elem)
+	case "img":
+		return r.renderImage(elem)
+	case "pre":
+		return r.renderCode(elem)
+	case "blockquote":
+		return r.renderQuote(elem)
+	case "ul", "ol", "menu":
+		return r.renderList(elem)
+	case "li":
+		return r.renderList(&etree.Element{Tag: "ul", Child: []etree.Token{elem.Copy()}})
+	case "table":
+		return r.renderTable(elem)
+	case "figcaption", "caption":
+		return r.renderCaption(elem)
+	}
+	if isBlockContainer(elem) {
+		return block(r.renderChildren(elem))
+	}
+	return r.renderChildren(elem)
+}
+
+func (r *MarkdownRenderer) headingLevel(elem *etree.Element) int {
+	if r.inHeading > 0 {
+		return 0
+	}
+	if level := headingLevel(elem); level > 0 {
+		return min(level+r.headingOffset, 6)
+	}
+	return 0
+}
+
+// renderLine 把元素内容渲染为一行，用于标题、链接文字、表格单元格等
+func (r *MarkdownRenderer) renderLine(elem *etree.Element) string {
+	r.inHeading++
+	defer func() { r.inHeading-- }()
+	return singleLine(r.renderChildren(elem))
+}
+
+func (r *MarkdownRenderer) renderLink(elem *etree.Element) string {
+	text := r.renderLine(elem)
+	if text == "" {
+		return ""
+	}
+	href := ""
+	for _, key := range consts.A_ATTRS {
+		if href = strings.TrimSpace(elem.SelectAttrValue(key, "")); href != "" {
+			break
+		}
+	}
+	lowerHref := strings.ToLower(href)
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(lowerHref, "javascript:") {
+		return text
+	}
+	return fmt.Sprintf("[%v](%v)", text, escapeUrl(href))
+}
+
+func (r *MarkdownRenderer) renderImage(elem *etree.Element) string {
+	src := imageSrc(elem)
+	if src == "" {
+		return ""
+	}
+	alt := mdEscaper.Replace(imageAlt(elem))
+	return fmt.Sprintf("![%v](%v)", alt, escapeUrl(src))
+}
+
+func (r *MarkdownRenderer) renderCode(elem *etree.Element) string {
+	code := strings.Trim(rawText(elem), "\n")
+	if strings.TrimSpace(code) == "" {
+		return ""
+	}
+	language := codeLanguage(elem)
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	r.codeBlocks = append(r.codeBlocks, fence+language+"\n"+code+"\n"+fence)
+	return block(fmt.Sprintf(mdPlaceholder, len(r.codeBlocks)-1))
+}
+
+func (r *MarkdownRenderer) renderQuote(elem *etree.Element) string {
+	content := normalizeBlock(r.renderChildren(elem))
+	if content == "" {
+		return ""
+	}
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = ">"
+		} else {
+			lines[i] = "> " + line
+		}
+	}
+	return block(strings.Join(lines, "\n"))
+}
+
+func (r *MarkdownRenderer) renderList(elem *etree.Element) string {
+	ordered := elem.Tag == "ol"
+	index, err := strconv.Atoi(elem.SelectAttrValue("start", "1"))
+	if err != nil {
+		index = 1
+	}
+	items := []string{}
+	markerWidth := 0
+	for _, child := range elem.ChildElements() {
+		if shouldSkip(child) {
+			continue
+		}
+		// 不规范的html中，子列表直接挂在列表下，归到上一项中
+		if utils.Contains(listTags, child.Tag) && len(items) > 0 {
+			if sub := normalizeBlock(r.renderList(child)); sub != "" {
+				items[len(items)-1] += "\n" + indent(sub, markerWidth, true)
+			}
+			continue
+		}
+		content := ""
+		if child.Tag == "li" {
+			content = normalizeBlock(r.renderChildren(child))
+		} else {
+			content = normalizeBlock(r.renderElem(child))
+		}
+		if content == "" {
+			continue
+		}
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", index)
+			index++
+		}
+		markerWidth = len(marker)
+		items = append(items, marker+indent(content, markerWidth, false))
+	}
+	return block(strings.Join(items, "\n"))
+}
+
+// renderTable 规则的表格输出为markdown表格，有合并单元格或嵌套表格时保留html
+func (r *MarkdownRenderer) renderTable(elem *etree.Element) string {
+	caption := ""
+	if captionElem := elem.FindElement("./caption"); captionElem != nil {
+		caption = r.renderCaption(captionElem)
+	}
+	if elem.FindElement(".//table") != nil {
+		return caption + tableHtml(elem)
+	}
+
+	rows := [][]string{}
+	headerRow := false
+	for _, tr := range elem.FindElements(".//tr") {
+		cells := []string{}
+		allTh := true
+		for _, cell := range tr.ChildElements() {
+			if cell.Tag != "td" && cell.Tag != "th" {
+				continue
+			}
+			if cell.SelectAttrValue("colspan", "1") != "1" || cell.SelectAttrValue("rowspan", "1") != "1" {
+				return caption + tableHtml(elem)
+			}
+			allTh = allTh && cell.Tag == "th"
+			cells = append(cells, strings.ReplaceAll(r.renderLine(cell), "|", `\|`))
+		}
+		if len(cells) == 0 {
+			continue
+		}
+		if len(rows) == 0 {
+			headerRow = allTh
+		}
+		rows = append(rows, cells)
+	}
+	if len(rows) == 0 {
+		return caption
+	}
+
+	numCols := 0
+	for _, row := range rows {
+		numCols = max(numCols, len(row))
+	}
+	if !headerRow {
+		// markdown表格必须有表头
+		rows = append([][]string{make([]string, numCols)}, rows...)
+	}
+	lines := []string{}
+	for i, row := range rows {
+		for len(row) < numCols {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", numCols))
+		}
+	}
+	return caption + block(strings.Join(lines, "\n"))
+}
+
+func (r *MarkdownRenderer) renderCaption(elem *etree.Element) string {
+	text := r.renderLine(elem)
+	if text == "" {
+		return ""
+	}
+	return block("*" + text + "*")
+}
+
+// renderReference 参考文献逐条输出为列表项
+func (r *MarkdownRenderer) renderReference(elem *etree.Element) string {
+	text := r.renderLine(elem)
+	if text == "" {
+		return ""
+	}
+	return block("- " + text)
+}
+
+func block(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ""
+	}
+	return "\n\n" + s + "\n\n"
+}
+
+func wrapInline(s string, marker string) string {
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return s
+	}
+	prefix, suffix := "", ""
+	if strings.HasPrefix(s, " ") {
+		prefix = " "
+	}
+	if strings.HasSuffix(s, " ") {
+		suffix = " "
+	}
+	return prefix + marker + text + marker + suffix
+}
+
+func inlineCode(code string) string {
+	code = strings.TrimSpace(spaceRegex.ReplaceAllString(code, " "))
+	if code == "" {
+		return ""
+	}
+	if strings.Contains(code, "`") {
+		return "`` " + code + " ``"
+	}
+	return "`" + code + "`"
+}
+
+func normalizeBlock(s string) string {
+	s = mdTrailingSpace.ReplaceAllString(s, "\n")
+	s = mdBlankLines.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
+
+// indent 列表项内容的后续行按标记宽度缩进
+func indent(s string, width int, firstLine bool) string {
+	lines := strings.Split(s, "\n")
+	padding := strings.Repeat(" ", width)
+	for i, line := range lines {
+		if line == "" || i == 0 && !firstLine {
+			continue
+		}
+		lines[i] = padding + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// tableHtml 去掉解析过程中添加的属性，只保留合并单元格相关的属性
+func tableHtml(elem *etree.Element) string {
+	table := elem.Copy()
+	stack := []*etree.Element{table}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		attrs := []etree.Attr{}
+		for _, attr := range node.Attr {
+			if attr.Key == "colspan" || attr.Key == "rowspan" {
+				attrs = append(attrs, attr)
+			}
+		}
+		node.Attr = attrs
+		stack = append(stack, node.ChildElements()...)
+	}
+	writer := &strings.Builder{}
+	table.WriteTo(writer, &etree.WriteSettings{CanonicalEndTags: true})
+	return block(writer.String())
+}
+
+func escapeUrl(url string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(url)
+}
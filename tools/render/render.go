@@ -0,0 +1,120 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/utils"
+
+	"github.com/beevik/etree"
+)
+
+var (
+	// 不输出内容的标签
+	skipTags = []string{
+		"head", "script", "style", "noscript", "iframe", "object", "template",
+		"svg", "canvas", "video", "audio", "button", "input", "select", "textarea",
+	}
+	// 按段落输出的标签
+	blockTags = []string{
+		"html", "body", "p", "div", "section", "article", "main", "header", "footer", "aside", "nav",
+		"figure", "dl", "dt", "dd", "address", "details", "summary", "center", "form", "fieldset",
+	}
+	headingTags = []string{"h1", "h2", "h3", "h4", "h5", "h6"}
+	listTags    = []string{"ul", "ol", "menu"}
+
+	spaceRegex        = regexp.MustCompile(`[\s\x{00a0}\x{3000}]+`)
+	codeLanguageRegex = regexp.MustCompile(`(?:^|\s)(?:language|lang)-([\w+#-]+)`)
+)
+
+// shouldSkip 不可见或不含正文的节点
+func shouldSkip(elem *etree.Element) bool {
+	if utils.Contains(skipTags, elem.Tag) {
+		return true
+	}
+	style := strings.ToLower(strings.ReplaceAll(elem.SelectAttrValue(consts.StyleAttr, ""), " ", ""))
+	return strings.Contains(style, "display:none")
+}
+
+// headingLevel 优先使用标注得到的data-deeplang-h1..h5，其次是h1..h6标签；不是标题时返回0
+func headingLevel(elem *etree.Element) int {
+	for i := 1; i <= 5; i++ {
+		if hasAttr(elem, fmt.Sprintf("%vh%d", consts.ATTR_PREFIX, i)) {
+			return i
+		}
+	}
+	return utils.Index(headingTags, elem.Tag) + 1
+}
+
+// isBlockContainer 按段落输出的容器节点。subtree格式化时用display: block的span作为段落
+func isBlockContainer(elem *etree.Element) bool {
+	return utils.Contains(blockTags, elem.Tag) || isDisplayBlock(elem)
+}
+
+func isDisplayBlock(elem *etree.Element) bool {
+	style := strings.ToLower(strings.ReplaceAll(elem.SelectAttrValue(consts.StyleAttr, ""), " ", ""))
+	return strings.Contains(style, "display:block")
+}
+
+func hasAttr(elem *etree.Element, key string) bool {
+	return elem.SelectAttr(key) != nil
+}
+
+// rawText 保留空白的文本，用于代码
+func rawText(elem *etree.Element) string {
+	sb := strings.Builder{}
+	for _, token := range elem.Child {
+		switch t := token.(type) {
+		case *etree.CharData:
+			sb.WriteString(t.Data)
+		case *etree.Element:
+			if t.Tag == "br" {
+				sb.WriteString("\n")
+			} else {
+				sb.WriteString(rawText(t))
+			}
+		}
+	}
+	return sb.String()
+}
+
+func codeLanguage(elem *etree.Element) string {
+	if match := codeLanguageRegex.FindStringSubmatch(elem.SelectAttrValue("class", "")); len(match) > 1 {
+		return match[1]
+	}
+	if codeElem := elem.FindElement("./code"); codeElem != nil {
+		return codeLanguage(codeElem)
+	}
+	return ""
+}
+
+// imageSrc 优先取http开头的图片链接，忽略data url
+func imageSrc(elem *etree.Element) string {
+	src := ""
+	for _, key := range consts.IMG_ATTRS {
+		value := strings.TrimSpace(elem.SelectAttrValue(key, ""))
+		if value == "" {
+			continue
+		}
+		if src == "" || strings.HasPrefix(value, "http") && !strings.HasPrefix(src, "http") {
+			src = value
+		}
+	}
+	if strings.HasPrefix(src, "data:") {
+		return ""
+	}
+	return src
+}
+
+func imageAlt(elem *etree.Element) string {
+	if alt := elem.SelectAttrValue("alt", ""); alt != "" {
+		return singleLine(alt)
+	}
+	return singleLine(elem.SelectAttrValue("title", ""))
+}
+
+func singleLine(s string) string {
+	return strings.TrimSpace(spaceRegex.ReplaceAllString(s, " "))
+}
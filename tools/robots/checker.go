@@ -0,0 +1,138 @@
+package robots
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/DeepLangAI/wcd/conf"
+	"github.com/DeepLangAI/wcd/http"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	DefaultUserAgent = "wcd"
+
+	defaultCacheDuration = 24 * time.Hour
+	defaultFetchTimeout  = 10 * time.Second
+	// robots.txt无法访问时按禁止抓取处理，缓存较短的时间后重新获取
+	errorCacheDuration = 10 * time.Minute
+	// 超过该数量后清理过期的缓存
+	maxCachedHosts = 10000
+)
+
+type cacheEntry struct {
+	ready  chan struct{} // 获取完成后关闭
+	robots *Robots
+	expire time.Time
+}
+
+var (
+	cacheMu sync.Mutex
+	cache   = map[string]*cacheEntry{}
+)
+
+// UserAgent 匹配robots.txt和meta标签的名称
+func UserAgent() string {
+	if ua := conf.GetConfig().Parse.Crawl.Robots.UserAgent; ua != "" {
+		return ua
+	}
+	return DefaultUserAgent
+}
+
+// Enabled 请求指定时以请求为准，否则使用全局配置
+func Enabled(reqRespect *bool) bool {
+	if reqRespect != nil {
+		return *reqRespect
+	}
+	return conf.GetConfig().Parse.Crawl.Robots.Enabled
+}
+
+// Allowed 按robots.txt判断是否允许抓取网页，robots.txt按scheme和host缓存。
+// url无法解析时返回true，由抓取环节报错
+func Allowed(ctx context.Context, pageUrl string) bool {
+	u, err := url.Parse(pageUrl)
+	if err != nil || u.Host == "" {
+		return true
+	}
+	robots := getRobots(ctx, u.Scheme+"://"+u.Host)
+	if robots == nil {
+		return false
+	}
+	path := u.EscapedPath()
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	allowed := robots.Allowed(UserAgent(), path)
+	if !allowed {
+		hlog.CtxInfof(ctx, "robots.txt disallow, url: %v", pageUrl)
+	}
+	return allowed
+}
+
+// getRobots 同一host同时只获取一次，ctx结束时返回nil
+func getRobots(ctx context.Context, origin string) *Robots {
+	cacheMu.Lock()
+	entry, ok := cache[origin]
+	if !ok || (isReady(entry) && time.Now().After(entry.expire)) {
+		if len(cache) >= maxCachedHosts {
+			for key, e := range cache {
+				if isReady(e) && time.Now().After(e.expire) {
+					delete(cache, key)
+				}
+			}
+		}
+		entry = &cacheEntry{ready: make(chan struct{})}
+		cache[origin] = entry
+		cacheMu.Unlock()
+		// 不随请求取消，获取结果给之后的请求使用
+		entry.robots, entry.expire = fetchRobots(context.WithoutCancel(ctx), origin)
+		close(entry.ready)
+		return entry.robots
+	}
+	cacheMu.Unlock()
+
+	select {
+	case <-entry.ready:
+		return entry.robots
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+func isReady(entry *cacheEntry) bool {
+	select {
+	case <-entry.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// fetchRobots 按RFC 9309：4xx视为没有限制，5xx和网络错误视为禁止全部
+func fetchRobots(ctx context.Context, origin string) (*Robots, time.Time) {
+	cfg := conf.GetConfig().Parse.Crawl.Robots
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultFetchTimeout
+	}
+	cacheDuration := time.Duration(cfg.CacheMinutes) * time.Minute
+	if cacheDuration <= 0 {
+		cacheDuration = defaultCacheDuration
+	}
+
+	status, body, err := http.FetchRobotsTxt(ctx, origin+"/robots.txt", UserAgent(), timeout)
+	switch {
+	case err != nil:
+		hlog.CtxWarnf(ctx, "fetch robots.txt failed, disallow all, origin: %v, err: %v", origin, err)
+		return DisallowAll(), time.Now().Add(errorCacheDuration)
+	case status >= 200 && status < 300:
+		return Parse(body), time.Now().Add(cacheDuration)
+	case status >= 400 && status < 500:
+		return AllowAll(), time.Now().Add(cacheDuration)
+	default:
+		hlog.CtxWarnf(ctx, "fetch robots.txt failed, disallow all, origin: %v, status: %v", origin, status)
+		return DisallowAll(), time.Now().Add(errorCacheDuration)
+	}
+}
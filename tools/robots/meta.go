@@ -0,0 +1,57 @@
+package robots
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Directives 网页中<meta name="robots">的指令
+type Directives struct {
+	NoIndex   bool // 网页不允许被收录，不返回解析结果
+	NoArchive bool // 网页不允许被存档，不缓存、不返回原始html
+}
+
+// ParseMeta 读取name为robots或user agent的meta标签，读到body时结束
+func ParseMeta(htmlStr string, userAgent string) Directives {
+	d := Directives{}
+	userAgent = normalizeAgent(userAgent)
+	tokenizer := html.NewTokenizer(strings.NewReader(htmlStr))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return d
+		}
+		if tokenType != html.StartTagToken && tokenType != html.SelfClosingTagToken {
+			continue
+		}
+		token := tokenizer.Token()
+		if token.DataAtom == atom.Body {
+			return d
+		}
+		if token.DataAtom != atom.Meta {
+			continue
+		}
+		name, content := "", ""
+		for _, attr := range token.Attr {
+			switch strings.ToLower(attr.Key) {
+			case "name":
+				name = strings.ToLower(strings.TrimSpace(attr.Val))
+			case "content":
+				content = attr.Val
+			}
+		}
+		if name != "robots" && name != userAgent {
+			continue
+		}
+		for _, directive := range strings.Split(content, ",") {
+			switch strings.ToLower(strings.TrimSpace(directive)) {
+			case "noindex", "none":
+				d.NoIndex = true
+			case "noarchive":
+				d.NoArchive = true
+			}
+		}
+	}
+}
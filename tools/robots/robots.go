@@ -0,0 +1,149 @@
+package robots
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+)
+
+// 只解析robots.txt的前500KB，与RFC 9309一致
+const maxRobotsBytes = 500 << 10
+
+type rule struct {
+	allow   bool
+	pattern string
+	re      *regexp.Regexp
+}
+
+type group struct {
+	agents []string // 小写的product token
+	rules  []rule
+}
+
+// Robots 解析后的robots.txt，按RFC 9309匹配：
+// 先找与user agent相同的组，没有时使用*组；组内最长匹配的规则生效，长度相同时allow优先
+type Robots struct {
+	groups []*group
+}
+
+// AllowAll robots.txt不存在时允许抓取全部网页
+func AllowAll() *Robots {
+	return &Robots{}
+}
+
+// DisallowAll robots.txt无法访问时按禁止抓取处理
+func DisallowAll() *Robots {
+	return &Robots{groups: []*group{{
+		agents: []string{"*"},
+		rules:  []rule{newRule(false, "/")},
+	}}}
+}
+
+func Parse(content string) *Robots {
+	if len(content) > maxRobotsBytes {
+		content = content[:maxRobotsBytes]
+	}
+	r := &Robots{}
+	var current *group
+	// 上一行是否为user-agent，连续的user-agent属于同一组
+	lastAgent := false
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 4096), maxRobotsBytes)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		switch key {
+		case "user-agent":
+			if !lastAgent || current == nil {
+				current = &group{}
+				r.groups = append(r.groups, current)
+			}
+			current.agents = append(current.agents, normalizeAgent(value))
+			lastAgent = true
+		case "allow", "disallow":
+			lastAgent = false
+			// 组外的规则和空的disallow都忽略
+			if current == nil || value == "" {
+				continue
+			}
+			current.rules = append(current.rules, newRule(key == "allow", value))
+		default:
+			lastAgent = false
+		}
+	}
+	return r
+}
+
+func newRule(allow bool, pattern string) rule {
+	expr := "^"
+	anchored := strings.HasSuffix(pattern, "$")
+	parts := strings.Split(strings.TrimSuffix(pattern, "$"), "*")
+	for i, part := range parts {
+		if i > 0 {
+			expr += ".*"
+		}
+		expr += regexp.QuoteMeta(part)
+	}
+	if anchored {
+		expr += "$"
+	}
+	return rule{allow: allow, pattern: pattern, re: regexp.MustCompile(expr)}
+}
+
+// normalizeAgent 只保留product token，如"Googlebot/2.1"取"googlebot"
+func normalizeAgent(agent string) string {
+	agent, _, _ = strings.Cut(agent, "/")
+	return strings.ToLower(strings.TrimSpace(agent))
+}
+
+func (r *Robots) rulesFor(userAgent string) []rule {
+	userAgent = normalizeAgent(userAgent)
+	found := false
+	matched := []rule{}
+	wildcard := []rule{}
+	for _, g := range r.groups {
+		for _, agent := range g.agents {
+			if agent == userAgent {
+				found = true
+				matched = append(matched, g.rules...)
+			} else if agent == "*" {
+				wildcard = append(wildcard, g.rules...)
+			}
+		}
+	}
+	// 有匹配的组时，即使组内没有规则也不再使用*组
+	if found {
+		return matched
+	}
+	return wildcard
+}
+
+// Allowed path包含query，如"/a/b?c=1"
+func (r *Robots) Allowed(userAgent string, path string) bool {
+	if path == "" {
+		path = "/"
+	}
+	if path == "/robots.txt" {
+		return true
+	}
+	allowed := true
+	matchedLen := -1
+	for _, rule := range r.rulesFor(userAgent) {
+		if !rule.re.MatchString(path) {
+			continue
+		}
+		if len(rule.pattern) > matchedLen || (len(rule.pattern) == matchedLen && rule.allow) {
+			allowed = rule.allow
+			matchedLen = len(rule.pattern)
+		}
+	}
+	return allowed
+}
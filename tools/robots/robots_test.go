@@ -0,0 +1,102 @@
+package robots
+
+import "testing"
+
+func TestAllowed(t *testing.T) {
+	cases := []struct {
+		name      string
+		content   string
+		userAgent string
+		path      string
+		allowed   bool
+	}{
+		// 分组
+		{"empty robots.txt", "", "wcd", "/a", true},
+		{"wildcard group", "User-agent: *\nDisallow: /a", "wcd", "/a/b", false},
+		{"wildcard group not matched", "User-agent: *\nDisallow: /a", "wcd", "/b", true},
+		{"specific group over wildcard", "User-agent: *\nDisallow: /\n\nUser-agent: wcd\nDisallow: /a", "wcd", "/b", true},
+		{"other agent group ignored", "User-agent: googlebot\nDisallow: /", "wcd", "/a", true},
+		{"agent case insensitive", "User-agent: WCD\nDisallow: /a", "wcd", "/a", false},
+		{"agent product token", "User-agent: wcd/1.0\nDisallow: /a", "Wcd/2.0", "/a", false},
+		{"consecutive agents share group", "User-agent: googlebot\nUser-agent: wcd\nDisallow: /a", "wcd", "/a", false},
+		{"agent after rule starts new group", "User-agent: wcd\nDisallow: /a\nUser-agent: googlebot\nDisallow: /b", "wcd", "/b", true},
+		{"same agent groups merged", "User-agent: wcd\nDisallow: /a\n\nUser-agent: googlebot\nDisallow: /\n\nUser-agent: wcd\nDisallow: /b", "wcd", "/b", false},
+		{"matched empty group allows all", "User-agent: *\nDisallow: /\n\nUser-agent: wcd\n", "wcd", "/a", true},
+		{"rule outside group ignored", "Disallow: /\nUser-agent: *\nDisallow: /a", "wcd", "/b", true},
+		{"empty disallow ignored", "User-agent: *\nDisallow:", "wcd", "/a", true},
+		{"comments and spaces", "# robots\n  user-agent :  *   # all\n DISALLOW : /a # private", "wcd", "/a", false},
+		{"unknown directives ignored", "User-agent: *\nCrawl-delay: 10\nSitemap: https://example.com/sitemap.xml\nDisallow: /a", "wcd", "/a", false},
+
+		// 最长匹配
+		{"longer allow wins", "User-agent: *\nDisallow: /a\nAllow: /a/b", "wcd", "/a/b/c", true},
+		{"longer disallow wins", "User-agent: *\nAllow: /a\nDisallow: /a/b", "wcd", "/a/b/c", false},
+		{"shorter rule applies elsewhere", "User-agent: *\nDisallow: /a\nAllow: /a/b", "wcd", "/a/c", false},
+		{"allow wins on equal length", "User-agent: *\nDisallow: /a\nAllow: /a", "wcd", "/a", true},
+		{"order does not matter", "User-agent: *\nAllow: /a/b\nDisallow: /a", "wcd", "/a/b", true},
+		{"prefix match", "User-agent: *\nDisallow: /fish", "wcd", "/fish.html", false},
+		{"case sensitive path", "User-agent: *\nDisallow: /fish", "wcd", "/Fish", true},
+		{"path with query", "User-agent: *\nDisallow: /search?q=", "wcd", "/search?q=go", false},
+		{"path without query", "User-agent: *\nDisallow: /search?q=", "wcd", "/search", true},
+		{"empty path is root", "User-agent: *\nDisallow: /$", "wcd", "", false},
+		{"robots.txt always allowed", "User-agent: *\nDisallow: /", "wcd", "/robots.txt", true},
+
+		// 通配符
+		{"star in middle", "User-agent: *\nDisallow: /*/private", "wcd", "/a/b/private/c", false},
+		{"star not matched", "User-agent: *\nDisallow: /*/private", "wcd", "/private", true},
+		{"trailing star", "User-agent: *\nDisallow: /fish*", "wcd", "/fishes", false},
+		{"dollar anchors end", "User-agent: *\nDisallow: /*.pdf$", "wcd", "/a/b.pdf", false},
+		{"dollar rejects suffix", "User-agent: *\nDisallow: /*.pdf$", "wcd", "/a/b.pdf?x=1", true},
+		{"dollar exact root", "User-agent: *\nDisallow: /$\nAllow: /", "wcd", "/a", true},
+		{"special chars are literal", "User-agent: *\nDisallow: /a.b+c", "wcd", "/axb+c", true},
+		{"wildcard pattern length counts", "User-agent: *\nAllow: /*.html\nDisallow: /a/", "wcd", "/a/b.html", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if allowed := Parse(c.content).Allowed(c.userAgent, c.path); allowed != c.allowed {
+				t.Errorf("Allowed(%q, %q) = %v, want %v", c.userAgent, c.path, allowed, c.allowed)
+			}
+		})
+	}
+}
+
+func TestAllowAllAndDisallowAll(t *testing.T) {
+	for _, path := range []string{"", "/", "/a/b?c=1"} {
+		if !AllowAll().Allowed("wcd", path) {
+			t.Errorf("AllowAll().Allowed(%q) = false", path)
+		}
+		if DisallowAll().Allowed("wcd", path) {
+			t.Errorf("DisallowAll().Allowed(%q) = true", path)
+		}
+	}
+	if !DisallowAll().Allowed("wcd", "/robots.txt") {
+		t.Errorf("DisallowAll().Allowed(/robots.txt) = false")
+	}
+}
+
+func TestParseMeta(t *testing.T) {
+	cases := []struct {
+		name string
+		html string
+		want Directives
+	}{
+		{"no meta", `<html><head><title>a</title></head><body></body></html>`, Directives{}},
+		{"noindex", `<meta name="robots" content="noindex">`, Directives{NoIndex: true}},
+		{"none", `<meta name="robots" content="none">`, Directives{NoIndex: true}},
+		{"noarchive", `<meta name="robots" content="noarchive">`, Directives{NoArchive: true}},
+		{"multiple directives", `<meta name="robots" content="noindex, nofollow, noarchive">`, Directives{NoIndex: true, NoArchive: true}},
+		{"case insensitive", `<META NAME="Robots" CONTENT=" NoIndex ">`, Directives{NoIndex: true}},
+		{"self closing", `<meta name="robots" content="noindex" />`, Directives{NoIndex: true}},
+		{"user agent name", `<meta name="wcd" content="noarchive">`, Directives{NoArchive: true}},
+		{"other agent ignored", `<meta name="googlebot" content="noindex">`, Directives{}},
+		{"index allowed", `<meta name="robots" content="index, follow">`, Directives{}},
+		{"merged across tags", `<meta name="robots" content="noarchive"><meta name="wcd" content="noindex">`, Directives{NoIndex: true, NoArchive: true}},
+		{"meta in body ignored", `<html><head></head><body><meta name="robots" content="noindex"></body></html>`, Directives{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := ParseMeta(c.html, "Wcd/1.0"); got != c.want {
+				t.Errorf("ParseMeta() = %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,10 @@ func cleanElemAttr(elem *etree.Element) {
 	}
 	reserveKeys = append(reserveKeys, consts.IMG_ATTRS...)
 	reserveKeys = append(reserveKeys, consts.A_ATTRS...)
+	if elem.Tag == consts.TagNameImg {
+		// 用于输出图片信息和过滤装饰性图片
+		reserveKeys = append(reserveKeys, "alt", "title", "width")
+	}
 
 	elem.Attr = utils.Filter(elem.Attr, func(attr etree.Attr) bool {
 		return utils.Contains(reserveKeys, attr.Key) || strings.Contains(strings.ToLower(attr.Key), "deeplang")
@@ -0,0 +1,351 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DeepLangAI/wcd/consts"
+	"github.com/DeepLangAI/wcd/tools/doc"
+	"github.com/DeepLangAI/wcd/utils"
+
+	"github.com/beevik/etree"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	suggestArticleRatio  = 0.6 // 与CleanByCentroid一致，<article>的文本占比超过此值时只在其中查找正文
+	suggestLinkRatio     = 0.7 // 与checkIsLinkBundle一致，链接文本占比超过此值时视为链接块
+	suggestNoiseMaxShare = 0.5 // 与removePotentialNoiseIsSafe一致，噪声块的文本不能超过正文的一半
+	suggestPreviewRunes  = 200
+)
+
+var (
+	// 正文可能所在的容器，段落等节点不作为正文节点
+	suggestContainerTags = []string{"div", "article", "section", "main", "table", "tbody", "tr", "td", "center"}
+	suggestSkipTags      = []string{"script", "style", "noscript", "iframe", "object", "head"}
+	// 自动生成的id每次访问可能不同，不用于定位
+	suggestAutoIdRegex = regexp.MustCompile(`\d{4,}|^[0-9a-f]{8,}$`)
+)
+
+// XpathSuggestion 推荐的xpath及其节点在样例网页中的统计
+type XpathSuggestion struct {
+	Xpath       string
+	TextLength  int     // 字符数
+	TextShare   float64 // 文本占整个网页文本的比例
+	LinkDensity float64 // 链接文本占节点文本的比例
+	Stable      bool    // 只使用id、class定位，不依赖节点位置
+	Reason      string
+	Preview     string
+	Matches     []*etree.Element
+}
+
+type suggestStat struct {
+	text  int // 字符数
+	link  int // 链接中的字符数
+	links int
+	score float64 // 容器的得分，见collect
+}
+
+// RuleSuggester 根据样例网页推荐站点规则的正文和噪声xpath，使用与Cleanner相同的启发式规则
+type RuleSuggester struct {
+	ctx    context.Context
+	doc    *doc.Document
+	root   *etree.Element
+	stats  map[*etree.Element]*suggestStat
+	depths map[*etree.Element]int
+
+	uniqueXpaths map[*etree.Element]string
+}
+
+func NewRuleSuggester(ctx context.Context, d *doc.Document) *RuleSuggester {
+	s := &RuleSuggester{
+		ctx:          ctx,
+		doc:          d,
+		root:         d.Doc.Root(),
+		stats:        map[*etree.Element]*suggestStat{},
+		depths:       map[*etree.Element]int{},
+		uniqueXpaths: map[*etree.Element]string{},
+	}
+	if bodyElems := d.Xpath("//body"); len(bodyElems) > 0 {
+		s.root = bodyElems[0]
+	}
+	s.collect(s.root, nil, 0, false)
+	return s
+}
+
+// collect 统计各节点的文本和链接。每段非链接文本给所在的各级容器加分，距离越远加分越少，
+// 直接包含大部分段落的容器得分最高
+func (s *RuleSuggester) collect(elem *etree.Element, containers []*etree.Element, depth int, inLink bool) *suggestStat {
+	stat := &suggestStat{}
+	s.stats[elem] = stat
+	if utils.Contains(suggestSkipTags, elem.Tag) {
+		return stat
+	}
+	if utils.Contains(suggestContainerTags, elem.Tag) {
+		containers = append(containers, elem)
+	}
+	inLink = inLink || elem.Tag == "a"
+	addText := func(text string) {
+		length := utf8.RuneCountInString(strings.TrimSpace(text))
+		stat.text += length
+		if inLink || length == 0 {
+			return
+		}
+		for _, container := range containers {
+			s.stats[container].score += float64(length) / float64(1+depth-s.depths[container])
+		}
+	}
+	s.depths[elem] = depth
+	addText(elem.Text())
+	for _, child := range elem.ChildElements() {
+		childStat := s.collect(child, containers, depth+1, inLink)
+		stat.text += childStat.text
+		stat.link += childStat.link
+		stat.links += childStat.links
+		addText(child.Tail())
+	}
+	if elem.Tag == "a" {
+		stat.link = stat.text
+		stat.links += 1
+	}
+	return stat
+}
+
+// SuggestBodies 在<body>或文本占比高的<article>中取得分最高的容器，其后为范围更大的祖先节点，作为备选
+func (s *RuleSuggester) SuggestBodies(limit int) []*XpathSuggestion {
+	suggestions := []*XpathSuggestion{}
+	if s.stats[s.root].text == 0 {
+		return suggestions
+	}
+	scope, scopeReason := s.root, ""
+	articles := []*etree.Element{}
+	s.doc.Traverse(s.root, &doc.TraverseParams{
+		Traversefunc: func(node *etree.Element) {
+			if node.Tag == "article" {
+				articles = append(articles, node)
+			}
+		},
+	})
+	if len(articles) == 1 && s.share(articles[0]) > suggestArticleRatio {
+		scope, scopeReason = articles[0], "<article> holds most text"
+	}
+
+	var best *etree.Element
+	s.doc.Traverse(scope, &doc.TraverseParams{
+		Traversefunc: func(node *etree.Element) {
+			if stat := s.stats[node]; stat != nil && stat.score > 0 && (best == nil || stat.score > s.stats[best].score) {
+				best = node
+			}
+		},
+	})
+	for elem := best; elem != nil && elem.Tag != "body" && elem.Tag != "html" && len(suggestions) < limit; elem = elem.Parent() {
+		xpath, stable, matches := s.xpathFor(elem, func(matches []*etree.Element) bool {
+			return len(matches) == 1
+		})
+		if xpath != "" {
+			reason := "container holding most paragraphs"
+			if len(suggestions) > 0 {
+				reason = "ancestor of the suggested body, covers a wider range"
+			}
+			if elem == scope && scopeReason != "" {
+				reason = scopeReason
+			}
+			suggestions = append(suggestions, s.newSuggestion(elem, xpath, stable, matches, reason))
+		}
+		if elem == scope {
+			break
+		}
+	}
+	return suggestions
+}
+
+// SuggestNoises 正文节点中的链接块和class、id像噪声的节点，文本不超过正文的一半。按文本长度从多到少
+func (s *RuleSuggester) SuggestNoises(body *etree.Element, limit int) []*XpathSuggestion {
+	bodyText := s.stats[body].text
+	suggestions := []*XpathSuggestion{}
+	if bodyText == 0 {
+		return suggestions
+	}
+	seen := map[string]bool{}
+	var visit func(elem *etree.Element)
+	visit = func(elem *etree.Element) {
+		stat := s.stats[elem]
+		if stat == nil || stat.text == 0 || utils.Contains(suggestSkipTags, elem.Tag) {
+			return
+		}
+		if reason := s.noiseReason(elem); reason != "" && float64(stat.text) < suggestNoiseMaxShare*float64(bodyText) {
+			xpath, stable, matches := s.xpathFor(elem, func(matches []*etree.Element) bool {
+				// 噪声会从整个网页中删除，不能删掉正文节点本身
+				return !slices.ContainsFunc(matches, func(match *etree.Element) bool {
+					return isAncestorOrSelf(match, body)
+				})
+			})
+			if xpath != "" && !seen[xpath] {
+				seen[xpath] = true
+				suggestions = append(suggestions, s.newSuggestion(elem, xpath, stable, matches, reason))
+			}
+			return
+		}
+		for _, child := range elem.ChildElements() {
+			visit(child)
+		}
+	}
+	for _, child := range body.ChildElements() {
+		visit(child)
+	}
+
+	slices.SortStableFunc(suggestions, func(a, b *XpathSuggestion) int {
+		return b.TextLength - a.TextLength
+	})
+	return suggestions[:min(len(suggestions), limit)]
+}
+
+// noiseReason 链接块与checkIsLinkBundle的判断方式一致，class、id使用CleanPotentialNoise中的正则
+func (s *RuleSuggester) noiseReason(elem *etree.Element) string {
+	stat := s.stats[elem]
+	if elem.Tag != "a" && stat.links >= 2 && float64(stat.link) >= suggestLinkRatio*float64(stat.text) {
+		return fmt.Sprintf("link bundle, link density: %.2f", float64(stat.link)/float64(stat.text))
+	}
+	for _, attrKey := range []string{"class", "id"} {
+		attrValue := elem.SelectAttrValue(attrKey, "")
+		if attrValue == "" {
+			continue
+		}
+		if consts.RegexRule_OkMaybeItsACandidate.MatchString(attrValue) || consts.RegexRule_Positive.MatchString(attrValue) {
+			continue
+		}
+		match := consts.RegexRule_UnlikelyCandidate.FindString(attrValue)
+		if match == "" {
+			match = consts.RegexRule_Negative.FindString(attrValue)
+		}
+		if match != "" {
+			return fmt.Sprintf("%v looks like noise: %v", attrKey, match)
+		}
+	}
+	return ""
+}
+
+// Preview 使用body并删除noises后的正文
+func (s *RuleSuggester) Preview(body *etree.Element, noises []*XpathSuggestion) string {
+	copied := body.Copy()
+	for _, noise := range noises {
+		for _, elem := range noise.Matches {
+			pid := s.doc.GetElemPositionId(elem)
+			found := copied.FindElement(fmt.Sprintf(".//*[@%v='%v']", consts.KeyPositionId, pid))
+			if found != nil && found.Parent() != nil {
+				found.Parent().RemoveChild(found)
+			}
+		}
+	}
+	return s.doc.GetRawDocText(copied)
+}
+
+func (s *RuleSuggester) share(elem *etree.Element) float64 {
+	total := s.stats[s.root].text
+	if total == 0 {
+		return 0
+	}
+	return float64(s.stats[elem].text) / float64(total)
+}
+
+func (s *RuleSuggester) newSuggestion(elem *etree.Element, xpath string, stable bool, matches []*etree.Element, reason string) *XpathSuggestion {
+	stat := s.stats[elem]
+	suggestion := &XpathSuggestion{
+		Xpath:      xpath,
+		TextLength: stat.text,
+		TextShare:  s.share(elem),
+		Stable:     stable,
+		Reason:     reason,
+		Preview:    utils.FirstNRunes(s.doc.GetRawDocText(elem), suggestPreviewRunes),
+		Matches:    matches,
+	}
+	if stat.text > 0 {
+		suggestion.LinkDensity = float64(stat.link) / float64(stat.text)
+	}
+	return suggestion
+}
+
+// selectors 按id、class定位节点的xpath，与站点规则中常见的写法一致
+func (s *RuleSuggester) selectors(elem *etree.Element) []string {
+	selectors := []string{}
+	if id := elem.SelectAttrValue("id", ""); strings.TrimSpace(id) != "" && !strings.Contains(id, `"`) && !suggestAutoIdRegex.MatchString(id) {
+		selectors = append(selectors, fmt.Sprintf(`//%v[@id="%v"]`, elem.Tag, id))
+	}
+	if class := elem.SelectAttrValue("class", ""); strings.TrimSpace(class) != "" && !strings.Contains(class, `"`) {
+		selectors = append(selectors, fmt.Sprintf(`//%v[@class="%v"]`, elem.Tag, class))
+	}
+	// 只按标签定位时需要唯一，如//article
+	return append(selectors, "//"+elem.Tag)
+}
+
+// check xpath需要命中elem，且命中的节点满足accept
+func (s *RuleSuggester) check(xpath string, elem *etree.Element, accept func(matches []*etree.Element) bool) ([]*etree.Element, bool) {
+	matches := s.doc.Xpath(xpath)
+	if !slices.Contains(matches, elem) || !accept(matches) {
+		return nil, false
+	}
+	if strings.HasSuffix(xpath, "//"+elem.Tag) && len(matches) > 1 {
+		return nil, false
+	}
+	return matches, true
+}
+
+// uniqueXpath 只命中elem的id、class定位，没有时返回空
+func (s *RuleSuggester) uniqueXpath(elem *etree.Element) string {
+	if xpath, ok := s.uniqueXpaths[elem]; ok {
+		return xpath
+	}
+	s.uniqueXpaths[elem] = ""
+	for _, selector := range s.selectors(elem) {
+		if _, ok := s.check(selector, elem, func(matches []*etree.Element) bool { return len(matches) == 1 }); ok {
+			s.uniqueXpaths[elem] = selector
+			break
+		}
+	}
+	return s.uniqueXpaths[elem]
+}
+
+// xpathFor 依次尝试节点自身的id、class，加上最近的可以唯一定位的祖先，最后按节点位置定位
+func (s *RuleSuggester) xpathFor(elem *etree.Element, accept func(matches []*etree.Element) bool) (string, bool, []*etree.Element) {
+	for _, selector := range s.selectors(elem) {
+		if matches, ok := s.check(selector, elem, accept); ok {
+			return selector, true, matches
+		}
+	}
+	elemXpath := s.doc.GetElemXpath(elem)
+	for ancestor := elem.Parent(); ancestor != nil; ancestor = ancestor.Parent() {
+		ancestorXpath := s.uniqueXpath(ancestor)
+		if ancestorXpath == "" {
+			continue
+		}
+		for _, selector := range s.selectors(elem) {
+			if matches, ok := s.check(ancestorXpath+selector, elem, accept); ok {
+				return ancestorXpath + selector, true, matches
+			}
+		}
+		if relative, ok := strings.CutPrefix(elemXpath, s.doc.GetElemXpath(ancestor)+"/"); ok {
+			if matches, ok := s.check(ancestorXpath+"/"+relative, elem, accept); ok {
+				return ancestorXpath + "/" + relative, false, matches
+			}
+		}
+		break
+	}
+	if matches, ok := s.check(elemXpath, elem, accept); ok {
+		return elemXpath, false, matches
+	}
+	hlog.CtxWarnf(s.ctx, "no xpath found for elem: %v", elemXpath)
+	return "", false, nil
+}
+
+func isAncestorOrSelf(ancestor *etree.Element, elem *etree.Element) bool {
+	for node := elem; node != nil; node = node.Parent() {
+		if node == ancestor {
+			return true
+		}
+	}
+	return false
+}
@@ -24,11 +24,14 @@ const (
 	CoreNamePostDistill                 = "post_distill"
 	NodeNamePostDistillFormat           = "post_format"
 	NodeNamePostDistillDistill          = "post_distill"
+	NodeNamePostDistillFilterImg        = "filter_img"
 	NodeNamePostDistillRenderHtml       = "render_html"
 	NodeNamePostDistillCheckWorthless   = "check_worthless"
 	NodeNamePostDistillGetExistSentence = "exist_sentence"
 	NodeNamePostDistillGetText          = "get_text"
 	NodeNamePostDistillGetImg           = "get_img"
+	NodeNamePostDistillRenderMarkdown   = "render_markdown"
+	NodeNamePostDistillRenderBlocks     = "render_blocks"
 )
 
 func CoreLog(ctx context.Context, coreName string, nodeName string) {
@@ -0,0 +1,64 @@
+package utils
+
+// 公共前后缀之外的行数乘积超过此值时不再求最长公共子序列，整段视为替换
+const maxDiffCells = 4_000_000
+
+// DiffLines 按行比较，只返回变化的行，"-"开头为只在from中的行，"+"开头为只在to中的行
+func DiffLines(from []string, to []string) []string {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+	from, to = from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+
+	diffs := []string{}
+	if len(from)*len(to) > maxDiffCells {
+		for _, line := range from {
+			diffs = append(diffs, "-"+line)
+		}
+		for _, line := range to {
+			diffs = append(diffs, "+"+line)
+		}
+		return diffs
+	}
+
+	// lcs[i][j]为from[i:]和to[j:]的最长公共子序列长度
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diffs = append(diffs, "-"+from[i])
+			i++
+		default:
+			diffs = append(diffs, "+"+to[j])
+			j++
+		}
+	}
+	for ; i < len(from); i++ {
+		diffs = append(diffs, "-"+from[i])
+	}
+	for ; j < len(to); j++ {
+		diffs = append(diffs, "+"+to[j])
+	}
+	return diffs
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"mime"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	imageSizeRegex   = regexp.MustCompile(`^\s*(\d+(?:\.\d+)?)\s*(?:px)?\s*$`)
+	styleWidthRegex  = regexp.MustCompile(`(?i)(?:^|;)\s*width\s*:\s*(\d+(?:\.\d+)?)px`)
+	styleHeightRegex = regexp.MustCompile(`(?i)(?:^|;)\s*height\s*:\s*(\d+(?:\.\d+)?)px`)
+)
+
+// ImageConfig 从图片内容的头部读取宽高和格式，支持gif、jpeg、png和webp。
+// data可以只是图片的前一部分
+func ImageConfig(data []byte) (width int, height int, mimeType string, ok bool) {
+	if w, h, ok := webpConfig(data); ok {
+		return w, h, "image/webp", true
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, "", false
+	}
+	return config.Width, config.Height, "image/" + format, true
+}
+
+// webpConfig 按RIFF头读取webp的画布大小
+func webpConfig(data []byte) (int, int, bool) {
+	if len(data) < 30 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, 0, false
+	}
+	le24 := func(b []byte) int {
+		return int(b[0]) | int(b[1])<<8 | int(b[2])<<16
+	}
+	switch string(data[12:16]) {
+	case "VP8 ":
+		// 3字节帧标记和3字节起始码之后是14位的宽高
+		return int(binary.LittleEndian.Uint16(data[26:28]) & 0x3fff), int(binary.LittleEndian.Uint16(data[28:30]) & 0x3fff), true
+	case "VP8L":
+		if data[20] != 0x2f {
+			return 0, 0, false
+		}
+		b := data[21:25]
+		width := 1 + (int(b[0]) | int(b[1]&0x3f)<<8)
+		height := 1 + (int(b[1]>>6) | int(b[2])<<2 | int(b[3]&0x0f)<<10)
+		return width, height, true
+	case "VP8X":
+		return 1 + le24(data[24:27]), 1 + le24(data[27:30]), true
+	}
+	return 0, 0, false
+}
+
+// ImageMimeTypeOfUrl 按链接的扩展名判断图片格式，无法判断时返回空
+func ImageMimeTypeOfUrl(imgUrl string) string {
+	u, err := url.Parse(imgUrl)
+	if err != nil {
+		return ""
+	}
+	ext := strings.ToLower(path.Ext(u.Path))
+	if ext == ".jpg" {
+		return "image/jpeg"
+	}
+	mimeType, _, _ := strings.Cut(mime.TypeByExtension(ext), ";")
+	if !strings.HasPrefix(mimeType, "image/") {
+		return ""
+	}
+	return mimeType
+}
+
+// ParseImageSize 读取width、height属性的像素值，如"300"、"300px"，百分比等返回0
+func ParseImageSize(value string) int {
+	match := imageSizeRegex.FindStringSubmatch(value)
+	if len(match) < 2 {
+		return 0
+	}
+	size, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0
+	}
+	return int(size)
+}
+
+// ParseStyleImageSize 读取style中以px为单位的width、height
+func ParseStyleImageSize(style string) (width int, height int) {
+	if match := styleWidthRegex.FindStringSubmatch(style); len(match) > 1 {
+		width = ParseImageSize(match[1])
+	}
+	if match := styleHeightRegex.FindStringSubmatch(style); len(match) > 1 {
+		height = ParseImageSize(match[1])
+	}
+	return width, height
+}
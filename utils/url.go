@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/DeepLangAI/wcd/consts"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// CanonicalUrl 规范化url，用作网页缓存的key：scheme和host转小写，去掉默认端口、fragment和跟踪参数，
+// 其余参数按名称排序。无法解析的url原样返回
+func CanonicalUrl(rawUrl string) string {
+	u, err := url.Parse(strings.TrimSpace(rawUrl))
+	if err != nil || u.Host == "" {
+		return rawUrl
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if (u.Scheme == "http" && u.Port() == "80") || (u.Scheme == "https" && u.Port() == "443") {
+		u.Host = u.Hostname()
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	if u.RawQuery != "" {
+		query := u.Query()
+		for key := range query {
+			if isTrackingParam(key) {
+				query.Del(key)
+			}
+		}
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
+
+func isTrackingParam(key string) bool {
+	key = strings.ToLower(key)
+	if Contains(consts.TRACKING_QUERY_PARAMS, key) {
+		return true
+	}
+	return Any(consts.TRACKING_QUERY_PREFIXES, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+// ExtractCanonicalLink 读取head中<link rel="canonical">的地址，按pageUrl补全为绝对地址，没有时返回空
+func ExtractCanonicalLink(htmlStr string, pageUrl string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(htmlStr))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return ""
+		}
+		if tokenType != html.StartTagToken && tokenType != html.SelfClosingTagToken {
+			continue
+		}
+		token := tokenizer.Token()
+		if token.DataAtom == atom.Body {
+			return ""
+		}
+		if token.DataAtom != atom.Link {
+			continue
+		}
+		rel, href := "", ""
+		for _, attr := range token.Attr {
+			switch strings.ToLower(attr.Key) {
+			case "rel":
+				rel = strings.ToLower(attr.Val)
+			case "href":
+				href = strings.TrimSpace(attr.Val)
+			}
+		}
+		if href == "" || !Contains(strings.Fields(rel), "canonical") {
+			continue
+		}
+		base, err := url.Parse(pageUrl)
+		if err != nil {
+			return ""
+		}
+		ref, err := url.Parse(href)
+		if err != nil {
+			return ""
+		}
+		return base.ResolveReference(ref).String()
+	}
+}